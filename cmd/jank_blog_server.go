@@ -12,14 +12,18 @@ import (
 	"github.com/cloudwego/hertz/pkg/app/server"
 
 	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/analytics"
 	"github.com/Done-0/jank/internal/casbin"
 	"github.com/Done-0/jank/internal/db"
 	"github.com/Done-0/jank/internal/global"
 	"github.com/Done-0/jank/internal/logger"
 	"github.com/Done-0/jank/internal/middleware"
 	"github.com/Done-0/jank/internal/plugin"
+	"github.com/Done-0/jank/internal/publisher"
 	"github.com/Done-0/jank/internal/redis"
 	"github.com/Done-0/jank/internal/theme"
+	"github.com/Done-0/jank/internal/trash"
+	"github.com/Done-0/jank/internal/types/consts"
 	"github.com/Done-0/jank/pkg/router"
 )
 
@@ -53,11 +57,28 @@ func Start() {
 	// 初始化主题系统
 	theme.New(cfgs)
 
+	// 启动文章定时发布任务
+	publisher.New(cfgs)
+
+	// 启动文章浏览统计汇总任务
+	analytics.New(cfgs)
+
+	// 启动回收站清理任务
+	trash.New(cfgs)
+
 	// 创建 Hertz 服务器实例
 	addr := fmt.Sprintf("%s:%s", cfgs.AppConfig.AppHost, cfgs.AppConfig.AppPort)
+
+	// 请求体上限需容纳媒体文件大小上限及 multipart 表单开销
+	maxUploadSize := cfgs.MediaConfig.MaxSize
+	if maxUploadSize <= 0 {
+		maxUploadSize = consts.MediaDefaultMaxSize
+	}
+
 	h := server.Default(
 		server.WithHostPorts(addr),
 		server.WithExitWaitTime(10*time.Second),
+		server.WithMaxRequestBodySize(int(maxUploadSize+1)<<20),
 	)
 
 	// 注册中间件
@@ -68,6 +89,9 @@ func Start() {
 
 	// 注册优雅关闭钩子
 	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		publisher.Shutdown()
+		analytics.Shutdown()
+		trash.Shutdown()
 		plugin.GlobalPluginManager.Shutdown()
 		theme.GlobalThemeManager.Shutdown()
 	})
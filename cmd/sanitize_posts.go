@@ -0,0 +1,41 @@
+// Package cmd 提供文章 HTML 重新清洗命令
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package cmd
+
+import (
+	"log"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/db"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/logger"
+)
+
+// SanitizePostsCommand 重新清洗文章命令名称
+const SanitizePostsCommand = "sanitize-posts"
+
+// SanitizePosts 按作者当前角色重新渲染并清洗所有已有文章，调整角色的 html:trusted 权限后执行
+func SanitizePosts() {
+	if err := configs.New(configs.DefaultConfigPath); err != nil {
+		log.Fatalf("failed to initialize config: %v", err)
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		log.Fatalf("failed to get config: %v", err)
+	}
+
+	logger.New(cfgs)
+	db.New(cfgs)
+	defer db.Close()
+
+	count, err := db.ResanitizePosts()
+	if err != nil {
+		global.SysLog.Errorf("Failed to sanitize posts: %v", err)
+		log.Fatalf("failed to sanitize posts: %v", err)
+	}
+
+	log.Printf("Sanitized %d posts", count)
+	global.SysLog.Infof("Sanitized %d posts", count)
+}
@@ -129,15 +129,103 @@ type ThemeConfig struct {
 	BuildTimeoutMinutes int    `mapstructure:"BUILD_TIMEOUT_MINUTES"` // 构建超时时间（分钟）
 }
 
+// SEOConfig 搜索引擎优化配置
+type SEOConfig struct {
+	SiteURL  string       `mapstructure:"SITE_URL"`  // 站点对外访问地址，为空时根据请求推导
+	CacheTTL int64        `mapstructure:"CACHE_TTL"` // sitemap.xml 与 robots.txt 缓存时间（分钟）
+	Robots   RobotsConfig `mapstructure:"ROBOTS"`    // robots.txt 配置
+}
+
+// RobotsConfig robots.txt 配置
+type RobotsConfig struct {
+	UserAgent  string   `mapstructure:"USER_AGENT"`  // 规则适用的爬虫
+	Allow      []string `mapstructure:"ALLOW"`       // 允许抓取的路径
+	Disallow   []string `mapstructure:"DISALLOW"`    // 禁止抓取的路径
+	CrawlDelay int64    `mapstructure:"CRAWL_DELAY"` // 抓取间隔（秒），0 表示不限制
+	Sitemap    bool     `mapstructure:"SITEMAP"`     // 是否声明站点地图地址
+}
+
+// MediaConfig 媒体文件配置
+type MediaConfig struct {
+	Storage      string             `mapstructure:"STORAGE"`       // 存储后端，可选值: local, s3
+	MaxSize      int64              `mapstructure:"MAX_SIZE"`      // 单个文件大小上限（MB）
+	AllowedTypes []string           `mapstructure:"ALLOWED_TYPES"` // 允许上传的 MIME 类型（按文件内容识别）
+	Local        LocalStorageConfig `mapstructure:"LOCAL"`         // 本地磁盘存储配置
+	S3           S3StorageConfig    `mapstructure:"S3"`            // S3 兼容对象存储配置
+}
+
+// LocalStorageConfig 本地磁盘存储配置
+type LocalStorageConfig struct {
+	Dir       string `mapstructure:"DIR"`        // 文件存放目录
+	URLPrefix string `mapstructure:"URL_PREFIX"` // 文件访问路径前缀
+}
+
+// S3StorageConfig S3 兼容对象存储配置
+type S3StorageConfig struct {
+	Endpoint  string `mapstructure:"ENDPOINT"`   // 服务地址，如 https://s3.amazonaws.com 或 http://127.0.0.1:9000
+	Region    string `mapstructure:"REGION"`     // 区域
+	Bucket    string `mapstructure:"BUCKET"`     // 存储桶
+	AccessKey string `mapstructure:"ACCESS_KEY"` // 访问密钥 ID
+	SecretKey string `mapstructure:"SECRET_KEY"` // 访问密钥
+	PathStyle bool   `mapstructure:"PATH_STYLE"` // 是否使用路径风格访问（MinIO 等自建服务通常需要开启）
+	PublicURL string `mapstructure:"PUBLIC_URL"` // 文件对外访问地址前缀（如 CDN 域名），为空时使用服务地址
+}
+
+// MarkdownConfig Markdown 渲染配置，变更后已有文章会按新配置重新渲染
+type MarkdownConfig struct {
+	Highlight      bool   `mapstructure:"HIGHLIGHT"`       // 是否启用服务端代码高亮
+	HighlightStyle string `mapstructure:"HIGHLIGHT_STYLE"` // 代码高亮样式，如 github、monokai、dracula
+	LineNumbers    bool   `mapstructure:"LINE_NUMBERS"`    // 代码块是否显示行号
+	Math           bool   `mapstructure:"MATH"`            // 是否解析 $...$ 与 $$...$$ 数学公式
+	Mermaid        bool   `mapstructure:"MERMAID"`         // 是否将 mermaid 代码块输出为图表容器
+	TOC            bool   `mapstructure:"TOC"`             // 是否生成文章目录
+}
+
+// SanitizeConfig 文章 HTML 清洗配置，按作者角色在 Casbin 中是否拥有 html:trusted 权限选择策略
+type SanitizeConfig struct {
+	Trusted SanitizePolicyConfig `mapstructure:"TRUSTED"` // 受信任策略
+	Basic   SanitizePolicyConfig `mapstructure:"BASIC"`   // 基础策略
+}
+
+// SanitizePolicyConfig HTML 清洗策略配置，在内置白名单（常规排版元素及 Markdown 渲染器输出）基础上扩展
+type SanitizePolicyConfig struct {
+	AllowElements []string `mapstructure:"ALLOW_ELEMENTS"` // 额外允许的元素，如 iframe、video、audio
+	AllowAttrs    []string `mapstructure:"ALLOW_ATTRS"`    // 额外允许的元素上可用的属性，如 src、width、controls
+	IframeHosts   []string `mapstructure:"IFRAME_HOSTS"`   // 允许通过 iframe 嵌入的域名，仅允许 https
+}
+
+// ReactionConfig 文章互动表态配置
+type ReactionConfig struct {
+	Types []string `mapstructure:"TYPES"` // 可用的表态类型，如 like、heart、clap
+}
+
+// TrashConfig 回收站配置
+type TrashConfig struct {
+	RetentionDays int64 `mapstructure:"RETENTION_DAYS"` // 已删除的文章、分类与用户的保留天数，超期后永久删除；为 0 时使用默认值，小于 0 时不自动清理
+}
+
+// I18NConfig 多语言配置
+type I18NConfig struct {
+	DefaultLocale string   `mapstructure:"DEFAULT_LOCALE"` // 默认语言，未指定语言的文章与分类使用该语言，也是按语言筛选时的最终回退语言
+	Locales       []string `mapstructure:"LOCALES"`        // 站点支持的语言列表，为空时仅支持默认语言
+}
+
 // Config 总配置结构
 type Config struct {
-	AppConfig    AppConfig      `mapstructure:"APP"`      // 应用配置
-	DBConfig     DatabaseConfig `mapstructure:"DATABASE"` // 数据库配置
-	LogConfig    LogConfig      `mapstructure:"LOG"`      // 日志配置
-	RedisConfig  RedisConfig    `mapstructure:"REDIS"`    // Redis 配置
-	CasbinConfig CasbinConfig   `mapstructure:"CASBIN"`   // Casbin 权限配置
-	PluginConfig PluginConfig   `mapstructure:"PLUGIN"`   // 插件配置
-	ThemeConfig  ThemeConfig    `mapstructure:"THEME"`    // 主题配置
+	AppConfig      AppConfig      `mapstructure:"APP"`      // 应用配置
+	DBConfig       DatabaseConfig `mapstructure:"DATABASE"` // 数据库配置
+	LogConfig      LogConfig      `mapstructure:"LOG"`      // 日志配置
+	RedisConfig    RedisConfig    `mapstructure:"REDIS"`    // Redis 配置
+	CasbinConfig   CasbinConfig   `mapstructure:"CASBIN"`   // Casbin 权限配置
+	PluginConfig   PluginConfig   `mapstructure:"PLUGIN"`   // 插件配置
+	ThemeConfig    ThemeConfig    `mapstructure:"THEME"`    // 主题配置
+	SEOConfig      SEOConfig      `mapstructure:"SEO"`      // 搜索引擎优化配置
+	MediaConfig    MediaConfig    `mapstructure:"MEDIA"`    // 媒体文件配置
+	MarkdownConfig MarkdownConfig `mapstructure:"MARKDOWN"` // Markdown 渲染配置
+	SanitizeConfig SanitizeConfig `mapstructure:"SANITIZE"` // 文章 HTML 清洗配置
+	ReactionConfig ReactionConfig `mapstructure:"REACTION"` // 文章互动表态配置
+	TrashConfig    TrashConfig    `mapstructure:"TRASH"`    // 回收站配置
+	I18NConfig     I18NConfig     `mapstructure:"I18N"`     // 多语言配置
 }
 
 // DefaultConfigPath 默认配置文件路径
@@ -147,8 +235,23 @@ var (
 	configInstance  *Config      // 全局配置实例
 	configMutex     sync.RWMutex // 配置读写锁
 	viperController *viper.Viper // viper 实例
+	changeHooks     []ChangeHook // 配置变更回调
 )
 
+// ChangeHook 配置变更回调，参数为变更前后的配置副本
+type ChangeHook func(oldConfig, newConfig *Config)
+
+// OnChange 注册配置变更回调，配置文件被修改并成功加载后依次调用
+// 参数：
+//
+//	hook: 配置变更回调
+func OnChange(hook ChangeHook) {
+	configMutex.Lock()
+	defer configMutex.Unlock()
+
+	changeHooks = append(changeHooks, hook)
+}
+
 // New 初始化配置
 // 参数：
 //
@@ -203,21 +306,31 @@ func monitorConfigChanges() {
 		}
 
 		configMutex.Lock()
-		defer configMutex.Unlock()
 
 		oldConfig := *configInstance
 		changes := make(map[string][2]any)
 
 		if !compareStructs(oldConfig, newConfig, "", changes) {
+			configMutex.Unlock()
 			log.Printf("config type mismatch, changes blocked")
 			return
 		}
 
 		configInstance = &newConfig
+		hooks := changeHooks
+		configMutex.Unlock()
 
 		for path, values := range changes {
 			log.Printf("config item [%s] changed: %v -> %v", path, values[0], values[1])
 		}
+
+		// 回调在释放锁之后执行，回调中可以安全地调用 GetConfig
+		if len(changes) > 0 {
+			for _, hook := range hooks {
+				oldCopy, newCopy := oldConfig, newConfig
+				hook(&oldCopy, &newCopy)
+			}
+		}
 	})
 }
 
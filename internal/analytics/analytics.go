@@ -0,0 +1,190 @@
+// Package analytics 提供文章浏览统计汇总后台任务
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm/clause"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+var (
+	cancel context.CancelFunc // 停止后台任务
+	wg     sync.WaitGroup     // 等待后台任务退出
+)
+
+// New 启动浏览统计汇总后台任务
+// 参数：
+//
+//	config: 应用配置
+func New(config *configs.Config) {
+	ctx, stop := context.WithCancel(context.Background())
+	cancel = stop
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		run(ctx)
+	}()
+
+	global.SysLog.Infof("Post view flusher started, interval: %s", consts.PostViewFlushInterval)
+}
+
+// Shutdown 停止浏览统计汇总后台任务，退出前再汇总一次，避免丢失最后一个周期的浏览
+func Shutdown() {
+	if cancel == nil {
+		return
+	}
+	cancel()
+	wg.Wait()
+
+	if err := flushViews(context.Background()); err != nil {
+		global.SysLog.Errorf("failed to flush post views: %v", err)
+	}
+	global.SysLog.Info("Post view flusher stopped")
+}
+
+// run 周期性将 Redis 中的浏览统计写入数据库
+func run(ctx context.Context) {
+	ticker := time.NewTicker(consts.PostViewFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := flushViews(ctx); err != nil {
+			global.SysLog.Errorf("failed to flush post views: %v", err)
+		}
+	}
+}
+
+// flushViews 汇总有新增浏览的文章统计
+// 待汇总集合先并入处理中集合再清空，处理成功的成员才从处理中集合移除，失败的成员在下一周期重试；
+// 写入的是 HyperLogLog 当前基数与来源计数的绝对值，重复汇总不会重复计数
+// 参数：
+//
+//	ctx: 上下文
+//
+// 返回值：
+//
+//	error: 错误信息
+func flushViews(ctx context.Context) error {
+	if global.RedisClient == nil {
+		return nil
+	}
+
+	if _, err := global.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SUnionStore(ctx, consts.PostViewsFlushingKey, consts.PostViewsFlushingKey, consts.PostViewsDirtyKey)
+		pipe.Del(ctx, consts.PostViewsDirtyKey)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to collect dirty post views: %w", err)
+	}
+
+	members, err := global.RedisClient.SMembers(ctx, consts.PostViewsFlushingKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list dirty post views: %w", err)
+	}
+
+	flushed := 0
+	for _, member := range members {
+		if err := flushPostViews(ctx, member); err != nil {
+			global.SysLog.Warnf("failed to flush post views %s: %v", member, err)
+			continue
+		}
+		if err := global.RedisClient.SRem(ctx, consts.PostViewsFlushingKey, member).Err(); err != nil {
+			global.SysLog.Warnf("failed to remove flushed post views %s: %v", member, err)
+			continue
+		}
+		flushed++
+	}
+
+	if flushed > 0 {
+		global.SysLog.Infof("Flushed views of %d posts", flushed)
+	}
+	return nil
+}
+
+// flushPostViews 将单篇文章单日的浏览量与来源统计写入数据库
+// 参数：
+//
+//	ctx: 上下文
+//	member: 待汇总成员，格式 {date}:{postID}
+//
+// 返回值：
+//
+//	error: 错误信息
+func flushPostViews(ctx context.Context, member string) error {
+	date, rawPostID, ok := strings.Cut(member, ":")
+	if !ok {
+		return fmt.Errorf("invalid member format")
+	}
+	postID, err := strconv.ParseInt(rawPostID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid post ID: %w", err)
+	}
+
+	views, err := global.RedisClient.PFCount(ctx, fmt.Sprintf("%s:%s:%d", consts.PostViewsKeyPrefix, date, postID)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to count views: %w", err)
+	}
+	// 统计键已过期，数据库中已是最终值
+	if views == 0 {
+		return nil
+	}
+
+	referrers, err := global.RedisClient.HGetAll(ctx, fmt.Sprintf("%s:%s:%d", consts.PostReferrersKeyPrefix, date, postID)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get referrers: %w", err)
+	}
+
+	db := global.DB.WithContext(ctx)
+
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"views", "gmt_modified"}),
+	}).Create(&post.PostStat{PostID: postID, Date: date, Views: views}).Error; err != nil {
+		return fmt.Errorf("failed to save views: %w", err)
+	}
+
+	if len(referrers) == 0 {
+		return nil
+	}
+
+	referrerStats := make([]*post.PostReferrerStat, 0, len(referrers))
+	for referrer, rawViews := range referrers {
+		referrerViews, err := strconv.ParseInt(rawViews, 10, 64)
+		if err != nil {
+			continue
+		}
+		referrerStats = append(referrerStats, &post.PostReferrerStat{PostID: postID, Date: date, Referrer: referrer, Views: referrerViews})
+	}
+	if len(referrerStats) == 0 {
+		return nil
+	}
+
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "date"}, {Name: "referrer"}},
+		DoUpdates: clause.AssignmentColumns([]string{"views", "gmt_modified"}),
+	}).Create(&referrerStats).Error; err != nil {
+		return fmt.Errorf("failed to save referrers: %w", err)
+	}
+
+	return nil
+}
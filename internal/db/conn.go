@@ -66,6 +66,42 @@ func New(config *configs.Config) {
 		global.SysLog.Fatalf("Failed to auto migrate database: %v", err)
 	}
 
+	// 创建文章全文检索索引
+	if err = ensureSearchIndex(dialect); err != nil {
+		global.SysLog.Fatalf("Failed to ensure search index: %v", err)
+	}
+
+	// 创建键集分页使用的复合索引
+	if err = ensureKeysetIndexes(); err != nil {
+		global.SysLog.Fatalf("Failed to ensure keyset indexes: %v", err)
+	}
+
+	// 创建标签名称唯一索引
+	if err = ensureTagNameIndex(); err != nil {
+		global.SysLog.Fatalf("Failed to ensure tag name index: %v", err)
+	}
+
+	// 回填历史数据的 slug
+	if err = backfillSlugs(); err != nil {
+		global.SysLog.Fatalf("Failed to backfill slugs: %v", err)
+	}
+
+	// 回填历史数据的语言与翻译组
+	if err = backfillLocales(); err != nil {
+		global.SysLog.Fatalf("Failed to backfill locales: %v", err)
+	}
+
+	// 按当前 Markdown 渲染与 HTML 清洗配置重新渲染过期的文章，并在配置变更时自动重新渲染
+	if _, err = rerenderPosts(false); err != nil {
+		global.SysLog.Fatalf("Failed to re-render posts: %v", err)
+	}
+	watchRenderConfig()
+
+	// 为尚未建立关键词索引的文章补建索引，用于相关文章推荐
+	if err = backfillPostTerms(); err != nil {
+		global.SysLog.Errorf("Failed to backfill post terms: %v", err)
+	}
+
 	InitAdminUser(config)
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Done-0/jank/configs"
 	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/base"
 	"github.com/Done-0/jank/internal/model/rbac"
 	"github.com/Done-0/jank/internal/model/user"
 )
@@ -18,7 +19,7 @@ import (
 // 如果数据库中没有用户，则创建默认的super_admin管理员账户
 func InitAdminUser(config *configs.Config) {
 	var userCount int64
-	if err := global.DB.Model(&user.User{}).Where("deleted = ?", false).Count(&userCount).Error; err != nil {
+	if err := global.DB.Model(&user.User{}).Scopes(base.NotDeleted).Count(&userCount).Error; err != nil {
 		global.SysLog.Errorf("Failed to count users: %v", err)
 		return
 	}
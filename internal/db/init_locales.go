@@ -0,0 +1,47 @@
+// Package db 提供历史数据语言与翻译组回填功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package db
+
+import (
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/utils/locale"
+)
+
+// backfillLocales 为引入多语言之前创建的文章和分类设置默认语言，并让每条记录自成一个翻译组
+// 使用 UpdateColumns 避免修改 gmt_modified，包含回收站中的记录以便恢复后同样可用
+// 返回值：
+//
+//	error: 错误信息
+func backfillLocales() error {
+	defaultLocale := locale.Default()
+
+	var locales, groups int64
+	for _, model := range []any{&post.Post{}, &category.Category{}} {
+		result := global.DB.Model(model).Where("locale = ?", "").UpdateColumn("locale", defaultLocale)
+		if result.Error != nil {
+			return fmt.Errorf("failed to backfill locales: %w", result.Error)
+		}
+		locales += result.RowsAffected
+
+		result = global.DB.Model(model).Where("translation_group_id = ?", 0).UpdateColumn("translation_group_id", gorm.Expr("id"))
+		if result.Error != nil {
+			return fmt.Errorf("failed to backfill translation groups: %w", result.Error)
+		}
+		groups += result.RowsAffected
+	}
+
+	if locales+groups > 0 {
+		log.Printf("Backfilled %d locales and %d translation groups...", locales, groups)
+		global.SysLog.Infof("Backfilled %d locales and %d translation groups...", locales, groups)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// Package db 提供文章 Markdown 重新渲染与 HTML 重新清洗功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/markdown"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	mapperImpl "github.com/Done-0/jank/pkg/serve/mapper/impl"
+)
+
+// rerenderMutex 保证同一时间只有一个重新渲染任务在执行
+var rerenderMutex sync.Mutex
+
+// watchRenderConfig 监听 Markdown 渲染与 HTML 清洗配置变更，变更后在后台重新渲染所有文章
+func watchRenderConfig() {
+	configs.OnChange(func(oldConfig, newConfig *configs.Config) {
+		if reflect.DeepEqual(oldConfig.MarkdownConfig, newConfig.MarkdownConfig) &&
+			reflect.DeepEqual(oldConfig.SanitizeConfig, newConfig.SanitizeConfig) {
+			return
+		}
+
+		go func() {
+			if _, err := rerenderPosts(false); err != nil {
+				global.SysLog.Errorf("Failed to re-render posts after render config change: %v", err)
+			}
+		}()
+	})
+}
+
+// ResanitizePosts 按作者当前角色重新渲染并清洗所有文章，用于调整角色的 html:trusted 权限后修正已有文章
+// 返回值：
+//
+//	int: 处理的文章数
+//	error: 错误信息
+func ResanitizePosts() (int, error) {
+	return rerenderPosts(true)
+}
+
+// rerenderPosts 使用当前配置重新渲染文章
+// 参数：
+//
+//	force: 是否重新渲染全部文章，为 false 时仅处理渲染版本过期的文章
+//
+// 返回值：
+//
+//	int: 处理的文章数
+//	error: 错误信息
+func rerenderPosts(force bool) (int, error) {
+	rerenderMutex.Lock()
+	defer rerenderMutex.Unlock()
+
+	options := markdown.CurrentOptions()
+	version := markdown.Version(options)
+	trusted := make(map[int64]bool)
+	rbacMapper := mapperImpl.NewRBACMapper()
+
+	var total int
+	var lastID int64
+	for {
+		query := global.DB.Select("id, author_id, markdown").Where("id > ?", lastID)
+		if !force {
+			query = query.Where("render_version IS NULL OR render_version <> ?", version)
+		}
+
+		var posts []*post.Post
+		if err := query.Order("id ASC").Limit(consts.PostRerenderBatchSize).Find(&posts).Error; err != nil {
+			return total, fmt.Errorf("failed to list posts to re-render: %w", err)
+		}
+		if len(posts) == 0 {
+			break
+		}
+
+		for _, p := range posts {
+			isTrusted, ok := trusted[p.AuthorID]
+			if !ok {
+				var err error
+				if isTrusted, err = trustedAuthor(rbacMapper, p.AuthorID); err != nil {
+					return total, fmt.Errorf("failed to check html permission for user %d: %w", p.AuthorID, err)
+				}
+				trusted[p.AuthorID] = isTrusted
+			}
+
+			if err := rerenderPost(p, options, isTrusted); err != nil {
+				return total, err
+			}
+		}
+		total += len(posts)
+		lastID = posts[len(posts)-1].ID
+	}
+
+	if total == 0 {
+		return 0, nil
+	}
+
+	log.Printf("Re-rendered %d posts with render version %s...", total, version)
+	global.SysLog.Infof("Re-rendered %d posts with render version %s...", total, version)
+
+	// 文章 HTML 已变化，订阅源等缓存需要失效
+	if global.RedisClient != nil {
+		if err := global.RedisClient.Incr(context.Background(), consts.SEOCacheVersionKey).Err(); err != nil {
+			global.SysLog.Warnf("failed to invalidate seo cache: %v", err)
+		}
+	}
+
+	return total, nil
+}
+
+// rerenderPost 重新渲染单篇文章，使用 UpdateColumns 避免修改 gmt_modified
+func rerenderPost(p *post.Post, options markdown.Options, trusted bool) error {
+	rendered, err := markdown.RenderWithOptions([]byte(p.Markdown), options, trusted)
+	if err != nil {
+		return fmt.Errorf("failed to render markdown for post %d: %w", p.ID, err)
+	}
+
+	toc, err := json.Marshal(rendered.TOC)
+	if err != nil {
+		return fmt.Errorf("failed to marshal toc for post %d: %w", p.ID, err)
+	}
+
+	return global.DB.Model(&post.Post{}).Where("id = ?", p.ID).UpdateColumns(map[string]any{
+		"html":           rendered.HTML,
+		"toc":            string(toc),
+		"render_version": rendered.Version,
+	}).Error
+}
+
+// trustedAuthor 检查作者是否拥有受信任 HTML 权限，与文章写入时使用同一 RBACMapper.CheckPermission
+// 参数：
+//
+//	rbacMapper: RBAC 数据访问
+//	authorID: 作者用户 ID，0 表示历史文章未记录作者
+//
+// 返回值：
+//
+//	bool: 是否受信任
+//	error: 错误信息
+func trustedAuthor(rbacMapper mapper.RBACMapper, authorID int64) (bool, error) {
+	if authorID == 0 {
+		return false, nil
+	}
+	return rbacMapper.CheckPermission(&app.RequestContext{}, strconv.FormatInt(authorID, 10), consts.HTMLTrustedResource, consts.HTMLTrustedAction)
+}
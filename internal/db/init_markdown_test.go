@@ -0,0 +1,44 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/rbac"
+	"github.com/Done-0/jank/internal/types/consts"
+	mapperImpl "github.com/Done-0/jank/pkg/serve/mapper/impl"
+)
+
+func TestTrustedAuthor(t *testing.T) {
+	setupKeysetDB(t)
+
+	require.NoError(t, global.DB.Create([]*rbac.Policy{
+		{Ptype: "p", V0: "1", V1: consts.HTMLTrustedResource, V2: consts.HTMLTrustedAction},
+		{Ptype: "g", V0: "2", V1: "editor"},
+		{Ptype: "p", V0: "editor", V1: consts.HTMLTrustedResource, V2: consts.HTMLTrustedAction},
+		{Ptype: "g", V0: "3", V1: "reader"},
+	}).Error)
+
+	rbacMapper := mapperImpl.NewRBACMapper()
+	tests := []struct {
+		name     string
+		authorID int64
+		want     bool
+	}{
+		{name: "direct permission", authorID: 1, want: true},
+		{name: "role permission", authorID: 2, want: true},
+		{name: "role without permission", authorID: 3, want: false},
+		{name: "unknown author", authorID: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trusted, err := trustedAuthor(rbacMapper, tt.authorID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, trusted)
+		})
+	}
+}
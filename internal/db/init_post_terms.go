@@ -0,0 +1,69 @@
+// Package db 提供文章关键词索引回填功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package db
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	mapperImpl "github.com/Done-0/jank/pkg/serve/mapper/impl"
+	serviceImpl "github.com/Done-0/jank/pkg/serve/service/impl"
+)
+
+// backfillPostTerms 为尚未建立关键词索引的文章建立索引，用于相关文章推荐
+// 与文章写入共用 serviceImpl.IndexPostTerms，索引完成后文章标记为已索引，未提取到关键词的文章也不会重复回填
+// 首轮建立索引时文档频率随索引推进逐步累积，因此对本次回填的文章再重建一轮，使其按完整的文档频率计算权重
+//
+// 返回值：
+//
+//	error: 错误信息
+func backfillPostTerms() error {
+	// 引入索引标记前已建立关键词的文章直接标记为已索引
+	if err := global.DB.Model(&post.Post{}).
+		Where("terms_indexed = ? AND id IN (?)", false, global.DB.Model(&post.PostTerm{}).Distinct("post_id")).
+		UpdateColumn("terms_indexed", true).Error; err != nil {
+		return fmt.Errorf("failed to mark indexed posts: %w", err)
+	}
+
+	var postIDs []int64
+	if err := global.DB.Model(&post.Post{}).
+		Scopes(base.NotDeleted).
+		Where("terms_indexed = ?", false).
+		Order("id ASC").
+		Pluck("id", &postIDs).Error; err != nil {
+		return fmt.Errorf("failed to list posts without terms: %w", err)
+	}
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	termMapper := mapperImpl.NewPostTermMapper()
+	for pass := 0; pass < 2; pass++ {
+		for start := 0; start < len(postIDs); start += consts.PostTermIndexBatchSize {
+			end := min(start+consts.PostTermIndexBatchSize, len(postIDs))
+
+			var posts []*post.Post
+			if err := global.DB.Select("id, title, markdown").Where("id IN ?", postIDs[start:end]).Find(&posts).Error; err != nil {
+				return fmt.Errorf("failed to list posts to index: %w", err)
+			}
+			for _, p := range posts {
+				c := &app.RequestContext{}
+				if _, err := db.RunDBTransaction(c, func() (any, error) {
+					return nil, serviceImpl.IndexPostTerms(c, termMapper, p)
+				}); err != nil {
+					return fmt.Errorf("failed to index terms of post %d: %w", p.ID, err)
+				}
+			}
+		}
+	}
+
+	global.SysLog.Infof("Indexed terms for %d posts...", len(postIDs))
+	return nil
+}
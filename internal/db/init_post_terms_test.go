@@ -0,0 +1,47 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+)
+
+func TestBackfillPostTerms(t *testing.T) {
+	setupKeysetDB(t)
+
+	golang := &post.Post{Title: "Go concurrency", Markdown: "goroutines and channels"}
+	empty := &post.Post{Title: "!!!", Markdown: "2024"}
+	legacy := &post.Post{Title: "Rust ownership", Markdown: "borrow checker"}
+	require.NoError(t, global.DB.Create([]*post.Post{golang, empty, legacy}).Error)
+	// 引入索引标记前已建立关键词的文章
+	require.NoError(t, global.DB.Create(&post.PostTerm{PostID: legacy.ID, Term: "legacy", Weight: 1}).Error)
+
+	require.NoError(t, backfillPostTerms())
+
+	var indexed []int64
+	require.NoError(t, global.DB.Model(&post.Post{}).Where("terms_indexed = ?", true).Order("id").Pluck("id", &indexed).Error)
+	assert.ElementsMatch(t, []int64{golang.ID, empty.ID, legacy.ID}, indexed)
+
+	var terms []string
+	require.NoError(t, global.DB.Model(&post.PostTerm{}).Where("post_id = ?", golang.ID).Order("term").Pluck("term", &terms).Error)
+	assert.Equal(t, []string{"channels", "concurrency", "go", "goroutines"}, terms)
+
+	var count int64
+	require.NoError(t, global.DB.Model(&post.PostTerm{}).Where("post_id = ?", empty.ID).Count(&count).Error)
+	assert.Zero(t, count)
+	require.NoError(t, global.DB.Model(&post.PostTerm{}).Where("post_id = ? AND term = ?", legacy.ID, "legacy").Count(&count).Error)
+	assert.Equal(t, int64(1), count, "already indexed posts are not rebuilt")
+
+	// 再次回填时没有待索引的文章，已建立的关键词保持不变
+	require.NoError(t, global.DB.Model(&post.PostTerm{}).Where("post_id = ?", golang.ID).Update("weight", 0.5).Error)
+	require.NoError(t, backfillPostTerms())
+	var weights []float64
+	require.NoError(t, global.DB.Model(&post.PostTerm{}).Where("post_id = ?", golang.ID).Pluck("weight", &weights).Error)
+	for _, w := range weights {
+		assert.Equal(t, 0.5, w)
+	}
+}
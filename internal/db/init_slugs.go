@@ -0,0 +1,69 @@
+// Package db 提供历史数据 slug 回填功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package db
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/slugify"
+)
+
+// backfillSlugs 为引入 slug 之前创建的文章和分类生成 slug
+// 回收站中的记录 slug 已被释放，恢复时取回原 slug，不参与回填
+// 返回值：
+//
+//	error: 错误信息
+func backfillSlugs() error {
+	var posts []*post.Post
+	if err := global.DB.Select("id, title").Scopes(base.NotDeleted).Where("slug IS NULL OR slug = ?", "").Find(&posts).Error; err != nil {
+		return fmt.Errorf("failed to list posts without slug: %w", err)
+	}
+	for _, p := range posts {
+		if err := backfillSlug(&post.Post{}, p.ID, p.Title, consts.SlugEntityPost); err != nil {
+			return err
+		}
+	}
+
+	var categories []*category.Category
+	if err := global.DB.Select("id, name").Scopes(base.NotDeleted).Where("slug IS NULL OR slug = ?", "").Find(&categories).Error; err != nil {
+		return fmt.Errorf("failed to list categories without slug: %w", err)
+	}
+	for _, cat := range categories {
+		if err := backfillSlug(&category.Category{}, cat.ID, cat.Name, consts.SlugEntityCategory); err != nil {
+			return err
+		}
+	}
+
+	if len(posts)+len(categories) > 0 {
+		log.Printf("Backfilled slugs for %d posts and %d categories...", len(posts), len(categories))
+		global.SysLog.Infof("Backfilled slugs for %d posts and %d categories...", len(posts), len(categories))
+	}
+
+	return nil
+}
+
+// backfillSlug 为单条记录生成唯一 slug 并写入，使用 UpdateColumn 避免修改 gmt_modified
+func backfillSlug(model any, id int64, text, fallback string) error {
+	base := slugify.Make(text)
+	if base == "" {
+		base = fallback
+	}
+
+	s, err := slugify.Unique(base, func(candidate string) (bool, error) {
+		var count int64
+		err := global.DB.Model(model).Where("slug = ?", candidate).Count(&count).Error
+		return count > 0, err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate slug for %s %d: %w", fallback, id, err)
+	}
+
+	return global.DB.Model(model).Where("id = ?", id).UpdateColumn("slug", s).Error
+}
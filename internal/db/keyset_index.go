@@ -0,0 +1,46 @@
+// Package db 提供键集分页复合索引初始化功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package db
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Done-0/jank/internal/global"
+)
+
+// keysetIndex 键集分页使用的复合索引
+type keysetIndex struct {
+	Table   string // 表名
+	Name    string // 索引名称
+	Columns string // 索引列，排序方向与列表排序一致
+}
+
+// keysetIndexes 游标分页列表所需的复合索引
+// 单列索引无法同时满足筛选与排序，深翻页时会退化为排序整个结果集
+var keysetIndexes = []keysetIndex{
+	{Table: "posts", Name: "idx_posts_status_id", Columns: "status, id"},                // 按状态筛选的文章列表按 ID 倒序翻页
+	{Table: "categories", Name: "idx_categories_sort_id", Columns: "sort DESC, id ASC"}, // 分类列表按排序权重倒序、ID 正序翻页
+}
+
+// ensureKeysetIndexes 创建键集分页使用的复合索引
+// MySQL 不支持 CREATE INDEX IF NOT EXISTS，因此先检查索引是否存在，三种数据库使用同一建索引语句
+// 返回值：
+//
+//	error: 错误信息
+func ensureKeysetIndexes() error {
+	for _, idx := range keysetIndexes {
+		if global.DB.Migrator().HasIndex(idx.Table, idx.Name) {
+			continue
+		}
+		if err := global.DB.Exec(fmt.Sprintf("CREATE INDEX %s ON %s (%s)", idx.Name, idx.Table, idx.Columns)).Error; err != nil {
+			return fmt.Errorf("failed to create index %s: %w", idx.Name, err)
+		}
+	}
+
+	log.Println("Keyset pagination indexes ensured successfully...")
+	global.SysLog.Info("Keyset pagination indexes ensured successfully...")
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/utils/cursor"
+	"github.com/Done-0/jank/pkg/serve/mapper/impl"
+)
+
+// sqlRecorder 记录最近一条执行的 SQL（参数已内联），用于对实际执行的查询做 EXPLAIN
+type sqlRecorder struct {
+	logger.Interface
+	last string
+}
+
+func (r *sqlRecorder) LogMode(logger.LogLevel) logger.Interface { return r }
+
+func (r *sqlRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	r.last, _ = fc()
+}
+
+func setupKeysetDB(t *testing.T) *sqlRecorder {
+	t.Helper()
+
+	recorder := &sqlRecorder{Interface: logger.Discard}
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: recorder})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	global.DB = db
+	global.SysLog = logrus.New()
+	global.SysLog.SetLevel(logrus.ErrorLevel)
+	require.NoError(t, autoMigrate())
+	require.NoError(t, ensureKeysetIndexes())
+	// 索引已存在时应直接跳过
+	require.NoError(t, ensureKeysetIndexes())
+	return recorder
+}
+
+// queryPlan 返回 SQLite 查询计划的各步骤描述
+func queryPlan(t *testing.T, query string) string {
+	t.Helper()
+
+	rows, err := global.DB.Raw("EXPLAIN QUERY PLAN " + query).Rows()
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var details []string
+	for rows.Next() {
+		var id, parent, notUsed int
+		var detail string
+		require.NoError(t, rows.Scan(&id, &parent, &notUsed, &detail))
+		details = append(details, detail)
+	}
+	return strings.Join(details, "\n")
+}
+
+func TestKeysetIndexPlans(t *testing.T) {
+	recorder := setupKeysetDB(t)
+	c := &app.RequestContext{}
+	postMapper, categoryMapper, userMapper := impl.NewPostMapper(), impl.NewCategoryMapper(), impl.NewUserMapper()
+
+	tests := []struct {
+		name  string
+		run   func() error
+		index string // 期望使用的索引，为空时仅要求无需额外排序
+	}{
+		{
+			name: "posts by status",
+			run: func() error {
+				_, _, err := postMapper.ListPostsByStatusWithCursor(c, &cursor.Cursor{Keys: []int64{100}}, 10, false, "published", nil, "")
+				return err
+			},
+			index: "idx_posts_status_id",
+		},
+		{
+			name: "posts by status backward",
+			run: func() error {
+				_, _, err := postMapper.ListPostsByStatusWithCursor(c, &cursor.Cursor{Keys: []int64{100}, Backward: true}, 10, false, "draft", nil, "")
+				return err
+			},
+			index: "idx_posts_status_id",
+		},
+		{
+			name: "all posts",
+			run: func() error {
+				_, _, err := postMapper.ListPostsByStatusWithCursor(c, &cursor.Cursor{Keys: []int64{100}}, 10, false, "", nil, "")
+				return err
+			},
+		},
+		{
+			name: "categories",
+			run: func() error {
+				_, _, err := categoryMapper.ListCategoriesWithCursor(c, &cursor.Cursor{Keys: []int64{100, 5}}, 10, false, nil, nil, nil)
+				return err
+			},
+			index: "idx_categories_sort_id",
+		},
+		{
+			name: "categories backward",
+			run: func() error {
+				_, _, err := categoryMapper.ListCategoriesWithCursor(c, &cursor.Cursor{Keys: []int64{100, 5}, Backward: true}, 10, false, nil, nil, nil)
+				return err
+			},
+			index: "idx_categories_sort_id",
+		},
+		{
+			name: "users",
+			run: func() error {
+				_, _, err := userMapper.ListUsersWithCursor(c, &cursor.Cursor{Keys: []int64{100}}, 10, false, "", "")
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.run())
+			query := recorder.last
+			require.Contains(t, query, "LIMIT")
+
+			plan := queryPlan(t, query)
+			t.Log(query + "\n" + plan)
+			assert.NotContains(t, plan, "TEMP B-TREE", "keyset query should not sort the result set")
+			if tt.index != "" {
+				assert.Contains(t, plan, tt.index)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Package db 提供文章全文检索索引初始化功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package db
+
+import (
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+// postgresSearchVector PostgreSQL 加权检索向量表达式，标题 > 摘要 > 正文
+const postgresSearchVector = "setweight(to_tsvector('simple', coalesce(title, '')), 'A') || " +
+	"setweight(to_tsvector('simple', coalesce(description, '')), 'B') || " +
+	"setweight(to_tsvector('simple', coalesce(markdown, '')), 'C')"
+
+// ensureSearchIndex 根据数据库类型创建文章全文检索索引
+// 索引均由数据库自身维护（生成列、全文索引或触发器），文章增删改时自动保持同步
+// 参数：
+//
+//	dialect: 数据库类型
+//
+// 返回值：
+//
+//	error: 错误信息
+func ensureSearchIndex(dialect string) error {
+	var err error
+	switch dialect {
+	case DIALECT_POSTGRES:
+		err = ensurePostgresSearchIndex()
+	case DIALECT_MYSQL:
+		err = ensureMySQLSearchIndex()
+	case DIALECT_SQLITE:
+		// SQLite 需要以 sqlite_fts5 构建标签编译驱动才支持 FTS5，不支持时降级为 LIKE 检索
+		if err = ensureSQLiteSearchIndex(); err != nil {
+			global.SysLog.Warnf("SQLite FTS5 unavailable, post search falls back to LIKE matching: %v", err)
+			return nil
+		}
+	default:
+		return fmt.Errorf("unsupported database dialect: %s", dialect)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to ensure post search index: %w", err)
+	}
+
+	log.Println("Post search index ensured successfully...")
+	global.SysLog.Info("Post search index ensured successfully...")
+
+	return nil
+}
+
+// ensurePostgresSearchIndex 创建 tsvector 生成列及 GIN 索引
+func ensurePostgresSearchIndex() error {
+	if err := global.DB.Exec(fmt.Sprintf(
+		"ALTER TABLE posts ADD COLUMN IF NOT EXISTS %s tsvector GENERATED ALWAYS AS (%s) STORED",
+		consts.PostSearchVectorColumn, postgresSearchVector,
+	)).Error; err != nil {
+		return err
+	}
+
+	return global.DB.Exec(fmt.Sprintf(
+		"CREATE INDEX IF NOT EXISTS %s ON posts USING GIN (%s)",
+		consts.PostFullTextIndex, consts.PostSearchVectorColumn,
+	)).Error
+}
+
+// ensureMySQLSearchIndex 创建基于 ngram 解析器的 FULLTEXT 索引
+func ensureMySQLSearchIndex() error {
+	if global.DB.Migrator().HasIndex("posts", consts.PostFullTextIndex) {
+		return nil
+	}
+
+	return global.DB.Exec(fmt.Sprintf(
+		"ALTER TABLE posts ADD FULLTEXT INDEX %s (title, description, markdown) WITH PARSER ngram",
+		consts.PostFullTextIndex,
+	)).Error
+}
+
+// ensureSQLiteSearchIndex 创建 FTS5 虚拟表、同步触发器并回填已有文章
+func ensureSQLiteSearchIndex() error {
+	statements := []string{
+		fmt.Sprintf("CREATE VIRTUAL TABLE IF NOT EXISTS %s USING fts5(title, description, markdown, tokenize = 'trigram')", consts.PostFTSTable),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %[1]s_ai AFTER INSERT ON posts BEGIN
+	INSERT INTO %[1]s(rowid, title, description, markdown) VALUES (new.id, new.title, new.description, new.markdown);
+END`, consts.PostFTSTable),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %[1]s_au AFTER UPDATE ON posts BEGIN
+	DELETE FROM %[1]s WHERE rowid = old.id;
+	INSERT INTO %[1]s(rowid, title, description, markdown) VALUES (new.id, new.title, new.description, new.markdown);
+END`, consts.PostFTSTable),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %[1]s_ad AFTER DELETE ON posts BEGIN
+	DELETE FROM %[1]s WHERE rowid = old.id;
+END`, consts.PostFTSTable),
+		fmt.Sprintf("INSERT INTO %[1]s(rowid, title, description, markdown) SELECT id, title, description, markdown FROM posts WHERE id NOT IN (SELECT rowid FROM %[1]s)", consts.PostFTSTable),
+	}
+
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		for _, stmt := range statements {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
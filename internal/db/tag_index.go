@@ -0,0 +1,61 @@
+// Package db 提供标签名称唯一索引初始化功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package db
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+// 标签名称索引常量
+const (
+	tagNameUniqueIndex = "idx_tags_name_unique" // 标签名称唯一索引
+	tagNameLegacyIndex = "idx_tags_name"        // 引入唯一索引前的普通索引，已被唯一索引覆盖
+)
+
+// ensureTagNameIndex 为标签名称创建唯一索引
+// 标签名称在写入前已规范化，回收站中的标签名称替换为占位值，因此唯一索引仅约束未删除的标签
+// 引入唯一索引前删除的标签仍保留原名称，建索引前先释放
+// 返回值：
+//
+//	error: 错误信息
+func ensureTagNameIndex() error {
+	migrator := global.DB.Migrator()
+	if migrator.HasIndex(&tag.Tag{}, tagNameUniqueIndex) {
+		return nil
+	}
+
+	var trashed []*tag.Tag
+	if err := global.DB.Select("id, name").Scopes(base.OnlyDeleted).Find(&trashed).Error; err != nil {
+		return fmt.Errorf("failed to list trashed tags: %w", err)
+	}
+	for _, t := range trashed {
+		placeholder := fmt.Sprintf(consts.TagTrashedNameFormat, t.ID)
+		if t.Name == placeholder {
+			continue
+		}
+		if err := global.DB.Model(&tag.Tag{}).Where("id = ?", t.ID).UpdateColumn("name", placeholder).Error; err != nil {
+			return fmt.Errorf("failed to release name of trashed tag %d: %w", t.ID, err)
+		}
+	}
+
+	if err := global.DB.Exec(fmt.Sprintf("CREATE UNIQUE INDEX %s ON tags (name)", tagNameUniqueIndex)).Error; err != nil {
+		return fmt.Errorf("failed to create index %s, merge tags with duplicate names and restart: %w", tagNameUniqueIndex, err)
+	}
+	if migrator.HasIndex(&tag.Tag{}, tagNameLegacyIndex) {
+		if err := migrator.DropIndex(&tag.Tag{}, tagNameLegacyIndex); err != nil {
+			return fmt.Errorf("failed to drop index %s: %w", tagNameLegacyIndex, err)
+		}
+	}
+
+	log.Println("Tag name unique index ensured successfully...")
+	global.SysLog.Info("Tag name unique index ensured successfully...")
+
+	return nil
+}
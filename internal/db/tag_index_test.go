@@ -0,0 +1,37 @@
+package db
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+func TestEnsureTagNameIndex(t *testing.T) {
+	setupKeysetDB(t)
+	require.NoError(t, global.DB.Exec(fmt.Sprintf("CREATE INDEX %s ON tags (name)", tagNameLegacyIndex)).Error)
+
+	// 引入唯一索引前删除的标签仍保留原名称
+	trashed := &tag.Tag{Name: "go"}
+	trashed.Deleted = true
+	active := &tag.Tag{Name: "go"}
+	require.NoError(t, global.DB.Create(trashed).Error)
+	require.NoError(t, global.DB.Create(active).Error)
+
+	require.NoError(t, ensureTagNameIndex())
+	require.NoError(t, ensureTagNameIndex())
+	assert.True(t, global.DB.Migrator().HasIndex(&tag.Tag{}, tagNameUniqueIndex))
+	assert.False(t, global.DB.Migrator().HasIndex(&tag.Tag{}, tagNameLegacyIndex))
+
+	var released tag.Tag
+	require.NoError(t, global.DB.First(&released, trashed.ID).Error)
+	assert.Equal(t, fmt.Sprintf(consts.TagTrashedNameFormat, trashed.ID), released.Name)
+
+	assert.Error(t, global.DB.Create(&tag.Tag{Name: "go"}).Error)
+	assert.NoError(t, global.DB.Create(&tag.Tag{Name: "rust"}).Error)
+}
@@ -39,10 +39,12 @@ func New() app.HandlerFunc {
 			consts.HeaderAccept,
 			consts.HeaderAuthorization,
 			consts.HeaderXRequestedWith,
+			consts.HeaderIfMatch,
 		},
 		ExposeHeaders: []string{
 			consts.HeaderContentLength,
 			consts.HeaderAuthorization,
+			consts.HeaderETag,
 		},
 		AllowCredentials: cfgs.AppConfig.CORSConfig.AllowCredentials,
 		MaxAge:           time.Duration(cfgs.AppConfig.CORSConfig.MaxAge) * time.Hour,
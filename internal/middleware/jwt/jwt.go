@@ -87,3 +87,16 @@ func New() app.HandlerFunc {
 
 	return authMiddleware.MiddlewareFunc()
 }
+
+// NewOptional 创建可选 JWT 认证中间件
+// 请求携带 Authorization 头时按 New 的规则校验并注入用户 ID，未携带时直接放行，供匿名与登录用户共用的接口使用
+func NewOptional() app.HandlerFunc {
+	authHandler := New()
+	return func(ctx context.Context, c *app.RequestContext) {
+		if len(c.GetHeader(constants.HeaderAuthorization)) == 0 {
+			c.Next(ctx)
+			return
+		}
+		authHandler(ctx, c)
+	}
+}
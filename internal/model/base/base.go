@@ -21,6 +21,7 @@ type Base struct {
 	GmtModified int64   `gorm:"type:bigint" json:"gmt_modified"`           // 更新时间
 	Ext         JSONMap `gorm:"type:json" json:"ext"`                      // 扩展字段
 	Deleted     bool    `gorm:"type:boolean;default:false" json:"deleted"` // 逻辑删除
+	GmtDeleted  int64   `gorm:"type:bigint;default:0" json:"gmt_deleted"`  // 删除时间，0 表示未删除或删除时间未记录
 }
 
 // JSONMap 处理 JSON 类型字段
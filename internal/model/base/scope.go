@@ -0,0 +1,127 @@
+// Package base 提供软删除查询作用域与回收站辅助函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package base
+
+import (
+	"maps"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// trashedExtKeyPrefix 软删除时释放的唯一列原值在扩展字段中的键名前缀
+const trashedExtKeyPrefix = "trashed_"
+
+// ReleasedColumn 软删除时需要释放的唯一列
+type ReleasedColumn struct {
+	Name        string // 列名
+	Value       string // 原值，暂存到扩展字段
+	Placeholder any    // 删除期间的占位值，可为 nil 的列使用 nil
+}
+
+// NotDeleted 查询作用域：仅包含未删除的记录，联表查询时限定主表
+func NotDeleted(db *gorm.DB) *gorm.DB {
+	return db.Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "deleted"}, Value: false})
+}
+
+// OnlyDeleted 查询作用域：仅包含已删除（回收站中）的记录
+func OnlyDeleted(db *gorm.DB) *gorm.DB {
+	return db.Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "deleted"}, Value: true})
+}
+
+// NotDeletedIn 联表查询作用域：限定指定表的记录未删除
+// 参数：
+//
+//	table: 表名
+//
+// 返回值：
+//
+//	func(*gorm.DB) *gorm.DB: 查询作用域
+func NotDeletedIn(table string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(clause.Eq{Column: clause.Column{Table: table, Name: "deleted"}, Value: false})
+	}
+}
+
+// SoftDeleteColumns 返回软删除需要更新的列：标记删除、记录删除时间，并释放唯一列
+// 唯一列的原值暂存到扩展字段，恢复时由 TrashedValue 取回
+// 参数：
+//
+//	ext: 记录当前的扩展字段
+//	released: 需要释放的唯一列，原值为空的列不做处理
+//
+// 返回值：
+//
+//	map[string]any: 需要更新的列
+func SoftDeleteColumns(ext JSONMap, released ...ReleasedColumn) map[string]any {
+	columns := map[string]any{
+		"deleted":     true,
+		"gmt_deleted": time.Now().Unix(),
+	}
+
+	stashed := maps.Clone(ext)
+	if stashed == nil {
+		stashed = make(JSONMap)
+	}
+	changed := false
+	for _, col := range released {
+		if col.Value == "" {
+			continue
+		}
+		stashed[trashedExtKeyPrefix+col.Name] = col.Value
+		columns[col.Name] = col.Placeholder
+		changed = true
+	}
+	if changed {
+		columns["ext"] = stashed
+	}
+
+	return columns
+}
+
+// RestoreColumns 返回从回收站恢复需要更新的列：清除删除标记与删除时间，写回唯一列并移除暂存的原值
+// 参数：
+//
+//	ext: 记录当前的扩展字段
+//	restored: 恢复后的唯一列取值
+//
+// 返回值：
+//
+//	map[string]any: 需要更新的列
+func RestoreColumns(ext JSONMap, restored map[string]any) map[string]any {
+	columns := map[string]any{
+		"deleted":     false,
+		"gmt_deleted": 0,
+	}
+
+	stashed := maps.Clone(ext)
+	changed := false
+	for name, value := range restored {
+		columns[name] = value
+		if _, ok := stashed[trashedExtKeyPrefix+name]; ok {
+			delete(stashed, trashedExtKeyPrefix+name)
+			changed = true
+		}
+	}
+	if changed {
+		columns["ext"] = stashed
+	}
+
+	return columns
+}
+
+// TrashedValue 取回软删除时暂存的唯一列原值
+// 参数：
+//
+//	ext: 记录的扩展字段
+//	column: 列名
+//
+// 返回值：
+//
+//	string: 原值，未暂存时返回空字符串
+func TrashedValue(ext JSONMap, column string) string {
+	value, _ := ext[trashedExtKeyPrefix+column].(string)
+	return value
+}
@@ -10,11 +10,14 @@ import (
 // Category 分类模型
 type Category struct {
 	base.Base
-	Name        string `gorm:"type:varchar(100);not null;index" json:"name"`              // 分类名称
-	Description string `gorm:"type:varchar(500)" json:"description"`                      // 分类描述（可选）
-	ParentID    int64  `gorm:"type:bigint;not null;default:0;index" json:"parent_id"`     // 父分类 ID，0 表示顶级分类
-	Sort        int64  `gorm:"type:bigint;not null;default:100;index" json:"sort"`        // 排序权重，数字越大越靠前
-	IsActive    bool   `gorm:"type:boolean;not null;default:true;index" json:"is_active"` // 是否启用
+	Name               string `gorm:"type:varchar(100);not null;index" json:"name"`                     // 分类名称
+	Slug               string `gorm:"type:varchar(255);uniqueIndex;default:null" json:"slug"`           // URL slug，全局唯一
+	Description        string `gorm:"type:varchar(500)" json:"description"`                             // 分类描述（可选）
+	ParentID           int64  `gorm:"type:bigint;not null;default:0;index" json:"parent_id"`            // 父分类 ID，0 表示顶级分类
+	Sort               int64  `gorm:"type:bigint;not null;default:100;index" json:"sort"`               // 排序权重，数字越大越靠前
+	IsActive           bool   `gorm:"type:boolean;not null;default:true;index" json:"is_active"`        // 是否启用
+	Locale             string `gorm:"type:varchar(16);not null;default:'';index" json:"locale"`         // 语言（BCP 47 语言标签），如 zh-CN、en
+	TranslationGroupID int64  `gorm:"type:bigint;not null;default:0;index" json:"translation_group_id"` // 翻译组 ID，同一分类的各语言版本共用，组内每种语言至多一个
 }
 
 // TableName 指定表名
@@ -0,0 +1,29 @@
+// Package comment 提供评论数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package comment
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Comment 评论模型
+type Comment struct {
+	base.Base
+	PostID      int64  `gorm:"type:bigint;not null;index" json:"post_id"`                       // 所属文章 ID
+	ParentID    int64  `gorm:"type:bigint;not null;default:0;index" json:"parent_id"`           // 父评论 ID，0 表示顶级评论
+	UserID      *int64 `gorm:"type:bigint;index" json:"user_id"`                                // 评论用户 ID，NULL 表示匿名评论
+	AuthorName  string `gorm:"type:varchar(64)" json:"author_name"`                             // 匿名评论者昵称
+	AuthorEmail string `gorm:"type:varchar(64)" json:"author_email"`                            // 匿名评论者邮箱
+	Content     string `gorm:"type:text;not null" json:"content"`                               // 评论内容
+	Status      string `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"` // 审核状态
+	IP          string `gorm:"type:varchar(64)" json:"ip"`                                      // 评论者 IP
+	UserAgent   string `gorm:"type:varchar(255)" json:"user_agent"`                             // 评论者 User-Agent
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Comment) TableName() string {
+	return "comments"
+}
@@ -5,8 +5,13 @@ package model
 
 import (
 	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/model/comment"
+	"github.com/Done-0/jank/internal/model/media"
 	"github.com/Done-0/jank/internal/model/post"
 	"github.com/Done-0/jank/internal/model/rbac"
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/model/slug"
+	"github.com/Done-0/jank/internal/model/tag"
 	"github.com/Done-0/jank/internal/model/user"
 )
 
@@ -16,9 +21,22 @@ import (
 //	[]any: 所有模型列表
 func GetAllModels() []any {
 	return []any{
-		&user.User{},         // 用户模型
-		&rbac.Policy{},       // RBAC策略模型
-		&post.Post{},         // 文章模型
-		&category.Category{}, // 分类模型
+		&user.User{},              // 用户模型
+		&rbac.Policy{},            // RBAC策略模型
+		&post.Post{},              // 文章模型
+		&post.PostRevision{},      // 文章修订模型
+		&post.PostStat{},          // 文章浏览统计模型
+		&post.PostReferrerStat{},  // 文章来源统计模型
+		&post.PostReaction{},      // 文章表态模型
+		&post.PostReactionCount{}, // 文章表态计数模型
+		&post.PostTerm{},          // 文章关键词模型
+		&series.Series{},          // 文章系列模型
+		&series.SeriesPost{},      // 系列文章关联模型
+		&category.Category{},      // 分类模型
+		&comment.Comment{},        // 评论模型
+		&tag.Tag{},                // 标签模型
+		&tag.PostTag{},            // 文章标签关联模型
+		&slug.SlugHistory{},       // slug 历史模型
+		&media.Media{},            // 媒体文件模型
 	}
 }
@@ -0,0 +1,28 @@
+// Package media 提供媒体文件数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package media
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Media 媒体文件模型
+type Media struct {
+	base.Base
+	UploaderID int64  `gorm:"type:bigint;not null;index" json:"uploader_id"` // 上传者用户 ID
+	FileName   string `gorm:"type:varchar(255)" json:"file_name"`            // 原始文件名
+	MimeType   string `gorm:"type:varchar(100);not null" json:"mime_type"`   // 按文件内容识别的 MIME 类型
+	Size       int64  `gorm:"type:bigint;not null" json:"size"`              // 文件大小（字节）
+	Hash       string `gorm:"type:char(64);not null;index" json:"hash"`      // 文件内容 SHA-256，用于去重
+	Storage    string `gorm:"type:varchar(20);not null" json:"storage"`      // 存储后端类型
+	StorageKey string `gorm:"type:varchar(255);not null" json:"storage_key"` // 存储后端中的文件 key
+	URL        string `gorm:"type:varchar(500);not null" json:"url"`         // 文件访问地址
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Media) TableName() string {
+	return "media"
+}
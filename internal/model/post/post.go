@@ -10,13 +10,29 @@ import (
 // Post 文章模型
 type Post struct {
 	base.Base
-	Title       string `gorm:"type:varchar(255);not null;index" json:"title"`                 // 标题
-	Description string `gorm:"type:varchar(500)" json:"description"`                          // 文章描述/摘要（可选）
-	Image       string `gorm:"type:varchar(255)" json:"image"`                                // 图片
-	Status      string `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"` // 文章状态
-	CategoryID  *int64 `gorm:"type:bigint;index" json:"category_id"`                          // 分类 ID，NULL表示未分类
-	Markdown    string `gorm:"type:text" json:"Markdown"`                                     // Markdown 内容
-	HTML        string `gorm:"type:text" json:"Html"`                                         // 渲染后的 HTML 内容
+	Title              string `gorm:"type:varchar(255);not null;index" json:"title"`                      // 标题
+	Slug               string `gorm:"type:varchar(255);uniqueIndex;default:null" json:"slug"`             // URL slug，全局唯一
+	Description        string `gorm:"type:varchar(500)" json:"description"`                               // 文章描述/摘要（可选）
+	Image              string `gorm:"type:varchar(255)" json:"image"`                                     // 图片
+	Status             string `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`      // 文章状态
+	PublishAt          *int64 `gorm:"type:bigint;index" json:"publish_at"`                                // 定时发布时间（Unix 秒），NULL 表示未设置定时发布
+	Visibility         string `gorm:"type:varchar(20);not null;default:'public';index" json:"visibility"` // 访问方式：public 公开、password 密码保护、unlisted 仅链接可见
+	PasswordHash       string `gorm:"type:varchar(255)" json:"-"`                                         // 访问密码的 bcrypt 哈希，仅密码保护文章使用
+	CategoryID         *int64 `gorm:"type:bigint;index" json:"category_id"`                               // 分类 ID，NULL表示未分类
+	AuthorID           int64  `gorm:"type:bigint;not null;default:0;index" json:"author_id"`              // 作者用户 ID，0 表示历史文章未记录作者
+	Markdown           string `gorm:"type:text" json:"Markdown"`                                          // Markdown 内容
+	HTML               string `gorm:"type:text" json:"Html"`                                              // 渲染后的 HTML 内容
+	TOC                string `gorm:"type:text" json:"toc"`                                               // 文章目录（JSON），随 HTML 一同生成
+	RenderVersion      string `gorm:"type:varchar(32);index" json:"render_version"`                       // 生成 HTML 时的渲染配置版本，与当前配置不一致时需重新渲染
+	Pinned             bool   `gorm:"type:boolean;not null;default:false;index" json:"pinned"`            // 是否置顶，置顶文章在公开列表中始终排在最前
+	PinnedUntil        *int64 `gorm:"type:bigint" json:"pinned_until"`                                    // 置顶到期时间（Unix 秒），NULL 表示永久置顶
+	PinnedWeight       int64  `gorm:"type:bigint;not null;default:0" json:"pinned_weight"`                // 置顶排序权重，数字越大越靠前
+	Featured           bool   `gorm:"type:boolean;not null;default:false;index" json:"featured"`          // 是否精选
+	FeaturedWeight     int64  `gorm:"type:bigint;not null;default:0" json:"featured_weight"`              // 精选排序权重，数字越大越靠前
+	Version            int64  `gorm:"type:bigint;not null;default:1" json:"version"`                      // 乐观锁版本号，每次编辑加一，用于检测并发编辑冲突
+	Locale             string `gorm:"type:varchar(16);not null;default:'';index" json:"locale"`           // 语言（BCP 47 语言标签），如 zh-CN、en
+	TranslationGroupID int64  `gorm:"type:bigint;not null;default:0;index" json:"translation_group_id"`   // 翻译组 ID，同一文章的各语言版本共用，组内每种语言至多一篇
+	TermsIndexed       bool   `gorm:"type:boolean;not null;default:false;index" json:"terms_indexed"`     // 是否已建立关键词索引（含未提取到关键词的文章），为 false 时启动时回填
 }
 
 // TableName 指定表名
@@ -0,0 +1,38 @@
+// Package post 提供文章互动表态数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package post
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// PostReaction 登录用户的文章表态模型，同一用户对同一文章的每种表态只记录一次；匿名访客的表态仅在 Redis 中去重，不落库
+type PostReaction struct {
+	base.Base
+	PostID int64  `gorm:"type:bigint;not null;uniqueIndex:idx_post_reactions_post_user_type" json:"post_id"`       // 文章 ID
+	UserID int64  `gorm:"type:bigint;not null;uniqueIndex:idx_post_reactions_post_user_type;index" json:"user_id"` // 用户 ID
+	Type   string `gorm:"type:varchar(32);not null;uniqueIndex:idx_post_reactions_post_user_type" json:"type"`     // 表态类型
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (PostReaction) TableName() string {
+	return "post_reactions"
+}
+
+// PostReactionCount 文章表态计数模型，汇总登录用户与匿名访客的表态数量
+type PostReactionCount struct {
+	base.Base
+	PostID int64  `gorm:"type:bigint;not null;uniqueIndex:idx_post_reaction_counts_post_type" json:"post_id"`   // 文章 ID
+	Type   string `gorm:"type:varchar(32);not null;uniqueIndex:idx_post_reaction_counts_post_type" json:"type"` // 表态类型
+	Count  int64  `gorm:"type:bigint;not null;default:0" json:"count"`                                          // 表态数量
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (PostReactionCount) TableName() string {
+	return "post_reaction_counts"
+}
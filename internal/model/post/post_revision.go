@@ -0,0 +1,24 @@
+// Package post 提供文章修订历史数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package post
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// PostRevision 文章修订模型，每次创建、更新或恢复文章时记录一份内容快照，修订时间即 GmtCreated
+type PostRevision struct {
+	base.Base
+	PostID   int64  `gorm:"type:bigint;not null;index" json:"post_id"`       // 文章 ID
+	Title    string `gorm:"type:varchar(255);not null" json:"title"`         // 标题快照
+	Markdown string `gorm:"type:text" json:"markdown"`                       // Markdown 内容快照
+	EditorID int64  `gorm:"type:bigint;not null;default:0" json:"editor_id"` // 编辑者用户 ID
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (PostRevision) TableName() string {
+	return "post_revisions"
+}
@@ -0,0 +1,39 @@
+// Package post 提供文章浏览统计数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package post
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// PostStat 文章每日浏览统计模型，由后台任务从 Redis HyperLogLog 汇总写入，浏览量为当日去重访客数
+type PostStat struct {
+	base.Base
+	PostID int64  `gorm:"type:bigint;not null;uniqueIndex:idx_post_stats_post_date" json:"post_id"`         // 文章 ID
+	Date   string `gorm:"type:varchar(10);not null;uniqueIndex:idx_post_stats_post_date;index" json:"date"` // 统计日期，格式 2006-01-02
+	Views  int64  `gorm:"type:bigint;not null;default:0" json:"views"`                                      // 当日去重浏览量
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (PostStat) TableName() string {
+	return "post_stats"
+}
+
+// PostReferrerStat 文章每日来源统计模型，仅统计当日首次访问的来源
+type PostReferrerStat struct {
+	base.Base
+	PostID   int64  `gorm:"type:bigint;not null;uniqueIndex:idx_post_referrer_stats_post_date_referrer" json:"post_id"`         // 文章 ID
+	Date     string `gorm:"type:varchar(10);not null;uniqueIndex:idx_post_referrer_stats_post_date_referrer;index" json:"date"` // 统计日期，格式 2006-01-02
+	Referrer string `gorm:"type:varchar(255);not null;uniqueIndex:idx_post_referrer_stats_post_date_referrer" json:"referrer"`  // 来源域名，直接访问为 direct
+	Views    int64  `gorm:"type:bigint;not null;default:0" json:"views"`                                                        // 当日来自该来源的去重浏览量
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (PostReferrerStat) TableName() string {
+	return "post_referrer_stats"
+}
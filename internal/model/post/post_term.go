@@ -0,0 +1,23 @@
+// Package post 提供文章关键词索引数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package post
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// PostTerm 文章关键词模型，保存文章 TF-IDF 权重最高的若干检索词，用于计算相关文章；文章内容变更时整体重建
+type PostTerm struct {
+	base.Base
+	PostID int64   `gorm:"type:bigint;not null;uniqueIndex:idx_post_terms_post_term" json:"post_id"`         // 文章 ID
+	Term   string  `gorm:"type:varchar(64);not null;uniqueIndex:idx_post_terms_post_term;index" json:"term"` // 检索词
+	Weight float64 `gorm:"not null;default:0" json:"weight"`                                                 // 归一化 TF-IDF 权重
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (PostTerm) TableName() string {
+	return "post_terms"
+}
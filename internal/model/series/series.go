@@ -0,0 +1,40 @@
+// Package series 提供文章系列数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package series
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Series 文章系列模型，用于将多篇文章组织为有序的连载
+type Series struct {
+	base.Base
+	Title       string `gorm:"type:varchar(255);not null;index" json:"title"`          // 系列标题
+	Slug        string `gorm:"type:varchar(255);uniqueIndex;default:null" json:"slug"` // URL slug，全局唯一
+	Description string `gorm:"type:varchar(500)" json:"description"`                   // 系列描述（可选）
+	Image       string `gorm:"type:varchar(255)" json:"image"`                         // 封面图片
+	AuthorID    int64  `gorm:"type:bigint;not null;default:0;index" json:"author_id"`  // 创建者用户 ID
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Series) TableName() string {
+	return "series"
+}
+
+// SeriesPost 系列文章关联模型，一篇文章最多属于一个系列
+type SeriesPost struct {
+	PostID     int64 `gorm:"primaryKey;type:bigint;autoIncrement:false" json:"post_id"`                                        // 文章 ID
+	SeriesID   int64 `gorm:"type:bigint;not null;index:idx_series_posts_series_position,priority:1" json:"series_id"`          // 系列 ID
+	Position   int64 `gorm:"type:bigint;not null;default:0;index:idx_series_posts_series_position,priority:2" json:"position"` // 在系列中的顺序，从 1 开始
+	GmtCreated int64 `gorm:"type:bigint;autoCreateTime" json:"gmt_created"`                                                    // 关联创建时间
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (SeriesPost) TableName() string {
+	return "series_posts"
+}
@@ -0,0 +1,23 @@
+// Package slug 提供 slug 历史数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package slug
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// SlugHistory slug 历史模型，记录文章与分类改名前使用过的 slug，用于旧链接 301 跳转
+type SlugHistory struct {
+	base.Base
+	EntityType string `gorm:"type:varchar(20);not null;uniqueIndex:idx_slug_histories_entity_slug" json:"entity_type"` // 实体类型：post、category
+	Slug       string `gorm:"type:varchar(255);not null;uniqueIndex:idx_slug_histories_entity_slug" json:"slug"`       // 曾用 slug
+	EntityID   int64  `gorm:"type:bigint;not null;index" json:"entity_id"`                                             // 实体 ID
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (SlugHistory) TableName() string {
+	return "slug_histories"
+}
@@ -0,0 +1,36 @@
+// Package tag 提供标签数据模型定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package tag
+
+import (
+	"github.com/Done-0/jank/internal/model/base"
+)
+
+// Tag 标签模型
+type Tag struct {
+	base.Base
+	Name        string `gorm:"type:varchar(64);not null" json:"name"` // 标签名称，未删除的标签间唯一，唯一索引由 ensureTagNameIndex 创建
+	Description string `gorm:"type:varchar(500)" json:"description"`  // 标签描述（可选）
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// PostTag 文章标签关联模型
+type PostTag struct {
+	PostID     int64 `gorm:"primaryKey;type:bigint;autoIncrement:false" json:"post_id"`      // 文章 ID
+	TagID      int64 `gorm:"primaryKey;type:bigint;autoIncrement:false;index" json:"tag_id"` // 标签 ID
+	GmtCreated int64 `gorm:"type:bigint;autoCreateTime" json:"gmt_created"`                  // 关联创建时间
+}
+
+// TableName 指定表名
+// 返回值：
+//   - string: 表名
+func (PostTag) TableName() string {
+	return "post_tags"
+}
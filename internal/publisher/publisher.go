@@ -0,0 +1,137 @@
+// Package publisher 提供文章定时发布后台任务
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+// releaseLockScript 仅当锁仍由当前实例持有时释放，避免误删其他实例的锁
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+var (
+	cancel context.CancelFunc // 停止后台任务
+	wg     sync.WaitGroup     // 等待后台任务退出
+)
+
+// New 启动定时发布后台任务
+// 参数：
+//
+//	config: 应用配置
+func New(config *configs.Config) {
+	ctx, stop := context.WithCancel(context.Background())
+	cancel = stop
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		run(ctx)
+	}()
+
+	global.SysLog.Infof("Post publisher started, interval: %s", consts.PostPublishInterval)
+}
+
+// Shutdown 停止定时发布后台任务，等待进行中的发布完成
+func Shutdown() {
+	if cancel == nil {
+		return
+	}
+	cancel()
+	wg.Wait()
+	global.SysLog.Info("Post publisher stopped")
+}
+
+// run 周期性发布到期文章，启动时立即执行一次以补发停机期间到期的文章
+func run(ctx context.Context) {
+	ticker := time.NewTicker(consts.PostPublishInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := publishDuePosts(ctx); err != nil {
+			global.SysLog.Errorf("failed to publish scheduled posts: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishDuePosts 在持有分布式锁的前提下将到期的定时文章更新为已发布
+// 参数：
+//
+//	ctx: 上下文
+//
+// 返回值：
+//
+//	error: 错误信息
+func publishDuePosts(ctx context.Context) error {
+	// Redis 不可用时退化为仅依赖条件更新，重复执行也不会重复发布
+	if global.RedisClient != nil {
+		token := uuid.NewString()
+		acquired, err := global.RedisClient.SetNX(ctx, consts.PostPublishLockKey, token, consts.PostPublishLockTTL).Result()
+		if err != nil {
+			return fmt.Errorf("failed to acquire publish lock: %w", err)
+		}
+		if !acquired {
+			return nil
+		}
+		defer func() {
+			if err := releaseLockScript.Run(context.Background(), global.RedisClient, []string{consts.PostPublishLockKey}, token).Err(); err != nil {
+				global.SysLog.Warnf("failed to release publish lock: %v", err)
+			}
+		}()
+	}
+
+	now := time.Now().Unix()
+	// 条件中包含 scheduled 状态，保证同一篇文章只会被发布一次；状态变化同样递增版本号，使持有旧版本的编辑者无法覆盖发布结果
+	result := global.DB.WithContext(ctx).Model(&post.Post{}).
+		Scopes(base.NotDeleted).
+		Where("status = ? AND publish_at <= ?", consts.PostStatusScheduled, now).
+		Updates(map[string]any{
+			"status":       consts.PostStatusPublished,
+			"gmt_modified": now,
+			"version":      gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update scheduled posts: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	global.SysLog.Infof("Published %d scheduled posts", result.RowsAffected)
+
+	// 新发布的文章需要出现在站点地图与相关文章中
+	if global.RedisClient != nil {
+		if err := global.RedisClient.Incr(ctx, consts.SEOCacheVersionKey).Err(); err != nil {
+			global.SysLog.Warnf("failed to invalidate seo cache: %v", err)
+		}
+		if err := global.RedisClient.Incr(ctx, consts.PostRelatedCacheVersionKey).Err(); err != nil {
+			global.SysLog.Warnf("failed to invalidate related posts cache: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Package storage 提供本地磁盘存储实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Done-0/jank/configs"
+)
+
+// LocalStorage 本地磁盘存储
+type LocalStorage struct {
+	dir       string
+	urlPrefix string
+}
+
+// NewLocalStorage 创建本地磁盘存储
+// 参数：
+//
+//	config: 本地磁盘存储配置
+//
+// 返回值：
+//
+//	*LocalStorage: 本地磁盘存储
+func NewLocalStorage(config configs.LocalStorageConfig) *LocalStorage {
+	return &LocalStorage{
+		dir:       config.Dir,
+		urlPrefix: strings.TrimRight(config.URLPrefix, "/"),
+	}
+}
+
+// Put 写入文件，先写临时文件再重命名，避免并发读取到不完整的文件
+func (s *LocalStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("failed to chmod file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to move file: %w", err)
+	}
+	return nil
+}
+
+// Delete 删除文件
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+// URL 获取文件访问路径
+func (s *LocalStorage) URL(key string) string {
+	return s.urlPrefix + "/" + key
+}
+
+// path 将 key 转换为磁盘路径，拒绝跳出存储目录的 key
+func (s *LocalStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid storage key: %s", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
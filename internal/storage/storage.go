@@ -0,0 +1,48 @@
+// Package storage 提供媒体文件存储后端抽象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Done-0/jank/configs"
+)
+
+// 存储后端类型
+const (
+	TypeLocal = "local" // 本地磁盘
+	TypeS3    = "s3"    // S3 兼容对象存储
+)
+
+// Storage 媒体文件存储接口
+type Storage interface {
+	// Put 写入文件，同一 key 重复写入时覆盖
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Delete 删除文件，文件不存在时不返回错误
+	Delete(ctx context.Context, key string) error
+	// URL 获取文件访问地址
+	URL(key string) string
+}
+
+// New 根据存储类型创建存储后端
+// 参数：
+//
+//	storageType: 存储类型，为空时使用本地磁盘
+//	config: 媒体文件配置
+//
+// 返回值：
+//
+//	Storage: 存储后端
+//	error: 错误信息
+func New(storageType string, config *configs.MediaConfig) (Storage, error) {
+	switch storageType {
+	case "", TypeLocal:
+		return NewLocalStorage(config.Local), nil
+	case TypeS3:
+		return NewS3Storage(config.S3)
+	default:
+		return nil, fmt.Errorf("unsupported storage type: %s", storageType)
+	}
+}
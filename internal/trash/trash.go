@@ -0,0 +1,282 @@
+// Package trash 提供回收站定期清理后台任务
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package trash
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/model/comment"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/rbac"
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/model/slug"
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/model/user"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+// releaseLockScript 仅当锁仍由当前实例持有时释放，避免误删其他实例的锁
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+var (
+	cancel context.CancelFunc // 停止后台任务
+	wg     sync.WaitGroup     // 等待后台任务退出
+)
+
+// New 启动回收站清理后台任务
+// 参数：
+//
+//	config: 应用配置
+func New(config *configs.Config) {
+	ctx, stop := context.WithCancel(context.Background())
+	cancel = stop
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		run(ctx)
+	}()
+
+	global.SysLog.Infof("Trash purger started, interval: %s", consts.TrashPurgeInterval)
+}
+
+// Shutdown 停止回收站清理后台任务，进行中的清理随之中断，未提交的批次在下次启动后重新清理
+func Shutdown() {
+	if cancel == nil {
+		return
+	}
+	cancel()
+	wg.Wait()
+	global.SysLog.Info("Trash purger stopped")
+}
+
+// RetentionDays 获取回收站保留天数，每次读取当前配置以支持热更新
+// 返回值：
+//
+//	int64: 保留天数
+//	bool: 是否自动清理
+func RetentionDays() (int64, bool) {
+	days := int64(consts.TrashDefaultRetentionDays)
+	if cfgs, err := configs.GetConfig(); err == nil && cfgs.TrashConfig.RetentionDays != 0 {
+		days = cfgs.TrashConfig.RetentionDays
+	}
+	return days, days > 0
+}
+
+// DeletedAt 获取记录的删除时间，未记录删除时间的历史记录以修改时间为准
+// 参数：
+//
+//	b: 记录的基础字段
+//
+// 返回值：
+//
+//	int64: 删除时间（Unix 秒）
+func DeletedAt(b *base.Base) int64 {
+	if b.GmtDeleted > 0 {
+		return b.GmtDeleted
+	}
+	return b.GmtModified
+}
+
+// PurgeAt 获取记录预计被永久删除的时间
+// 参数：
+//
+//	b: 记录的基础字段
+//
+// 返回值：
+//
+//	int64: 永久删除时间（Unix 秒），不自动清理时为 0
+func PurgeAt(b *base.Base) int64 {
+	days, enabled := RetentionDays()
+	if !enabled {
+		return 0
+	}
+	return DeletedAt(b) + days*int64(24*time.Hour/time.Second)
+}
+
+// run 周期性清理超过保留期的记录，启动时立即执行一次
+func run(ctx context.Context) {
+	ticker := time.NewTicker(consts.TrashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := purgeExpired(ctx); err != nil && ctx.Err() == nil {
+			global.SysLog.Errorf("failed to purge trash: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeExpired 在持有分布式锁的前提下永久删除超过保留期的文章、分类与用户
+// 参数：
+//
+//	ctx: 上下文
+//
+// 返回值：
+//
+//	error: 错误信息
+func purgeExpired(ctx context.Context) error {
+	days, enabled := RetentionDays()
+	if !enabled {
+		return nil
+	}
+
+	// Redis 不可用时退化为无锁清理，多实例重复清理同一批记录不会出错
+	if global.RedisClient != nil {
+		token := uuid.NewString()
+		acquired, err := global.RedisClient.SetNX(ctx, consts.TrashPurgeLockKey, token, consts.TrashPurgeLockTTL).Result()
+		if err != nil {
+			return fmt.Errorf("failed to acquire purge lock: %w", err)
+		}
+		if !acquired {
+			return nil
+		}
+		defer func() {
+			if err := releaseLockScript.Run(context.Background(), global.RedisClient, []string{consts.TrashPurgeLockKey}, token).Err(); err != nil {
+				global.SysLog.Warnf("failed to release purge lock: %v", err)
+			}
+		}()
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -int(days)).Unix()
+
+	posts, err := purgeBatches(ctx, &post.Post{}, cutoff, purgePosts)
+	if err != nil {
+		return fmt.Errorf("failed to purge posts: %w", err)
+	}
+	categories, err := purgeBatches(ctx, &category.Category{}, cutoff, purgeCategories)
+	if err != nil {
+		return fmt.Errorf("failed to purge categories: %w", err)
+	}
+	users, err := purgeBatches(ctx, &user.User{}, cutoff, purgeUsers)
+	if err != nil {
+		return fmt.Errorf("failed to purge users: %w", err)
+	}
+
+	if posts+categories+users > 0 {
+		global.SysLog.Infof("Purged %d posts, %d categories and %d users deleted more than %d days ago", posts, categories, users, days)
+	}
+
+	return nil
+}
+
+// purgeBatches 分批查询超过保留期的已删除记录，每批在独立事务中永久删除
+// 参数：
+//
+//	ctx: 上下文
+//	model: 记录模型
+//	cutoff: 删除时间早于该时间（Unix 秒）的记录会被清理
+//	purge: 在事务内永久删除一批记录及其关联数据的函数
+//
+// 返回值：
+//
+//	int64: 永久删除的记录数
+//	error: 错误信息
+func purgeBatches(ctx context.Context, model any, cutoff int64, purge func(tx *gorm.DB, ids []int64) error) (int64, error) {
+	var purged int64
+	for {
+		var ids []int64
+		if err := global.DB.WithContext(ctx).Model(model).
+			Scopes(base.OnlyDeleted).
+			Where("(gmt_deleted > 0 AND gmt_deleted < ?) OR (gmt_deleted = 0 AND gmt_modified < ?)", cutoff, cutoff).
+			Order("id ASC").
+			Limit(consts.TrashPurgeBatchSize).
+			Pluck("id", &ids).Error; err != nil {
+			return purged, err
+		}
+		if len(ids) == 0 {
+			return purged, nil
+		}
+
+		if err := global.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return purge(tx, ids)
+		}); err != nil {
+			return purged, err
+		}
+		purged += int64(len(ids))
+
+		if len(ids) < consts.TrashPurgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// purgePosts 永久删除文章及其修订、统计、表态、关键词、评论、系列与标签关联和曾用 slug
+func purgePosts(tx *gorm.DB, ids []int64) error {
+	related := []any{
+		&post.PostRevision{},
+		&post.PostStat{},
+		&post.PostReferrerStat{},
+		&post.PostReaction{},
+		&post.PostReactionCount{},
+		&post.PostTerm{},
+		&series.SeriesPost{},
+		&tag.PostTag{},
+		&comment.Comment{},
+	}
+	for _, model := range related {
+		if err := tx.Where("post_id IN ?", ids).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Where("entity_type = ? AND entity_id IN ?", consts.SlugEntityPost, ids).Delete(&slug.SlugHistory{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Where("id IN ?", ids).Delete(&post.Post{}).Error
+}
+
+// purgeCategories 永久删除分类及其曾用 slug，引用这些分类的文章改为未分类
+func purgeCategories(tx *gorm.DB, ids []int64) error {
+	if err := tx.Model(&post.Post{}).Where("category_id IN ?", ids).Update("category_id", nil).Error; err != nil {
+		return err
+	}
+
+	// 已删除的子分类仍可能以被清理的分类为父分类，改为顶级分类，避免恢复后引用不存在的分类
+	if err := tx.Model(&category.Category{}).Where("parent_id IN ?", ids).Update("parent_id", 0).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("entity_type = ? AND entity_id IN ?", consts.SlugEntityCategory, ids).Delete(&slug.SlugHistory{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Where("id IN ?", ids).Delete(&category.Category{}).Error
+}
+
+// purgeUsers 永久删除用户及其角色分配，用户发布的文章与评论保留
+func purgeUsers(tx *gorm.DB, ids []int64) error {
+	subjects := make([]string, 0, len(ids))
+	for _, id := range ids {
+		subjects = append(subjects, strconv.FormatInt(id, 10))
+	}
+	if err := tx.Where("ptype = ? AND v0 IN ?", "g", subjects).Delete(&rbac.Policy{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Where("id IN ?", ids).Delete(&user.User{}).Error
+}
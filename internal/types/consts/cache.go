@@ -8,3 +8,50 @@ const (
 	AuthAccessTokenKeyPrefix  = "auth:access_token"  // 访问令牌缓存键前缀: auth:access_token:{userID}
 	AuthRefreshTokenKeyPrefix = "auth:refresh_token" // 刷新令牌缓存键前缀: auth:refresh_token:{userID}
 )
+
+const (
+	// Redis 缓存键前缀 - 搜索引擎优化相关
+	SEOCacheVersionKey  = "seo:version" // SEO 缓存版本号，文章或分类变更时自增以使缓存整体失效
+	SEOSitemapKeyPrefix = "seo:sitemap" // 站点地图缓存键前缀: seo:sitemap:{version}:{baseURL}:{page}
+	SEORobotsKeyPrefix  = "seo:robots"  // robots.txt 缓存键前缀: seo:robots:{version}:{baseURL}:{configHash}
+)
+
+const (
+	// Redis 缓存键 - 文章定时发布相关
+	PostPublishLockKey = "post:publish:lock" // 定时发布分布式锁，保证多实例部署时同一时刻只有一个实例执行发布
+)
+
+const (
+	// Redis 缓存键 - 回收站清理相关
+	TrashPurgeLockKey = "trash:purge:lock" // 回收站清理分布式锁，保证多实例部署时同一时刻只有一个实例执行清理
+)
+
+const (
+	// Redis 缓存键 - 文章浏览统计相关
+	PostViewsKeyPrefix     = "post:views"          // 文章每日访客 HyperLogLog 键前缀: post:views:{date}:{postID}
+	PostReferrersKeyPrefix = "post:referrers"      // 文章每日来源计数哈希键前缀: post:referrers:{date}:{postID}
+	PostViewsDirtyKey      = "post:views:dirty"    // 有新增浏览、待落库的 {date}:{postID} 集合
+	PostViewsFlushingKey   = "post:views:flushing" // 正在落库的 {date}:{postID} 集合，落库失败的成员留待下次重试
+)
+
+const (
+	// Redis 缓存键前缀 - 文章互动表态相关
+	PostReactionAnonymousKeyPrefix = "post:reaction:anonymous" // 匿名访客表态去重集合键前缀: post:reaction:anonymous:{postID}:{type}，成员为访客指纹摘要
+)
+
+const (
+	// Redis 缓存键 - 相关文章相关
+	PostRelatedCacheVersionKey = "post:related:version" // 相关文章缓存版本号，文章变更时自增以使缓存整体失效
+	PostRelatedKeyPrefix       = "post:related"         // 相关文章缓存键前缀: post:related:{version}:{postID}:{limit}
+)
+
+const (
+	// Redis 缓存键前缀 - 文章预览链接相关
+	PostPreviewTokenKeyPrefix = "post:preview:token" // 预览令牌记录键前缀: post:preview:token:{tokenID}，随令牌过期
+	PostPreviewIndexKeyPrefix = "post:preview:index" // 文章预览令牌索引有序集合键前缀: post:preview:index:{postID}，成员为令牌 ID，分值为过期时间
+)
+
+const (
+	// Redis 缓存键前缀 - 文章自动保存相关
+	PostAutosaveKeyPrefix = "post:autosave" // 草稿自动保存键前缀: post:autosave:{userID}:{postID}，新文章的 postID 为 new
+)
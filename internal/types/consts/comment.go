@@ -0,0 +1,17 @@
+// Package consts 提供评论相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// 评论状态常量
+const (
+	CommentStatusPending  = "pending"  // 待审核状态 - 评论已提交，等待管理员审核
+	CommentStatusApproved = "approved" // 已通过状态 - 评论审核通过，对外可见
+	CommentStatusSpam     = "spam"     // 垃圾评论状态 - 评论被驳回，不对外展示
+)
+
+// 评论权限常量
+const (
+	CommentModerateResource = "comment:moderate" // 评论审核资源 - 拥有该权限的角色可查看审核队列、审核通过、驳回和删除评论
+	CommentModerateAction   = "write"            // 评论审核操作
+)
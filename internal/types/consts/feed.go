@@ -0,0 +1,20 @@
+// Package consts 提供订阅源相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// 订阅源常量
+const (
+	FeedItemLimit   = 20           // 订阅源输出的最新文章数量
+	FeedRSSPath     = "/feed.xml"  // RSS 2.0 订阅源路径
+	FeedAtomPath    = "/atom.xml"  // Atom 订阅源路径
+	FeedJSONPath    = "/feed.json" // JSON Feed 订阅源路径
+	FeedCacheMaxAge = 300          // 订阅源缓存时间（秒）
+)
+
+// 订阅源 MIME 类型
+const (
+	FeedRSSContentType  = "application/rss+xml; charset=utf-8"   // RSS 2.0
+	FeedAtomContentType = "application/atom+xml; charset=utf-8"  // Atom
+	FeedJSONContentType = "application/feed+json; charset=utf-8" // JSON Feed
+)
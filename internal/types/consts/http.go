@@ -24,9 +24,21 @@ const (
 	HeaderContentLength  = "Content-Length"   // 内容长度
 
 	// 网络相关头部
-	HeaderRequestID     = "X-Request-ID"    // 请求 ID 头部
-	HeaderXForwardedFor = "X-Forwarded-For" // 代理转发的原始客户端 IP
-	HeaderXRealIP       = "X-Real-IP"       // 真实客户端 IP
-	HeaderXClientIP     = "X-Client-IP"     // 客户端 IP（某些代理使用）
-	HeaderUserAgent     = "User-Agent"      // 用户代理字符串
+	HeaderRequestID       = "X-Request-ID"      // 请求 ID 头部
+	HeaderXForwardedFor   = "X-Forwarded-For"   // 代理转发的原始客户端 IP
+	HeaderXRealIP         = "X-Real-IP"         // 真实客户端 IP
+	HeaderXClientIP       = "X-Client-IP"       // 客户端 IP（某些代理使用）
+	HeaderUserAgent       = "User-Agent"        // 用户代理字符串
+	HeaderXForwardedProto = "X-Forwarded-Proto" // 代理转发的原始请求协议
+
+	// 缓存协商相关头部
+	HeaderETag            = "ETag"              // 实体标签
+	HeaderLastModified    = "Last-Modified"     // 最后修改时间
+	HeaderIfNoneMatch     = "If-None-Match"     // 条件请求：实体标签
+	HeaderIfMatch         = "If-Match"          // 条件请求：仅当实体标签匹配时执行修改
+	HeaderIfModifiedSince = "If-Modified-Since" // 条件请求：修改时间
+	HeaderCacheControl    = "Cache-Control"     // 缓存控制
+
+	// 搜索引擎相关头部
+	HeaderXRobotsTag = "X-Robots-Tag" // 搜索引擎索引指令
 )
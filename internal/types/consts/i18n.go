@@ -0,0 +1,10 @@
+// Package consts 提供多语言相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// 多语言常量
+const (
+	LocaleDefault           = "zh-CN" // 未配置默认语言时使用的语言
+	LocaleDetachTranslation = "0"     // 更新文章或分类时传入的 translation_of 为该值表示脱离原翻译组
+)
@@ -0,0 +1,37 @@
+// Package consts 提供媒体文件相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// 媒体文件上传常量
+const (
+	MediaFormField      = "file"  // 上传表单文件字段名
+	MediaKeyPrefix      = "media" // 存储 key 前缀: media/{hash[0:2]}/{hash}{ext}
+	MediaDefaultMaxSize = 10      // 默认单个文件大小上限（MB）
+	MediaSniffLength    = 512     // 识别 MIME 类型读取的文件头长度
+)
+
+// 媒体文件权限常量
+const (
+	MediaOverrideResource = "media:override" // 媒体越权管理资源 - 拥有该权限的角色可查看、删除其他用户上传的文件
+	MediaOverrideAction   = "write"          // 媒体越权管理操作
+)
+
+// MediaDefaultAllowedTypes 默认允许上传的 MIME 类型，SVG 可内嵌脚本，默认不允许
+var MediaDefaultAllowedTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
+// MediaTypeExtensions MIME 类型对应的文件扩展名
+var MediaTypeExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"image/bmp":       ".bmp",
+	"image/x-icon":    ".ico",
+	"application/pdf": ".pdf",
+	"video/mp4":       ".mp4",
+	"video/webm":      ".webm",
+	"audio/mpeg":      ".mp3",
+	"audio/wave":      ".wav",
+	"application/ogg": ".ogg",
+}
@@ -3,10 +3,115 @@
 // 创建时间：2025-08-13
 package consts
 
+import "time"
+
 // 文章状态常量
 const (
 	PostStatusDraft     = "draft"     // 草稿状态 - 文章正在编辑中，不对外展示
 	PostStatusPublished = "published" // 已发布状态 - 文章已发布，对外可见
 	PostStatusPrivate   = "private"   // 私有状态 - 文章仅作者可见
 	PostStatusArchived  = "archived"  // 已归档状态 - 文章已归档，不在列表中显示但可通过链接访问
+	PostStatusScheduled = "scheduled" // 定时发布状态 - 文章到达发布时间后由后台任务自动发布
+)
+
+// 文章批量操作类型常量
+const (
+	PostBulkOpStatus   = "status"   // 修改状态
+	PostBulkOpCategory = "category" // 移动分类
+	PostBulkOpDelete   = "delete"   // 删除
+	PostBulkOpRestore  = "restore"  // 恢复已删除文章
+	PostBulkOpRerender = "rerender" // 按当前配置重新渲染 Markdown
+)
+
+// 文章访问方式常量
+const (
+	PostVisibilityPublic   = "public"   // 公开 - 出现在列表、订阅源与检索结果中
+	PostVisibilityPassword = "password" // 密码保护 - 出现在列表中但不展示内容，读者输入密码解锁后方可阅读
+	PostVisibilityUnlisted = "unlisted" // 仅链接可见 - 不出现在列表、订阅源与检索结果中，持有链接即可访问
+)
+
+// 文章密码解锁常量
+const (
+	PostUnlockCookiePrefix     = "post_unlock_"         // 解锁凭证 Cookie 名称前缀，后接文章 ID
+	PostUnlockTTL              = time.Hour              // 解锁凭证有效期
+	PostUnlockAttemptKeyPrefix = "post:unlock:attempts" // 解锁失败次数缓存键前缀: post:unlock:attempts:{postID}:{clientIP}
+	PostUnlockMaxAttempts      = 5                      // 同一 IP 对同一文章在统计窗口内允许的密码错误次数
+	PostUnlockAttemptWindow    = 15 * time.Minute       // 解锁失败次数统计窗口，自首次失败起计时
+)
+
+// 文章预览链接常量
+const (
+	PostPreviewDefaultTTL = 72 * time.Hour      // 未指定有效期时预览链接的默认有效期
+	PostPreviewMaxTTL     = 30 * 24 * time.Hour // 预览链接最长有效期
+	PostPreviewMaxTokens  = 20                  // 单篇文章同时有效的预览链接数量上限
+)
+
+// 文章自动保存与编辑冲突常量
+const (
+	PostAutosaveTTL        = 7 * 24 * time.Hour // 草稿自动保存的保留时间，每次保存后重新计时
+	PostAutosaveNewDraftID = "new"              // 尚未创建的新文章在自动保存键中使用的文章 ID
+	PostETagFormat         = `"%d"`             // 文章 ETag 格式，取值为文章版本号，用于 If-Match 条件更新
+)
+
+// 文章定时发布常量
+const (
+	PostPublishInterval = 30 * time.Second // 定时发布任务扫描间隔
+	PostPublishLockTTL  = 25 * time.Second // 定时发布分布式锁过期时间，需小于扫描间隔
+)
+
+// 文章权限常量
+const (
+	PostOverrideResource = "post:override" // 文章越权管理资源 - 拥有该权限的角色可修改、删除其他作者的文章
+	PostOverrideAction   = "write"         // 文章越权管理操作
+	PostCurateResource   = "post:curate"   // 文章推荐管理资源 - 拥有该权限的角色可置顶、精选文章并调整其顺序
+	PostCurateAction     = "write"         // 文章推荐管理操作
+)
+
+// 文章全文检索常量
+const (
+	PostSearchVectorColumn = "search_vector"      // PostgreSQL 加权检索向量列（生成列，随文章写入自动更新）
+	PostFullTextIndex      = "idx_posts_fulltext" // 文章全文索引名称
+	PostFTSTable           = "posts_fts"          // SQLite FTS5 虚拟表，由触发器与 posts 表保持同步
+)
+
+// 文章渲染常量
+const (
+	PostRerenderBatchSize = 100 // Markdown 配置变更后重新渲染文章的批大小
+)
+
+// 文章浏览统计常量
+const (
+	PostViewFlushInterval = time.Minute    // 浏览统计落库间隔
+	PostViewKeyTTL        = 72 * time.Hour // Redis 中每日浏览统计键的保留时间，需覆盖跨天落库与停机时间
+	PostStatsDateLayout   = "2006-01-02"   // 统计日期格式
+	PostStatsDefaultDays  = 30             // 未指定时间范围时默认统计最近的天数
+	PostStatsMaxDays      = 366            // 单次查询允许的最大天数
+	PostReferrerDirect    = "direct"       // 无来源（直接访问）
+	PostReferrerMaxLength = 255            // 来源域名最大长度
+)
+
+// 浏览统计权限常量
+const (
+	AnalyticsResource = "analytics" // 浏览统计资源 - 拥有该权限的角色可查看站点浏览统计
+	AnalyticsAction   = "read"      // 浏览统计操作
+)
+
+// 文章互动表态常量
+const (
+	PostReactionDefaultType   = "like" // 未配置表态类型时的默认类型
+	PostReactionTypeMaxLength = 32     // 表态类型最大长度
+)
+
+// 相关文章常量
+const (
+	PostRelatedDefaultLimit   = 5         // 未指定数量时返回的相关文章数
+	PostRelatedTermLimit      = 64        // 每篇文章保留的关键词数量
+	PostRelatedTitleWeight    = 3         // 标题中检索词按正文词频的倍数计入
+	PostRelatedCandidateLimit = 200       // 每种召回方式（正文、分类、标签）保留的候选文章数量上限
+	PostRelatedTextWeight     = 0.6       // 正文 TF-IDF 相似度在总分中的权重
+	PostRelatedTagWeight      = 0.25      // 标签 Jaccard 相似度在总分中的权重
+	PostRelatedCategoryWeight = 0.15      // 同分类在总分中的权重
+	PostRelatedCacheTTL       = time.Hour // 相关文章结果缓存时间
+	PostTermIndexBatchSize    = 100       // 启动时补建关键词索引的批大小
+	PostTermQueryBatchSize    = 500       // 统计检索词文档频率时单次查询的检索词数量
 )
@@ -0,0 +1,10 @@
+// Package consts 提供 HTML 清洗相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// HTML 清洗权限常量
+const (
+	HTMLTrustedResource = "html:trusted" // 受信任 HTML 资源 - 作者角色拥有该权限时使用受信任清洗策略（如保留 iframe）
+	HTMLTrustedAction   = "write"        // 受信任 HTML 操作
+)
@@ -0,0 +1,17 @@
+// Package consts 提供搜索引擎优化相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// 站点地图与 robots.txt 常量
+const (
+	SitemapPath        = "/sitemap.xml"                                // 站点地图路径
+	RobotsPath         = "/robots.txt"                                 // robots.txt 路径
+	PostPagePath       = "/post/%d"                                    // 前端文章详情页路径
+	CategoryPagePath   = "/category/%d"                                // 前端分类页路径
+	SitemapMaxURLs     = 50000                                         // 单个站点地图允许的最大 URL 数量，超过时输出站点地图索引
+	SitemapNamespace   = "http://www.sitemaps.org/schemas/sitemap/0.9" // 站点地图 XML 命名空间
+	SitemapContentType = "application/xml; charset=utf-8"              // 站点地图 MIME 类型
+	RobotsContentType  = "text/plain; charset=utf-8"                   // robots.txt MIME 类型
+	SEODefaultCacheTTL = 60                                            // 默认缓存时间（分钟）
+)
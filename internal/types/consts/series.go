@@ -0,0 +1,10 @@
+// Package consts 提供文章系列相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// 文章系列权限常量
+const (
+	SeriesOverrideResource = "series:override" // 系列越权管理资源 - 拥有该权限的角色可修改、删除其他用户创建的系列
+	SeriesOverrideAction   = "write"           // 系列越权管理操作
+)
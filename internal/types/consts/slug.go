@@ -0,0 +1,11 @@
+// Package consts 提供 slug 相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// slug 实体类型常量
+const (
+	SlugEntityPost     = "post"     // 文章
+	SlugEntityCategory = "category" // 分类
+	SlugEntitySeries   = "series"   // 系列
+)
@@ -0,0 +1,24 @@
+// Package consts 提供回收站相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+import "time"
+
+// 回收站清理任务常量
+const (
+	TrashPurgeInterval        = time.Hour        // 回收站清理任务扫描间隔
+	TrashPurgeLockTTL         = 50 * time.Minute // 回收站清理分布式锁过期时间，需小于扫描间隔
+	TrashPurgeBatchSize       = 100              // 每批永久删除的记录数
+	TrashDefaultRetentionDays = 30               // 未配置保留天数时的默认值
+)
+
+// 回收站中用户的唯一列占位值，参数为用户 ID
+// 占位昵称长度超过昵称校验上限，占位邮箱使用保留顶级域名 .invalid，均不会与正常注册的用户冲突
+const (
+	UserTrashedEmailFormat    = "deleted-%d@trash.invalid" // 邮箱占位值
+	UserTrashedNicknameFormat = "deleted-%d"               // 昵称占位值
+)
+
+// TagTrashedNameFormat 回收站中标签名称的占位值，参数为标签 ID
+const TagTrashedNameFormat = "deleted-tag-%d@trash"
@@ -0,0 +1,10 @@
+// Package consts 提供用户相关常量定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package consts
+
+// 用户管理权限常量
+const (
+	UserManageResource = "user:manage" // 用户管理资源 - 拥有该权限的角色可删除、恢复用户并查看用户回收站
+	UserManageAction   = "write"       // 用户管理操作
+)
@@ -0,0 +1,21 @@
+// Package errno 浏览统计模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 浏览统计模块错误码: 110000 ~ 119999
+const (
+	ErrAnalyticsPostViewsFailed = 110001 // 获取文章浏览量失败
+	ErrAnalyticsTopPostsFailed  = 110002 // 获取浏览量排行失败
+	ErrAnalyticsReferrersFailed = 110003 // 获取来源统计失败
+)
+
+func init() {
+	code.Register(ErrAnalyticsPostViewsFailed, "get post views failed: {id}")
+	code.Register(ErrAnalyticsTopPostsFailed, "list top posts failed: {msg}")
+	code.Register(ErrAnalyticsReferrersFailed, "list referrers failed: {msg}")
+}
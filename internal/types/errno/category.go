@@ -9,11 +9,16 @@ import (
 
 // 分类模块错误码: 70000 ~ 79999
 const (
-	ErrCategoryCreateFailed = 70001 // 创建分类失败
-	ErrCategoryGetFailed    = 70002 // 获取分类失败
-	ErrCategoryUpdateFailed = 70003 // 更新分类失败
-	ErrCategoryDeleteFailed = 70004 // 删除分类失败
-	ErrCategoryListFailed   = 70005 // 获取分类列表失败
+	ErrCategoryCreateFailed    = 70001 // 创建分类失败
+	ErrCategoryGetFailed       = 70002 // 获取分类失败
+	ErrCategoryUpdateFailed    = 70003 // 更新分类失败
+	ErrCategoryDeleteFailed    = 70004 // 删除分类失败
+	ErrCategoryListFailed      = 70005 // 获取分类列表失败
+	ErrCategoryTreeFailed      = 70006 // 获取分类树失败
+	ErrCategoryMoveFailed      = 70007 // 移动分类失败
+	ErrCategoryMergeFailed     = 70008 // 合并分类失败
+	ErrCategoryTrashListFailed = 70009 // 获取回收站分类列表失败
+	ErrCategoryRestoreFailed   = 70010 // 从回收站恢复分类失败
 )
 
 func init() {
@@ -22,4 +27,9 @@ func init() {
 	code.Register(ErrCategoryUpdateFailed, "update category failed: {id}")
 	code.Register(ErrCategoryDeleteFailed, "delete category failed: {id}")
 	code.Register(ErrCategoryListFailed, "list categories failed: {msg}")
+	code.Register(ErrCategoryTreeFailed, "get category tree failed: {msg}")
+	code.Register(ErrCategoryMoveFailed, "move category failed: {id}")
+	code.Register(ErrCategoryMergeFailed, "merge category failed: {id}")
+	code.Register(ErrCategoryTrashListFailed, "list trashed categories failed: {msg}")
+	code.Register(ErrCategoryRestoreFailed, "restore category failed: {id}")
 }
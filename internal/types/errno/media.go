@@ -0,0 +1,25 @@
+// Package errno 媒体模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 媒体模块错误码: 100000 ~ 109999
+const (
+	ErrMediaUploadFailed  = 100001 // 上传媒体文件失败
+	ErrMediaListFailed    = 100002 // 获取媒体文件列表失败
+	ErrMediaDeleteFailed  = 100003 // 删除媒体文件失败
+	ErrMediaTooLarge      = 100004 // 媒体文件过大
+	ErrMediaTypeForbidden = 100005 // 媒体文件类型不允许
+)
+
+func init() {
+	code.Register(ErrMediaUploadFailed, "upload media failed: {msg}")
+	code.Register(ErrMediaListFailed, "list media failed: {msg}")
+	code.Register(ErrMediaDeleteFailed, "delete media failed: {id}")
+	code.Register(ErrMediaTooLarge, "media file too large: {msg}")
+	code.Register(ErrMediaTypeForbidden, "media type not allowed: {msg}")
+}
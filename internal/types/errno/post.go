@@ -9,11 +9,37 @@ import (
 
 // 文章模块错误码: 40000 ~ 49999
 const (
-	ErrPostCreateFailed = 40001 // 创建文章失败
-	ErrPostGetFailed    = 40002 // 获取文章失败
-	ErrPostUpdateFailed = 40003 // 更新文章失败
-	ErrPostDeleteFailed = 40004 // 删除文章失败
-	ErrPostListFailed   = 40005 // 获取文章列表失败
+	ErrPostCreateFailed          = 40001 // 创建文章失败
+	ErrPostGetFailed             = 40002 // 获取文章失败
+	ErrPostUpdateFailed          = 40003 // 更新文章失败
+	ErrPostDeleteFailed          = 40004 // 删除文章失败
+	ErrPostListFailed            = 40005 // 获取文章列表失败
+	ErrPostSearchFailed          = 40006 // 检索文章失败
+	ErrPostFeedFailed            = 40007 // 生成订阅源失败
+	ErrPostSitemapFailed         = 40008 // 生成站点地图失败
+	ErrPostRevisionListFailed    = 40009 // 获取文章修订列表失败
+	ErrPostRevisionDiffFailed    = 40010 // 对比文章修订失败
+	ErrPostRevisionRestoreFailed = 40011 // 恢复文章修订失败
+	ErrPostReactFailed           = 40012 // 文章表态失败
+	ErrPostUnreactFailed         = 40013 // 取消文章表态失败
+	ErrPostReactedListFailed     = 40014 // 获取表态过的文章列表失败
+	ErrPostRelatedListFailed     = 40015 // 获取相关文章失败
+	ErrPostPinFailed             = 40016 // 设置文章置顶失败
+	ErrPostFeatureFailed         = 40017 // 设置文章精选失败
+	ErrPostReorderFailed         = 40018 // 调整文章顺序失败
+	ErrPostFeaturedListFailed    = 40019 // 获取精选文章列表失败
+	ErrPostUnlockFailed          = 40020 // 解锁密码保护文章失败
+	ErrPostPreviewCreateFailed   = 40021 // 创建文章预览链接失败
+	ErrPostPreviewListFailed     = 40022 // 获取文章预览链接列表失败
+	ErrPostPreviewRevokeFailed   = 40023 // 撤销文章预览链接失败
+	ErrPostPreviewFailed         = 40024 // 通过预览链接获取文章失败
+	ErrPostBulkFailed            = 40025 // 批量操作文章失败
+	ErrPostTrashListFailed       = 40026 // 获取回收站文章列表失败
+	ErrPostRestoreFailed         = 40027 // 从回收站恢复文章失败
+	ErrPostVersionConflict       = 40028 // 文章编辑冲突，编辑所基于的版本已落后
+	ErrPostAutosaveFailed        = 40029 // 自动保存文章草稿失败
+	ErrPostAutosaveGetFailed     = 40030 // 获取自动保存的文章草稿失败
+	ErrPostAutosaveDiscardFailed = 40031 // 丢弃自动保存的文章草稿失败
 )
 
 func init() {
@@ -22,4 +48,30 @@ func init() {
 	code.Register(ErrPostUpdateFailed, "update post failed: {id}")
 	code.Register(ErrPostDeleteFailed, "delete post failed: {id}")
 	code.Register(ErrPostListFailed, "list posts failed: {msg}")
+	code.Register(ErrPostSearchFailed, "search posts failed: {msg}")
+	code.Register(ErrPostFeedFailed, "build feed failed: {msg}")
+	code.Register(ErrPostSitemapFailed, "build sitemap failed: {msg}")
+	code.Register(ErrPostRevisionListFailed, "list post revisions failed: {id}")
+	code.Register(ErrPostRevisionDiffFailed, "diff post revisions failed: {id}")
+	code.Register(ErrPostRevisionRestoreFailed, "restore post revision failed: {id}")
+	code.Register(ErrPostReactFailed, "react to post failed: {id}")
+	code.Register(ErrPostUnreactFailed, "remove post reaction failed: {id}")
+	code.Register(ErrPostReactedListFailed, "list reacted posts failed: {msg}")
+	code.Register(ErrPostRelatedListFailed, "list related posts failed: {id}")
+	code.Register(ErrPostPinFailed, "pin post failed: {id}")
+	code.Register(ErrPostFeatureFailed, "feature post failed: {id}")
+	code.Register(ErrPostReorderFailed, "reorder posts failed: {type}")
+	code.Register(ErrPostFeaturedListFailed, "list featured posts failed: {msg}")
+	code.Register(ErrPostUnlockFailed, "unlock post failed: {id}")
+	code.Register(ErrPostPreviewCreateFailed, "create post preview link failed: {id}")
+	code.Register(ErrPostPreviewListFailed, "list post preview links failed: {id}")
+	code.Register(ErrPostPreviewRevokeFailed, "revoke post preview link failed: {id}")
+	code.Register(ErrPostPreviewFailed, "preview post failed: {msg}")
+	code.Register(ErrPostBulkFailed, "bulk {operation} posts failed: {msg}")
+	code.Register(ErrPostTrashListFailed, "list trashed posts failed: {msg}")
+	code.Register(ErrPostRestoreFailed, "restore post failed: {id}")
+	code.Register(ErrPostVersionConflict, "post version conflict: {id}")
+	code.Register(ErrPostAutosaveFailed, "autosave post draft failed: {id}")
+	code.Register(ErrPostAutosaveGetFailed, "get post autosave failed: {id}")
+	code.Register(ErrPostAutosaveDiscardFailed, "discard post autosave failed: {id}")
 }
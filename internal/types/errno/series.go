@@ -0,0 +1,27 @@
+// Package errno 文章系列模块错误码定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package errno
+
+import (
+	"github.com/Done-0/jank/internal/utils/errorx/code"
+)
+
+// 文章系列模块错误码: 120000 ~ 129999
+const (
+	ErrSeriesCreateFailed  = 120001 // 创建系列失败
+	ErrSeriesGetFailed     = 120002 // 获取系列失败
+	ErrSeriesUpdateFailed  = 120003 // 更新系列失败
+	ErrSeriesDeleteFailed  = 120004 // 删除系列失败
+	ErrSeriesListFailed    = 120005 // 获取系列列表失败
+	ErrSeriesReorderFailed = 120006 // 设置系列文章失败
+)
+
+func init() {
+	code.Register(ErrSeriesCreateFailed, "create series failed: {title}")
+	code.Register(ErrSeriesGetFailed, "get series failed: {id}")
+	code.Register(ErrSeriesUpdateFailed, "update series failed: {id}")
+	code.Register(ErrSeriesDeleteFailed, "delete series failed: {id}")
+	code.Register(ErrSeriesListFailed, "list series failed: {msg}")
+	code.Register(ErrSeriesReorderFailed, "reorder series posts failed: {id}")
+}
@@ -16,6 +16,9 @@ const (
 	ErrUserResetPasswordFailed = 60005 // 重置密码失败
 	ErrUserListFailed          = 60006 // 获取用户列表失败
 	ErrUserRefreshTokenFailed  = 60007 // 刷新 token 失败
+	ErrUserDeleteFailed        = 60008 // 删除用户失败
+	ErrUserTrashListFailed     = 60009 // 获取回收站用户列表失败
+	ErrUserRestoreFailed       = 60010 // 从回收站恢复用户失败
 )
 
 func init() {
@@ -26,4 +29,7 @@ func init() {
 	code.Register(ErrUserResetPasswordFailed, "reset password failed: {msg}")
 	code.Register(ErrUserListFailed, "list users failed: {msg}")
 	code.Register(ErrUserRefreshTokenFailed, "refresh token failed: {msg}")
+	code.Register(ErrUserDeleteFailed, "delete user failed: {id}")
+	code.Register(ErrUserTrashListFailed, "list trashed users failed: {msg}")
+	code.Register(ErrUserRestoreFailed, "restore user failed: {id}")
 }
@@ -0,0 +1,131 @@
+// Package cursor 提供键集（游标）分页工具函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// maxEncodedLength 游标编码后的最大长度，超出视为非法游标
+const maxEncodedLength = 512
+
+// TotalSkipped 游标分页未统计总数时返回的总数量
+const TotalSkipped int64 = -1
+
+// ErrInvalidCursor 游标格式错误或与列表的排序列不匹配，所有游标解析错误均包装该错误
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor 分页游标，记录本页边界行的排序键
+type Cursor struct {
+	Keys     []int64 `json:"k"`           // 边界行的排序键，与列表排序列一一对应，末位为 ID
+	Backward bool    `json:"b,omitempty"` // 是否向前翻页（获取边界行之前的数据）
+}
+
+// Page 游标分页结果
+type Page[T any] struct {
+	List []T     // 本页数据，按列表展示顺序排列
+	Next *Cursor // 下一页游标，没有更多数据时为 nil
+	Prev *Cursor // 上一页游标，已是第一页时为 nil
+}
+
+// Encode 将游标编码为不透明字符串
+// 参数：
+//
+//	c: 游标，为 nil 时返回空字符串
+//
+// 返回值：
+//
+//	string: URL 安全的游标字符串
+func Encode(c *Cursor) string {
+	if c == nil {
+		return ""
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode 解析游标字符串
+// 参数：
+//
+//	raw: 游标字符串，为空时表示第一页
+//
+// 返回值：
+//
+//	*Cursor: 游标，raw 为空时返回 nil
+//	error: 游标格式错误，包装 ErrInvalidCursor
+func Decode(raw string) (*Cursor, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	if len(raw) > maxEncodedLength {
+		return nil, fmt.Errorf("%w: too long", ErrInvalidCursor)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed encoding", ErrInvalidCursor)
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("%w: malformed payload", ErrInvalidCursor)
+	}
+	if len(c.Keys) == 0 {
+		return nil, fmt.Errorf("%w: missing keys", ErrInvalidCursor)
+	}
+	return &c, nil
+}
+
+// Paginate 根据多取一行的查询结果裁剪本页数据并生成前后页游标
+// 参数：
+//
+//	rows: 按游标方向查询的结果，最多 limit+1 行，向前翻页时为倒序
+//	limit: 每页数量
+//	cur: 本次请求的游标，为 nil 表示第一页
+//	keys: 获取数据行排序键的函数
+//
+// 返回值：
+//
+//	*Page[T]: 按展示顺序排列的本页数据与前后页游标
+func Paginate[T any](rows []T, limit int64, cur *Cursor, keys func(T) []int64) *Page[T] {
+	hasMore := int64(len(rows)) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	backward := cur != nil && cur.Backward
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	page := &Page[T]{List: rows}
+	if len(rows) == 0 {
+		return page
+	}
+
+	first, last := rows[0], rows[len(rows)-1]
+	if backward {
+		// 向前翻页：多出的一行位于本页之前，说明还有上一页；本页之后必然还有数据
+		if hasMore {
+			page.Prev = &Cursor{Keys: keys(first), Backward: true}
+		}
+		page.Next = &Cursor{Keys: keys(last)}
+		return page
+	}
+
+	if hasMore {
+		page.Next = &Cursor{Keys: keys(last)}
+	}
+	if cur != nil {
+		page.Prev = &Cursor{Keys: keys(first), Backward: true}
+	}
+	return page
+}
@@ -0,0 +1,141 @@
+package cursor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		cur  *Cursor
+	}{
+		{name: "single key", cur: &Cursor{Keys: []int64{42}}},
+		{name: "multiple keys", cur: &Cursor{Keys: []int64{1, -5, 1 << 62}}},
+		{name: "backward", cur: &Cursor{Keys: []int64{7, 8}, Backward: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := Encode(tt.cur)
+			assert.NotEmpty(t, raw)
+			assert.NotContains(t, raw, "=")
+
+			decoded, err := Decode(raw)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.cur, decoded)
+		})
+	}
+
+	t.Run("nil cursor", func(t *testing.T) {
+		assert.Equal(t, "", Encode(nil))
+		decoded, err := Decode("")
+		assert.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		msg  string
+	}{
+		{name: "too long", raw: strings.Repeat("a", maxEncodedLength+1), msg: "too long"},
+		{name: "malformed encoding", raw: "not base64!", msg: "malformed encoding"},
+		{name: "malformed payload", raw: Encode(&Cursor{Keys: []int64{1}})[1:], msg: "malformed"},
+		{name: "missing keys", raw: "e30", msg: "missing keys"}, // {}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := Decode(tt.raw)
+			assert.Nil(t, decoded)
+			assert.True(t, errors.Is(err, ErrInvalidCursor))
+			assert.Contains(t, err.Error(), tt.msg)
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	keys := func(v int64) []int64 { return []int64{v} }
+
+	tests := []struct {
+		name  string
+		rows  []int64 // 查询结果，向前翻页时为倒序
+		limit int64
+		cur   *Cursor
+		list  []int64
+		next  *Cursor
+		prev  *Cursor
+	}{
+		{
+			name:  "first page with more",
+			rows:  []int64{10, 9, 8},
+			limit: 2,
+			list:  []int64{10, 9},
+			next:  &Cursor{Keys: []int64{9}},
+		},
+		{
+			name:  "single page",
+			rows:  []int64{10, 9},
+			limit: 2,
+			list:  []int64{10, 9},
+		},
+		{
+			name:  "empty first page",
+			limit: 2,
+		},
+		{
+			name:  "middle page forward",
+			rows:  []int64{8, 7, 6},
+			limit: 2,
+			cur:   &Cursor{Keys: []int64{9}},
+			list:  []int64{8, 7},
+			next:  &Cursor{Keys: []int64{7}},
+			prev:  &Cursor{Keys: []int64{8}, Backward: true},
+		},
+		{
+			name:  "last page forward",
+			rows:  []int64{2, 1},
+			limit: 2,
+			cur:   &Cursor{Keys: []int64{3}},
+			list:  []int64{2, 1},
+			prev:  &Cursor{Keys: []int64{2}, Backward: true},
+		},
+		{
+			name:  "backward with more",
+			rows:  []int64{5, 6, 7},
+			limit: 2,
+			cur:   &Cursor{Keys: []int64{4}, Backward: true},
+			list:  []int64{6, 5},
+			next:  &Cursor{Keys: []int64{5}},
+			prev:  &Cursor{Keys: []int64{6}, Backward: true},
+		},
+		{
+			name:  "backward reaches first page",
+			rows:  []int64{9, 10},
+			limit: 2,
+			cur:   &Cursor{Keys: []int64{8}, Backward: true},
+			list:  []int64{10, 9},
+			next:  &Cursor{Keys: []int64{9}},
+		},
+		{
+			name:  "empty backward page",
+			limit: 2,
+			cur:   &Cursor{Keys: []int64{11}, Backward: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := Paginate(tt.rows, tt.limit, tt.cur, keys)
+			assert.Equal(t, tt.list, page.List)
+			assert.Equal(t, tt.next, page.Next)
+			assert.Equal(t, tt.prev, page.Prev)
+		})
+	}
+}
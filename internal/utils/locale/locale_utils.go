@@ -0,0 +1,136 @@
+// Package locale 提供语言标签规范化与按语言回退的工具函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+// ErrInvalidLocale 语言标签格式不合法
+var ErrInvalidLocale = errors.New("invalid locale")
+
+// ErrUnsupportedLocale 站点不支持该语言
+var ErrUnsupportedLocale = errors.New("unsupported locale")
+
+// tagPattern 支持的语言标签格式：语言[-文字][-地区]，如 zh、zh-CN、zh-Hant-TW、es-419
+var tagPattern = regexp.MustCompile(`^([a-zA-Z]{2,3})(?:[-_]([a-zA-Z]{4}))?(?:[-_]([a-zA-Z]{2}|[0-9]{3}))?$`)
+
+// Normalize 将语言标签规范化为 BCP 47 推荐写法：语言小写、文字首字母大写、地区大写，下划线视为连字符
+// 参数：
+//
+//	tag: 原始语言标签
+//
+// 返回值：
+//
+//	string: 规范化后的语言标签
+//	bool: 语言标签格式是否合法
+func Normalize(tag string) (string, bool) {
+	m := tagPattern.FindStringSubmatch(strings.TrimSpace(tag))
+	if m == nil {
+		return "", false
+	}
+
+	parts := []string{strings.ToLower(m[1])}
+	if m[2] != "" {
+		parts = append(parts, strings.ToUpper(m[2][:1])+strings.ToLower(m[2][1:]))
+	}
+	if m[3] != "" {
+		parts = append(parts, strings.ToUpper(m[3]))
+	}
+	return strings.Join(parts, "-"), true
+}
+
+// Default 获取默认语言，每次读取当前配置以支持热更新
+// 返回值：
+//
+//	string: 默认语言，未配置或配置不合法时为 consts.LocaleDefault
+func Default() string {
+	if cfgs, err := configs.GetConfig(); err == nil {
+		if tag, ok := Normalize(cfgs.I18NConfig.DefaultLocale); ok {
+			return tag
+		}
+	}
+	return consts.LocaleDefault
+}
+
+// Supported 获取站点支持的语言列表，默认语言始终位于首位，配置中格式不合法的语言被忽略
+// 返回值：
+//
+//	[]string: 支持的语言列表
+func Supported() []string {
+	defaultLocale := Default()
+	locales := []string{defaultLocale}
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		return locales
+	}
+	for _, raw := range cfgs.I18NConfig.Locales {
+		if tag, ok := Normalize(raw); ok && !slices.Contains(locales, tag) {
+			locales = append(locales, tag)
+		}
+	}
+	return locales
+}
+
+// Resolve 校验并规范化文章或分类的语言，为空时使用默认语言
+// 参数：
+//
+//	tag: 原始语言标签
+//
+// 返回值：
+//
+//	string: 规范化后的语言标签
+//	error: 语言标签格式不合法时包装 ErrInvalidLocale，站点不支持该语言时包装 ErrUnsupportedLocale
+func Resolve(tag string) (string, error) {
+	if strings.TrimSpace(tag) == "" {
+		return Default(), nil
+	}
+
+	normalized, ok := Normalize(tag)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrInvalidLocale, tag)
+	}
+	if !slices.Contains(Supported(), normalized) {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedLocale, tag)
+	}
+	return normalized, nil
+}
+
+// FallbackChain 获取按语言筛选时的回退顺序：指定语言、其基础语言（如 en-US 回退到 en）、默认语言
+// 基础语言与默认语言仅在站点支持时加入，指定语言不受站点支持列表限制以便按历史语言查询
+// 参数：
+//
+//	tag: 原始语言标签
+//
+// 返回值：
+//
+//	[]string: 按优先级排列的语言列表，tag 为空时返回 nil 表示不按语言筛选
+//	error: 语言标签格式不合法时包装 ErrInvalidLocale
+func FallbackChain(tag string) ([]string, error) {
+	if strings.TrimSpace(tag) == "" {
+		return nil, nil
+	}
+
+	normalized, ok := Normalize(tag)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLocale, tag)
+	}
+
+	supported := Supported()
+	chain := []string{normalized}
+	if base, _, found := strings.Cut(normalized, "-"); found && slices.Contains(supported, base) && !slices.Contains(chain, base) {
+		chain = append(chain, base)
+	}
+	if defaultLocale := supported[0]; !slices.Contains(chain, defaultLocale) {
+		chain = append(chain, defaultLocale)
+	}
+	return chain, nil
+}
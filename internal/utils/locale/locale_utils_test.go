@@ -0,0 +1,107 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+		ok   bool
+	}{
+		{tag: "zh-CN", want: "zh-CN", ok: true},
+		{tag: "zh_cn", want: "zh-CN", ok: true},
+		{tag: "EN", want: "en", ok: true},
+		{tag: " en-us ", want: "en-US", ok: true},
+		{tag: "zh-hant-tw", want: "zh-Hant-TW", ok: true},
+		{tag: "zh_HANS", want: "zh-Hans", ok: true},
+		{tag: "es-419", want: "es-419", ok: true},
+		{tag: "fil", want: "fil", ok: true},
+		{tag: "e", ok: false},
+		{tag: "english", ok: false},
+		{tag: "en-USA", ok: false},
+		{tag: "zh--CN", ok: false},
+		{tag: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, ok := Normalize(tt.tag)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestDefaultWithoutConfig 须在加载配置的测试之前运行
+func TestDefaultWithoutConfig(t *testing.T) {
+	assert.Equal(t, consts.LocaleDefault, Default())
+	assert.Equal(t, []string{consts.LocaleDefault}, Supported())
+}
+
+func TestResolve(t *testing.T) {
+	require.NoError(t, configs.New("../../../configs/configs.yaml"))
+
+	tests := []struct {
+		tag  string
+		want string
+		err  error
+	}{
+		{tag: "", want: "zh-CN"},
+		{tag: "  ", want: "zh-CN"},
+		{tag: "zh_cn", want: "zh-CN"},
+		{tag: "EN", want: "en"},
+		{tag: "fr", err: ErrUnsupportedLocale},
+		{tag: "en-US", err: ErrUnsupportedLocale},
+		{tag: "not a locale", err: ErrInvalidLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, err := Resolve(tt.tag)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFallbackChain(t *testing.T) {
+	require.NoError(t, configs.New("../../../configs/configs.yaml"))
+
+	tests := []struct {
+		tag  string
+		want []string
+		err  error
+	}{
+		{tag: "", want: nil},
+		{tag: "zh-CN", want: []string{"zh-CN"}},
+		{tag: "en", want: []string{"en", "zh-CN"}},
+		{tag: "en_us", want: []string{"en-US", "en", "zh-CN"}},
+		{tag: "zh-Hant-TW", want: []string{"zh-Hant-TW", "zh-CN"}},
+		{tag: "fr-FR", want: []string{"fr-FR", "zh-CN"}},
+		{tag: "???", err: ErrInvalidLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, err := FallbackChain(tt.tag)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
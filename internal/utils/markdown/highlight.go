@@ -0,0 +1,108 @@
+// Package markdown 提供代码块高亮与 mermaid 图表渲染扩展
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// mermaidLanguage mermaid 图表代码块的语言标识
+const mermaidLanguage = "mermaid"
+
+// codeBlockRenderer 围栏代码块渲染器，按配置进行服务端语法高亮或输出 mermaid 图表容器
+type codeBlockRenderer struct {
+	highlight bool                  // 是否启用语法高亮
+	mermaid   bool                  // 是否识别 mermaid 代码块
+	style     *chroma.Style         // 高亮样式
+	formatter *chromahtml.Formatter // 高亮输出格式
+}
+
+// newCodeBlockRenderer 创建围栏代码块渲染器
+func newCodeBlockRenderer(highlight, mermaid, lineNumbers bool, styleName string) *codeBlockRenderer {
+	return &codeBlockRenderer{
+		highlight: highlight,
+		mermaid:   mermaid,
+		style:     styles.Get(styleName),
+		formatter: chromahtml.New(
+			chromahtml.WithClasses(false), // 使用内联样式，无需前端引入额外的 CSS
+			chromahtml.WithLineNumbers(lineNumbers),
+			chromahtml.TabWidth(4),
+		),
+	}
+}
+
+// RegisterFuncs 注册节点渲染函数
+func (r *codeBlockRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFencedCodeBlock, r.renderFencedCodeBlock)
+}
+
+// renderFencedCodeBlock 渲染围栏代码块，无法识别语言或高亮失败时退化为普通代码块
+func (r *codeBlockRenderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*ast.FencedCodeBlock)
+	language := strings.ToLower(string(n.Language(source)))
+
+	var code bytes.Buffer
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		code.Write(segment.Value(source))
+	}
+
+	if r.mermaid && language == mermaidLanguage {
+		// mermaid.js 读取元素的文本内容，转义后的实体会被还原
+		_, _ = w.WriteString(`<pre class="mermaid">`)
+		_, _ = w.Write(util.EscapeHTML(code.Bytes()))
+		_, _ = w.WriteString("</pre>\n")
+		return ast.WalkSkipChildren, nil
+	}
+
+	if r.highlight && language != "" {
+		if lexer := lexers.Get(language); lexer != nil {
+			iterator, err := chroma.Coalesce(lexer).Tokenise(nil, code.String())
+			if err == nil {
+				var highlighted bytes.Buffer
+				if err := r.formatter.Format(&highlighted, r.style, iterator); err == nil {
+					_, _ = w.Write(highlighted.Bytes())
+					_ = w.WriteByte('\n')
+					return ast.WalkSkipChildren, nil
+				}
+			}
+		}
+	}
+
+	_, _ = w.WriteString("<pre><code")
+	if language != "" {
+		_, _ = w.WriteString(` class="language-`)
+		_, _ = w.Write(util.EscapeHTML([]byte(language)))
+		_ = w.WriteByte('"')
+	}
+	_ = w.WriteByte('>')
+	_, _ = w.Write(util.EscapeHTML(code.Bytes()))
+	_, _ = w.WriteString("</code></pre>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// codeBlockExtension 围栏代码块扩展
+type codeBlockExtension struct {
+	renderer *codeBlockRenderer
+}
+
+// Extend 注册渲染器，优先级高于默认 HTML 渲染器以覆盖其围栏代码块渲染
+func (e *codeBlockExtension) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(util.Prioritized(e.renderer, 200)))
+}
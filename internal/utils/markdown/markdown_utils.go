@@ -5,6 +5,9 @@ package markdown
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"sync"
 
 	"github.com/yuin/goldmark"
@@ -12,8 +15,18 @@ import (
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/utils/sanitize"
 )
 
+// rendererVersion 渲染管线版本，渲染逻辑发生不兼容变化时递增，使已有文章重新渲染
+const rendererVersion = 1
+
+// defaultHighlightStyle 默认代码高亮样式
+const defaultHighlightStyle = "github"
+
 // 使用 sync.Pool 复用 buffer
 var bufferPool = sync.Pool{
 	New: func() any {
@@ -21,6 +34,16 @@ var bufferPool = sync.Pool{
 	},
 }
 
+// renderers 按 Markdown 渲染配置缓存渲染器，goldmark.Markdown 可并发使用
+var renderers sync.Map
+
+// Result 渲染结果
+type Result struct {
+	HTML    string     // 渲染后的 HTML
+	TOC     []*TOCItem // 文章目录，未启用目录时为空
+	Version string     // 渲染配置版本，配置变化后与文章中保存的版本不一致
+}
+
 // MarkdownConfig 用于配置 Goldmark 渲染器
 type MarkdownConfig struct {
 	Extensions      []goldmark.Extender // Goldmark 扩展
@@ -73,22 +96,126 @@ func defaultMarkdownConfig() MarkdownConfig {
 	}
 }
 
-// RenderMarkdown 将 Markdown 渲染为 HTML
+// markdownConfigFromOptions 在默认配置基础上按渲染选项启用代码高亮、数学公式与 mermaid 图表
+// 参数：
+//   - options: Markdown 渲染配置
+//
+// 返回值：
+//   - MarkdownConfig: Markdown配置
+func markdownConfigFromOptions(options configs.MarkdownConfig) MarkdownConfig {
+	config := defaultMarkdownConfig()
+	if options.Highlight || options.Mermaid {
+		config.Extensions = append(config.Extensions, &codeBlockExtension{
+			renderer: newCodeBlockRenderer(options.Highlight, options.Mermaid, options.LineNumbers, options.HighlightStyle),
+		})
+	}
+	if options.Math {
+		config.Extensions = append(config.Extensions, &mathExtension{})
+	}
+	return config
+}
+
+// Options 渲染选项
+type Options struct {
+	Markdown configs.MarkdownConfig // Markdown 渲染配置
+	Sanitize configs.SanitizeConfig // HTML 清洗配置
+}
+
+// CurrentOptions 获取当前配置中的渲染选项，配置未初始化时使用零值（不启用任何可选功能，使用内置清洗白名单）
+// 返回值：
+//   - Options: 渲染选项
+func CurrentOptions() Options {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		return Options{}
+	}
+	return Options{Markdown: cfgs.MarkdownConfig, Sanitize: cfgs.SanitizeConfig}
+}
+
+// normalizeOptions 补全渲染选项默认值
+func normalizeOptions(options Options) Options {
+	if options.Markdown.HighlightStyle == "" {
+		options.Markdown.HighlightStyle = defaultHighlightStyle
+	}
+	return options
+}
+
+// Version 计算渲染选项对应的渲染版本
+// 参数：
+//   - options: 渲染选项
+//
+// 返回值：
+//   - string: 渲染版本
+func Version(options Options) string {
+	options = normalizeOptions(options)
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d|%+v", rendererVersion, options)))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// Render 使用当前配置将 Markdown 渲染为 HTML、按作者策略清洗并提取目录
 // 参数：
 //   - content: Markdown内容
+//   - trusted: 作者是否受信任，决定使用的 HTML 清洗策略
 //
 // 返回值：
-//   - string: 渲染后的 HTML
+//   - *Result: 渲染结果
 //   - error: 渲染过程中的错误
-func RenderMarkdown(content []byte) (string, error) {
-	md := NewMarkdownRenderer(defaultMarkdownConfig())
+func Render(content []byte, trusted bool) (*Result, error) {
+	return RenderWithOptions(content, CurrentOptions(), trusted)
+}
+
+// RenderWithOptions 使用指定渲染选项将 Markdown 渲染为 HTML、按作者策略清洗并提取目录
+// 参数：
+//   - content: Markdown内容
+//   - options: 渲染选项
+//   - trusted: 作者是否受信任，决定使用的 HTML 清洗策略
+//
+// 返回值：
+//   - *Result: 渲染结果
+//   - error: 渲染过程中的错误
+func RenderWithOptions(content []byte, options Options, trusted bool) (*Result, error) {
+	options = normalizeOptions(options)
+
+	key := fmt.Sprintf("%+v", options.Markdown)
+	md, ok := renderers.Load(key)
+	if !ok {
+		md, _ = renderers.LoadOrStore(key, NewMarkdownRenderer(markdownConfigFromOptions(options.Markdown)))
+	}
+	m := md.(goldmark.Markdown)
+
+	doc := m.Parser().Parse(text.NewReader(content))
+
 	buf := bufferPool.Get().(*bytes.Buffer)
 	buf.Reset()
 	defer bufferPool.Put(buf)
 
-	if err := md.Convert(content, buf); err != nil {
+	if err := m.Renderer().Render(buf, content, doc); err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		HTML:    sanitize.HTML(buf.String(), options.Sanitize, trusted),
+		TOC:     []*TOCItem{},
+		Version: Version(options),
+	}
+	if options.Markdown.TOC {
+		result.TOC = extractTOC(doc, content)
+	}
+	return result, nil
+}
+
+// RenderMarkdown 将 Markdown 渲染为 HTML，使用基础清洗策略
+// 参数：
+//   - content: Markdown内容
+//
+// 返回值：
+//   - string: 渲染后的 HTML
+//   - error: 渲染过程中的错误
+func RenderMarkdown(content []byte) (string, error) {
+	result, err := Render(content, false)
+	if err != nil {
 		return "", err
 	}
 
-	return buf.String(), nil
+	return result.HTML, nil
 }
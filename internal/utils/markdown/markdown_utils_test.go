@@ -0,0 +1,133 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Done-0/jank/configs"
+)
+
+func TestRenderWithOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		options     configs.MarkdownConfig
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:     "plain code block",
+			content:  "```go\nfmt.Println(\"<hi>\")\n```",
+			contains: []string{`<pre><code class="language-go">fmt.Println(&#34;&lt;hi&gt;&#34;)`},
+		},
+		{
+			name:        "highlighted code block",
+			content:     "```go\nfunc main() {}\n```",
+			options:     configs.MarkdownConfig{Highlight: true},
+			contains:    []string{`<pre style="`, `<span style="`, "main"},
+			notContains: []string{`class="language-go"`},
+		},
+		{
+			name:     "highlight falls back for unknown language",
+			content:  "```no-such-language\nx\n```",
+			options:  configs.MarkdownConfig{Highlight: true},
+			contains: []string{`<pre><code class="language-no-such-language">x`},
+		},
+		{
+			name:     "mermaid diagram",
+			content:  "```mermaid\ngraph TD; A-->B\n```",
+			options:  configs.MarkdownConfig{Mermaid: true},
+			contains: []string{`<pre class="mermaid">graph TD; A--&gt;B`},
+		},
+		{
+			name:        "mermaid disabled",
+			content:     "```mermaid\ngraph TD; A-->B\n```",
+			contains:    []string{`<code class="language-mermaid">`},
+			notContains: []string{`class="mermaid"`},
+		},
+		{
+			name:     "inline math",
+			content:  "Euler: $e^{i\\pi}+1=0$ done",
+			options:  configs.MarkdownConfig{Math: true},
+			contains: []string{`<span class="math inline">\(e^{i\pi}+1=0\)</span>`},
+		},
+		{
+			name:     "display math inline",
+			content:  "see $$a<b$$ here",
+			options:  configs.MarkdownConfig{Math: true},
+			contains: []string{`<span class="math display">\[a&lt;b\]</span>`},
+		},
+		{
+			name:     "math block",
+			content:  "text\n$$\nx^2\n$$\n",
+			options:  configs.MarkdownConfig{Math: true},
+			contains: []string{"<div class=\"math display\">\\[x^2\n\\]</div>"},
+		},
+		{
+			name:        "currency is not math",
+			content:     "costs $5 and $6",
+			options:     configs.MarkdownConfig{Math: true},
+			contains:    []string{"costs $5 and $6"},
+			notContains: []string{"math"},
+		},
+		{
+			name:        "math disabled",
+			content:     "$x$",
+			notContains: []string{"math"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := RenderWithOptions([]byte(tt.content), Options{Markdown: tt.options}, false)
+			require.NoError(t, err)
+			for _, s := range tt.contains {
+				assert.Contains(t, result.HTML, s)
+			}
+			for _, s := range tt.notContains {
+				assert.NotContains(t, result.HTML, s)
+			}
+		})
+	}
+}
+
+func TestRenderTOC(t *testing.T) {
+	content := []byte("# Intro\n\ntext\n\n## Setup {#custom-id}\n\n### Details\n\n## Setup\n")
+
+	result, err := RenderWithOptions(content, Options{Markdown: configs.MarkdownConfig{TOC: true}}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []*TOCItem{
+		{Level: 1, ID: "intro", Title: "Intro"},
+		{Level: 2, ID: "custom-id", Title: "Setup"},
+		{Level: 3, ID: "details", Title: "Details"},
+		{Level: 2, ID: "setup", Title: "Setup"},
+	}, result.TOC)
+	assert.Contains(t, result.HTML, `<h2 id="custom-id">Setup</h2>`)
+
+	result, err = RenderWithOptions(content, Options{}, false)
+	require.NoError(t, err)
+	assert.Empty(t, result.TOC)
+}
+
+func TestVersion(t *testing.T) {
+	base := Options{}
+	assert.Equal(t, Version(base), Version(Options{Markdown: configs.MarkdownConfig{HighlightStyle: defaultHighlightStyle}}))
+	assert.Len(t, Version(base), 16)
+
+	changed := []Options{
+		{Markdown: configs.MarkdownConfig{Highlight: true}},
+		{Markdown: configs.MarkdownConfig{HighlightStyle: "monokai"}},
+		{Markdown: configs.MarkdownConfig{Math: true}},
+		{Markdown: configs.MarkdownConfig{TOC: true}},
+		{Sanitize: configs.SanitizeConfig{Trusted: configs.SanitizePolicyConfig{AllowElements: []string{"iframe"}}}},
+	}
+	for _, options := range changed {
+		assert.NotEqual(t, Version(base), Version(options), "%+v", options)
+	}
+
+	result, err := RenderWithOptions([]byte("x"), base, false)
+	require.NoError(t, err)
+	assert.Equal(t, Version(base), result.Version)
+}
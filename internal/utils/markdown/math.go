@@ -0,0 +1,237 @@
+// Package markdown 提供数学公式解析与渲染扩展
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// 数学公式节点类型
+var (
+	KindMath      = ast.NewNodeKind("Math")      // 行内公式，$...$ 或 $$...$$
+	KindMathBlock = ast.NewNodeKind("MathBlock") // 块级公式，独占多行的 $$ 围栏
+)
+
+// Math 行内公式节点
+type Math struct {
+	ast.BaseInline
+	Display bool         // 是否为展示模式（$$...$$）
+	Value   text.Segment // 公式源码
+}
+
+// Kind 返回节点类型
+func (n *Math) Kind() ast.NodeKind {
+	return KindMath
+}
+
+// Dump 输出节点调试信息
+func (n *Math) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Value": string(n.Value.Value(source))}, nil)
+}
+
+// MathBlock 块级公式节点，公式源码保存在 Lines 中
+type MathBlock struct {
+	ast.BaseBlock
+}
+
+// Kind 返回节点类型
+func (n *MathBlock) Kind() ast.NodeKind {
+	return KindMathBlock
+}
+
+// IsRaw 块内容不再解析为 Markdown
+func (n *MathBlock) IsRaw() bool {
+	return true
+}
+
+// Dump 输出节点调试信息
+func (n *MathBlock) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+// mathInlineParser 解析行内公式，遵循 Pandoc 规则：起始 $ 后不能是空白，结束 $ 前不能是空白且后面不能紧跟数字，
+// 以避免把 "$5 and $6" 之类的金额误识别为公式
+type mathInlineParser struct{}
+
+// Trigger 触发字符
+func (p *mathInlineParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Parse 解析行内公式
+func (p *mathInlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+
+	delim := 1
+	if len(line) > 1 && line[1] == '$' {
+		delim = 2
+	}
+	body := line[delim:]
+	if len(body) == 0 || util.IsSpace(body[0]) {
+		return nil
+	}
+
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++ // 跳过转义字符，公式中的 \$ 不作为结束符
+		case '$':
+			if delim == 2 {
+				if i+1 >= len(body) || body[i+1] != '$' {
+					continue
+				}
+			} else if util.IsSpace(body[i-1]) || (i+1 < len(body) && body[i+1] >= '0' && body[i+1] <= '9') {
+				continue
+			}
+
+			node := &Math{
+				Display: delim == 2,
+				Value:   text.NewSegment(segment.Start+delim, segment.Start+delim+i),
+			}
+			block.Advance(delim*2 + i)
+			return node
+		}
+	}
+	return nil
+}
+
+// mathBlockState 块级公式解析状态
+type mathBlockState struct {
+	node   ast.Node
+	closed bool // 起始行已包含结束符
+}
+
+var mathBlockStateKey = parser.NewContextKey()
+
+// mathBlockParser 解析以 $$ 独占一行开始和结束的块级公式
+type mathBlockParser struct{}
+
+// Trigger 触发字符
+func (p *mathBlockParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Open 解析起始行，"$$ 公式 $$" 写在同一行时直接作为完整的块级公式
+func (p *mathBlockParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !bytes.HasPrefix(line[pos:], []byte("$$")) {
+		return nil, parser.NoChildren
+	}
+
+	node := &MathBlock{}
+	state := &mathBlockState{node: node}
+
+	rest := util.TrimRightSpace(line[pos+2:])
+	if len(rest) > 0 {
+		// 起始行带有内容时只接受同一行闭合的写法，其余交给行内解析
+		if !bytes.HasSuffix(rest, []byte("$$")) || len(rest) < 3 {
+			return nil, parser.NoChildren
+		}
+		start := segment.Start + pos + 2
+		node.Lines().Append(text.NewSegment(start, start+len(rest)-2))
+		state.closed = true
+	}
+
+	pc.Set(mathBlockStateKey, state)
+	return node, parser.NoChildren
+}
+
+// Continue 逐行读取公式内容直到遇到结束行 $$
+func (p *mathBlockParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	state := pc.Get(mathBlockStateKey).(*mathBlockState)
+	if state.closed {
+		return parser.Close
+	}
+
+	line, segment := reader.PeekLine()
+	if bytes.Equal(util.TrimRightSpace(util.TrimLeftSpace(line)), []byte("$$")) {
+		reader.Advance(segment.Len() - 1)
+		return parser.Close
+	}
+
+	node.Lines().Append(segment)
+	reader.Advance(segment.Len() - 1)
+	return parser.Continue | parser.NoChildren
+}
+
+// Close 清理解析状态
+func (p *mathBlockParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {
+	if state, ok := pc.Get(mathBlockStateKey).(*mathBlockState); ok && state.node == node {
+		pc.Set(mathBlockStateKey, nil)
+	}
+}
+
+// CanInterruptParagraph 块级公式可以打断段落
+func (p *mathBlockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine 缩进行不会开启块级公式
+func (p *mathBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// mathRenderer 将公式节点渲染为 KaTeX / MathJax 可识别的 \(...\) 与 \[...\] 定界格式
+type mathRenderer struct{}
+
+// RegisterFuncs 注册节点渲染函数
+func (r *mathRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindMath, r.renderMath)
+	reg.Register(KindMathBlock, r.renderMathBlock)
+}
+
+// renderMath 渲染行内公式
+func (r *mathRenderer) renderMath(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*Math)
+	if n.Display {
+		_, _ = w.WriteString(`<span class="math display">\[`)
+		_, _ = w.Write(util.EscapeHTML(n.Value.Value(source)))
+		_, _ = w.WriteString(`\]</span>`)
+	} else {
+		_, _ = w.WriteString(`<span class="math inline">\(`)
+		_, _ = w.Write(util.EscapeHTML(n.Value.Value(source)))
+		_, _ = w.WriteString(`\)</span>`)
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+// renderMathBlock 渲染块级公式
+func (r *mathRenderer) renderMathBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	_, _ = w.WriteString(`<div class="math display">\[`)
+	lines := node.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		_, _ = w.Write(util.EscapeHTML(segment.Value(source)))
+	}
+	_, _ = w.WriteString("\\]</div>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// mathExtension 数学公式扩展
+type mathExtension struct{}
+
+// Extend 注册公式解析器与渲染器，优先级高于默认段落解析以便 $$ 能够打断段落
+func (e *mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(&mathBlockParser{}, 701)),
+		parser.WithInlineParsers(util.Prioritized(&mathInlineParser{}, 501)),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(util.Prioritized(&mathRenderer{}, 500)))
+}
@@ -0,0 +1,55 @@
+// Package markdown 提供文章目录提取功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package markdown
+
+import (
+	"github.com/yuin/goldmark/ast"
+)
+
+// TOCItem 目录项
+type TOCItem struct {
+	Level int    `json:"level"` // 标题级别，1-6
+	ID    string `json:"id"`    // 标题锚点 ID，由 WithAutoHeadingID 生成或通过 {#id} 属性指定
+	Title string `json:"title"` // 标题文本
+}
+
+// extractTOC 按文档顺序提取所有带 ID 的标题
+// 参数：
+//
+//	doc: 解析后的文档节点
+//	source: Markdown 源内容
+//
+// 返回值：
+//
+//	[]*TOCItem: 目录项列表
+func extractTOC(doc ast.Node, source []byte) []*TOCItem {
+	items := make([]*TOCItem, 0)
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		heading, ok := n.(*ast.Heading)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		id, ok := heading.AttributeString("id")
+		if !ok {
+			return ast.WalkSkipChildren, nil
+		}
+		idBytes, ok := id.([]byte)
+		if !ok {
+			return ast.WalkSkipChildren, nil
+		}
+
+		items = append(items, &TOCItem{
+			Level: heading.Level,
+			ID:    string(idBytes),
+			Title: string(heading.Text(source)),
+		})
+		return ast.WalkSkipChildren, nil
+	})
+	return items
+}
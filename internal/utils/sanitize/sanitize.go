@@ -0,0 +1,133 @@
+// Package sanitize 提供文章 HTML 白名单清洗工具函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package sanitize
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/microcosm-cc/bluemonday"
+
+	"github.com/Done-0/jank/configs"
+)
+
+var (
+	// rendererClassPattern Markdown 渲染器输出的 class：代码语言、数学公式、mermaid 图表、脚注
+	rendererClassPattern = regexp.MustCompile(`^(language-[\w+#.-]+|math inline|math display|mermaid|footnotes|footnote-ref|footnote-backref)$`)
+	// checkboxPattern 任务列表复选框
+	checkboxPattern = regexp.MustCompile(`^checkbox$`)
+)
+
+// highlightStyles 代码高亮内联样式使用的 CSS 属性
+var highlightStyles = []string{
+	"color", "background-color", "font-weight", "font-style", "text-decoration",
+	"display", "white-space", "tab-size", "-moz-tab-size", "-o-tab-size",
+	"margin-right", "padding", "user-select", "-webkit-user-select", "width",
+}
+
+// policies 按策略配置缓存清洗策略，bluemonday.Policy 构建完成后可并发使用
+var policies sync.Map
+
+// HTML 按作者是否受信任选择策略清洗 HTML
+// 参数：
+//
+//	content: 待清洗的 HTML
+//	config: 清洗配置
+//	trusted: 作者是否受信任
+//
+// 返回值：
+//
+//	string: 清洗后的 HTML
+func HTML(content string, config configs.SanitizeConfig, trusted bool) string {
+	policyConfig := config.Basic
+	if trusted {
+		policyConfig = config.Trusted
+	}
+	return policy(policyConfig).Sanitize(content)
+}
+
+// policy 获取策略配置对应的清洗策略
+func policy(config configs.SanitizePolicyConfig) *bluemonday.Policy {
+	key := fmt.Sprintf("%+v", config)
+	if p, ok := policies.Load(key); ok {
+		return p.(*bluemonday.Policy)
+	}
+	p, _ := policies.LoadOrStore(key, newPolicy(config))
+	return p.(*bluemonday.Policy)
+}
+
+// newPolicy 在 UGC 策略基础上放行渲染器输出，并按配置扩展允许的元素与属性
+// 参数：
+//
+//	config: 策略配置
+//
+// 返回值：
+//
+//	*bluemonday.Policy: 清洗策略
+func newPolicy(config configs.SanitizePolicyConfig) *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+
+	// 渲染器输出
+	p.AllowAttrs("class").Matching(rendererClassPattern).OnElements("code", "pre", "span", "div", "section", "a", "sup", "li")
+	p.AllowStyles(highlightStyles...).OnElements("pre", "span")
+	p.AllowAttrs("tabindex").Matching(bluemonday.Integer).OnElements("pre")
+	p.AllowAttrs("type").Matching(checkboxPattern).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+
+	if len(config.AllowElements) == 0 {
+		return p
+	}
+
+	elements := make([]string, 0, len(config.AllowElements))
+	allowIframe := false
+	for _, element := range config.AllowElements {
+		element = strings.ToLower(element)
+		if element == "iframe" {
+			allowIframe = true
+			continue
+		}
+		elements = append(elements, element)
+	}
+
+	if len(elements) > 0 {
+		p.AllowElements(elements...)
+		if len(config.AllowAttrs) > 0 {
+			p.AllowAttrs(config.AllowAttrs...).OnElements(elements...)
+		}
+	}
+
+	// iframe 的 src 仅允许指定域名，未配置域名时不保留 src
+	if allowIframe {
+		attrs := make([]string, 0, len(config.AllowAttrs))
+		for _, attr := range config.AllowAttrs {
+			if !strings.EqualFold(attr, "src") {
+				attrs = append(attrs, attr)
+			}
+		}
+		if len(attrs) > 0 {
+			p.AllowAttrs(attrs...).OnElements("iframe")
+		}
+		if pattern := iframeSrcPattern(config.IframeHosts); pattern != nil {
+			p.AllowAttrs("src").Matching(pattern).OnElements("iframe")
+		}
+	}
+
+	return p
+}
+
+// iframeSrcPattern 根据域名白名单生成 iframe src 匹配规则，仅允许 https 协议
+func iframeSrcPattern(hosts []string) *regexp.Regexp {
+	quoted := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if host = strings.TrimSpace(host); host != "" {
+			quoted = append(quoted, regexp.QuoteMeta(strings.ToLower(host)))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`^https://(` + strings.Join(quoted, "|") + `)(/|$)`)
+}
@@ -0,0 +1,140 @@
+package sanitize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Done-0/jank/configs"
+)
+
+func TestHTMLStripsScripts(t *testing.T) {
+	// 无论作者是否受信任、是否放行额外元素，脚本与事件处理属性都必须被移除
+	config := configs.SanitizeConfig{
+		Trusted: configs.SanitizePolicyConfig{
+			AllowElements: []string{"iframe", "video"},
+			AllowAttrs:    []string{"src", "width", "controls"},
+			IframeHosts:   []string{"www.youtube.com"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		content     string
+		notContains []string
+	}{
+		{name: "script element", content: `<p>hi</p><script>alert(1)</script>`, notContains: []string{"<script", "alert(1)"}},
+		{name: "event handler", content: `<img src="https://example.com/a.png" onerror="alert(1)">`, notContains: []string{"onerror", "alert"}},
+		{name: "javascript link", content: `<a href="javascript:alert(1)">x</a>`, notContains: []string{"javascript:"}},
+		{name: "data uri link", content: `<a href="data:text/html;base64,PHNjcmlwdD4=">x</a>`, notContains: []string{"data:"}},
+		{name: "svg onload", content: `<svg onload="alert(1)"></svg>`, notContains: []string{"<svg", "onload"}},
+		{name: "style element", content: `<style>body{display:none}</style>`, notContains: []string{"<style"}},
+		{name: "object element", content: `<object data="evil.swf"></object>`, notContains: []string{"<object"}},
+		{name: "iframe with javascript src", content: `<iframe src="javascript:alert(1)"></iframe>`, notContains: []string{"javascript:"}},
+		{name: "video event handler", content: `<video src="https://example.com/v.mp4" onplay="alert(1)"></video>`, notContains: []string{"onplay"}},
+		{name: "disallowed class", content: `<code class="evil">x</code>`, notContains: []string{"evil"}},
+		{name: "disallowed style", content: `<span style="position:fixed;top:0">x</span>`, notContains: []string{"position"}},
+	}
+
+	for _, tt := range tests {
+		for _, trusted := range []bool{false, true} {
+			got := HTML(tt.content, config, trusted)
+			for _, s := range tt.notContains {
+				assert.NotContains(t, got, s, "%s (trusted=%v): %s", tt.name, trusted, got)
+			}
+		}
+	}
+}
+
+func TestHTMLKeepsRendererOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "code language", content: `<pre><code class="language-go">x</code></pre>`, want: `<pre><code class="language-go">x</code></pre>`},
+		{name: "inline math", content: `<span class="math inline">\(x\)</span>`, want: `<span class="math inline">\(x\)</span>`},
+		{name: "block math", content: `<div class="math display">\[x\]</div>`, want: `<div class="math display">\[x\]</div>`},
+		{name: "mermaid", content: `<pre class="mermaid">graph TD</pre>`, want: `<pre class="mermaid">graph TD</pre>`},
+		{name: "highlight styles", content: `<pre tabindex="0" style="color:#1f2328;background-color:#fff"><span style="font-weight:bold">func</span></pre>`, want: `<pre tabindex="0" style="color: #1f2328; background-color: #fff"><span style="font-weight: bold">func</span></pre>`},
+		{name: "task list", content: `<li><input checked="" disabled="" type="checkbox"/> done</li>`, want: `<li><input checked="" disabled="" type="checkbox"/> done</li>`},
+		{name: "heading id", content: `<h2 id="setup">Setup</h2>`, want: `<h2 id="setup">Setup</h2>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, HTML(tt.content, configs.SanitizeConfig{}, false))
+		})
+	}
+}
+
+func TestHTMLPolicies(t *testing.T) {
+	config := configs.SanitizeConfig{
+		Trusted: configs.SanitizePolicyConfig{
+			AllowElements: []string{"IFRAME", "video"},
+			AllowAttrs:    []string{"src", "width", "controls"},
+			IframeHosts:   []string{"www.youtube.com", " player.vimeo.com "},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		content  string
+		trusted  bool
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "basic policy drops extra elements",
+			content:  `<video src="https://example.com/v.mp4" controls></video><iframe src="https://www.youtube.com/embed/x"></iframe>`,
+			excludes: []string{"<video", "<iframe"},
+		},
+		{
+			name:     "trusted policy keeps extra elements",
+			content:  `<video src="https://example.com/v.mp4" controls width="640"></video>`,
+			trusted:  true,
+			contains: []string{`<video src="https://example.com/v.mp4" controls="" width="640">`},
+		},
+		{
+			name:     "iframe from allowed host",
+			content:  `<iframe src="https://www.youtube.com/embed/x" width="560"></iframe><iframe src="https://player.vimeo.com/video/1"></iframe>`,
+			trusted:  true,
+			contains: []string{`<iframe src="https://www.youtube.com/embed/x" width="560">`, `<iframe src="https://player.vimeo.com/video/1">`},
+		},
+		{
+			name:     "iframe from other host loses src",
+			content:  `<iframe src="https://evil.example.com/x"></iframe>`,
+			trusted:  true,
+			excludes: []string{"evil.example.com"},
+		},
+		{
+			name:     "iframe host must match exactly",
+			content:  `<iframe src="https://www.youtube.com.evil.example/x"></iframe>`,
+			trusted:  true,
+			excludes: []string{"evil.example"},
+		},
+		{
+			name:     "iframe requires https",
+			content:  `<iframe src="http://www.youtube.com/embed/x"></iframe>`,
+			trusted:  true,
+			excludes: []string{"http://"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HTML(tt.content, config, tt.trusted)
+			for _, s := range tt.contains {
+				assert.Contains(t, got, s)
+			}
+			for _, s := range tt.excludes {
+				assert.NotContains(t, got, s)
+			}
+		})
+	}
+
+	t.Run("iframe without hosts keeps no src", func(t *testing.T) {
+		noHosts := configs.SanitizeConfig{Trusted: configs.SanitizePolicyConfig{AllowElements: []string{"iframe"}, AllowAttrs: []string{"src"}}}
+		assert.NotContains(t, HTML(`<iframe src="https://www.youtube.com/embed/x"></iframe>`, noHosts, true), "src=")
+	})
+}
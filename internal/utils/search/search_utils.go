@@ -0,0 +1,171 @@
+// Package search 提供全文检索结果高亮与摘要片段工具函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package search
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// 高亮标签
+const (
+	HighlightOpen  = "<mark>"
+	HighlightClose = "</mark>"
+)
+
+var (
+	htmlTagPattern    = regexp.MustCompile(`(?s)<[^>]*>`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// Terms 将检索关键词拆分为去重后的检索词
+// 参数：
+//
+//	keyword: 检索关键词
+//
+// 返回值：
+//
+//	[]string: 检索词列表
+func Terms(keyword string) []string {
+	seen := make(map[string]struct{})
+	terms := make([]string, 0)
+	for _, term := range strings.Fields(keyword) {
+		lower := strings.ToLower(term)
+		if _, ok := seen[lower]; ok {
+			continue
+		}
+		seen[lower] = struct{}{}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// PlainText 去除 HTML 标签并合并空白，得到用于生成摘要的纯文本
+// 参数：
+//
+//	content: HTML 内容
+//
+// 返回值：
+//
+//	string: 纯文本
+func PlainText(content string) string {
+	text := htmlTagPattern.ReplaceAllString(content, " ")
+	text = html.UnescapeString(text)
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(text, " "))
+}
+
+// Highlight 转义文本并以 <mark> 包裹所有命中的检索词（不区分大小写）
+// 参数：
+//
+//	text: 原始文本
+//	terms: 检索词列表
+//
+// 返回值：
+//
+//	string: 高亮后的 HTML 片段
+func Highlight(text string, terms []string) string {
+	runes := []rune(text)
+	return highlightRunes(runes, matchRanges(runes, terms))
+}
+
+// Snippet 截取首个命中位置附近的文本并高亮，未命中时返回开头片段
+// 参数：
+//
+//	text: 原始文本
+//	terms: 检索词列表
+//	radius: 命中位置前后保留的字符数
+//
+// 返回值：
+//
+//	string: 高亮后的摘要片段
+func Snippet(text string, terms []string, radius int) string {
+	runes := []rune(text)
+	ranges := matchRanges(runes, terms)
+
+	start, end := 0, min(len(runes), radius*2)
+	if len(ranges) > 0 {
+		start = max(0, ranges[0][0]-radius)
+		end = min(len(runes), ranges[0][1]+radius)
+	}
+
+	// 截取窗口内的命中区间
+	window := make([][2]int, 0, len(ranges))
+	for _, r := range ranges {
+		if r[0] >= start && r[1] <= end {
+			window = append(window, [2]int{r[0] - start, r[1] - start})
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("...")
+	}
+	b.WriteString(highlightRunes(runes[start:end], window))
+	if end < len(runes) {
+		b.WriteString("...")
+	}
+	return b.String()
+}
+
+// matchRanges 查找所有检索词的命中区间，按起始位置排序并合并重叠区间
+func matchRanges(runes []rune, terms []string) [][2]int {
+	lowered := make([]rune, len(runes))
+	for i, r := range runes {
+		lowered[i] = unicode.ToLower(r)
+	}
+
+	ranges := make([][2]int, 0)
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(term))
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(lowered); i++ {
+			if equalRunes(lowered[i:i+len(needle)], needle) {
+				ranges = append(ranges, [2]int{i, i + len(needle)})
+				i += len(needle) - 1
+			}
+		}
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+
+	merged := make([][2]int, 0, len(ranges))
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r[0] <= merged[n-1][1] {
+			merged[n-1][1] = max(merged[n-1][1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// highlightRunes 按命中区间转义并插入高亮标签
+func highlightRunes(runes []rune, ranges [][2]int) string {
+	var b strings.Builder
+	last := 0
+	for _, r := range ranges {
+		b.WriteString(html.EscapeString(string(runes[last:r[0]])))
+		b.WriteString(HighlightOpen)
+		b.WriteString(html.EscapeString(string(runes[r[0]:r[1]])))
+		b.WriteString(HighlightClose)
+		last = r[1]
+	}
+	b.WriteString(html.EscapeString(string(runes[last:])))
+	return b.String()
+}
+
+// equalRunes 比较两个 rune 切片是否相等
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
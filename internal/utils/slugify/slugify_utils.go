@@ -0,0 +1,83 @@
+// Package slugify 提供 URL slug 生成与校验工具函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package slugify
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gosimple/slug"
+)
+
+// MaxLength slug 最大长度
+const MaxLength = 100
+
+// maxUniqueAttempts 生成唯一 slug 时追加序号的最大尝试次数
+const maxUniqueAttempts = 100
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// Make 根据文本生成 slug，中文、日文、韩文等非拉丁字符会被转写为拉丁字母
+// 参数：
+//
+//	text: 原始文本（通常为标题或名称）
+//
+// 返回值：
+//
+//	string: 生成的 slug，文本无可转写字符时返回空字符串
+func Make(text string) string {
+	s := slug.Make(text)
+	if len(s) <= MaxLength {
+		return s
+	}
+
+	// 超长时在单词边界截断
+	s = s[:MaxLength]
+	if idx := strings.LastIndex(s, "-"); idx > 0 {
+		s = s[:idx]
+	}
+	return strings.Trim(s, "-")
+}
+
+// IsValid 校验 slug 格式：小写字母、数字，以单个连字符分隔
+// 参数：
+//
+//	s: 待校验的 slug
+//
+// 返回值：
+//
+//	bool: 是否合法
+func IsValid(s string) bool {
+	return len(s) <= MaxLength && slugPattern.MatchString(s)
+}
+
+// Unique 在 base 基础上追加序号生成未被占用的 slug，如 hello、hello-2、hello-3
+// 参数：
+//
+//	base: 基础 slug
+//	taken: 判断 slug 是否已被占用的函数
+//
+// 返回值：
+//
+//	string: 未被占用的 slug
+//	error: 查询出错或超过最大尝试次数
+func Unique(base string, taken func(string) (bool, error)) (string, error) {
+	for i := 1; i <= maxUniqueAttempts; i++ {
+		candidate := base
+		if i > 1 {
+			suffix := fmt.Sprintf("-%d", i)
+			candidate = strings.TrimRight(base[:min(len(base), MaxLength-len(suffix))], "-") + suffix
+		}
+
+		exists, err := taken(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("failed to generate unique slug for '%s'", base)
+}
@@ -0,0 +1,118 @@
+package slugify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "latin", text: "Hello, World!", want: "hello-world"},
+		{name: "extra separators", text: "  Go --  Generics  ", want: "go-generics"},
+		{name: "chinese", text: "你好世界", want: "ni-hao-shi-jie"},
+		{name: "mixed", text: "Go 语言", want: "go-yu-yan"},
+		{name: "symbols only", text: "!!!", want: ""},
+		{name: "empty", text: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Make(tt.text)
+			assert.Equal(t, tt.want, got)
+			if got != "" {
+				assert.True(t, IsValid(got))
+			}
+		})
+	}
+
+	t.Run("truncated at word boundary", func(t *testing.T) {
+		got := Make(strings.Repeat("word ", 40))
+		assert.LessOrEqual(t, len(got), MaxLength)
+		assert.True(t, IsValid(got))
+		assert.True(t, strings.HasSuffix(got, "word"))
+	})
+}
+
+func TestIsValid(t *testing.T) {
+	tests := []struct {
+		slug string
+		want bool
+	}{
+		{slug: "hello", want: true},
+		{slug: "hello-world-2", want: true},
+		{slug: "Hello", want: false},
+		{slug: "hello--world", want: false},
+		{slug: "-hello", want: false},
+		{slug: "hello-", want: false},
+		{slug: "hello_world", want: false},
+		{slug: "你好", want: false},
+		{slug: "", want: false},
+		{slug: strings.Repeat("a", MaxLength), want: true},
+		{slug: strings.Repeat("a", MaxLength+1), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsValid(tt.slug))
+		})
+	}
+}
+
+func TestUnique(t *testing.T) {
+	takenSet := func(slugs ...string) func(string) (bool, error) {
+		set := make(map[string]bool, len(slugs))
+		for _, s := range slugs {
+			set[s] = true
+		}
+		return func(s string) (bool, error) { return set[s], nil }
+	}
+
+	long := strings.Repeat("a", MaxLength-1) + "-b"
+
+	tests := []struct {
+		name  string
+		base  string
+		taken func(string) (bool, error)
+		want  string
+	}{
+		{name: "free", base: "hello", taken: takenSet(), want: "hello"},
+		{name: "base taken", base: "hello", taken: takenSet("hello"), want: "hello-2"},
+		{name: "several taken", base: "hello", taken: takenSet("hello", "hello-2", "hello-3"), want: "hello-4"},
+		{name: "gap is reused", base: "hello", taken: takenSet("hello", "hello-3"), want: "hello-2"},
+		{name: "long base is truncated", base: long[:MaxLength], taken: takenSet(long[:MaxLength]), want: strings.Repeat("a", MaxLength-2) + "-2"},
+		{name: "truncation trims trailing hyphen", base: strings.Repeat("a", MaxLength-3) + "-bc", taken: takenSet(strings.Repeat("a", MaxLength-3) + "-bc"), want: strings.Repeat("a", MaxLength-3) + "-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Unique(tt.base, tt.taken)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, len(got), MaxLength)
+		})
+	}
+
+	t.Run("lookup error", func(t *testing.T) {
+		lookupErr := errors.New("db down")
+		_, err := Unique("hello", func(string) (bool, error) { return false, lookupErr })
+		assert.ErrorIs(t, err, lookupErr)
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		calls := 0
+		_, err := Unique("hello", func(string) (bool, error) {
+			calls++
+			return true, nil
+		})
+		assert.EqualError(t, err, fmt.Sprintf("failed to generate unique slug for '%s'", "hello"))
+		assert.Equal(t, maxUniqueAttempts, calls)
+	})
+}
@@ -0,0 +1,183 @@
+// Package tfidf 提供支持中日韩文字的分词与 TF-IDF 关键词权重计算工具函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package tfidf
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MaxTermLength 检索词最大字节数，超出的检索词会被丢弃
+const MaxTermLength = 64
+
+var (
+	urlPattern     = regexp.MustCompile(`https?://\S+`)
+	htmlTagPattern = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// stopWords 常见英文停用词
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "but": {}, "by": {}, "can": {},
+	"do": {}, "for": {}, "from": {}, "has": {}, "have": {}, "if": {}, "in": {}, "into": {}, "is": {}, "it": {},
+	"its": {}, "not": {}, "of": {}, "on": {}, "or": {}, "so": {}, "that": {}, "the": {}, "their": {}, "then": {},
+	"there": {}, "these": {}, "this": {}, "to": {}, "was": {}, "we": {}, "were": {}, "will": {}, "with": {}, "you": {},
+	"your": {},
+}
+
+// Tokenize 将文本切分为检索词：拉丁字母与数字按单词切分并转为小写，去除停用词、单字符与纯数字；
+// 中日韩文字无空格分隔，按相邻二元组切分，单字成段时保留单字
+// 参数：
+//
+//	text: 待切分的文本
+//
+// 返回值：
+//
+//	[]string: 检索词列表，按出现顺序且保留重复
+func Tokenize(text string) []string {
+	text = htmlTagPattern.ReplaceAllString(text, " ")
+	text = urlPattern.ReplaceAllString(text, " ")
+
+	terms := make([]string, 0)
+	var word []rune
+	var cjk []rune
+
+	flushWord := func() {
+		if len(word) > 1 {
+			term := strings.ToLower(string(word))
+			if _, stop := stopWords[term]; !stop && !isNumeric(term) && len(term) <= MaxTermLength {
+				terms = append(terms, term)
+			}
+		}
+		word = word[:0]
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			terms = append(terms, string(cjk))
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			terms = append(terms, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+
+	return terms
+}
+
+// TermFrequencies 统计标题与正文的词频，标题中的检索词按 titleWeight 倍计入
+// 参数：
+//
+//	title: 标题
+//	content: 正文
+//	titleWeight: 标题权重
+//
+// 返回值：
+//
+//	map[string]int: 检索词到词频的映射
+func TermFrequencies(title, content string, titleWeight int) map[string]int {
+	frequencies := make(map[string]int)
+	for _, term := range Tokenize(title) {
+		frequencies[term] += titleWeight
+	}
+	for _, term := range Tokenize(content) {
+		frequencies[term]++
+	}
+	return frequencies
+}
+
+// Weigh 计算 TF-IDF 权重，保留权重最高的 limit 个检索词并做 L2 归一化，两篇文章的相似度即为共有检索词权重乘积之和
+// 参数：
+//
+//	frequencies: 检索词词频
+//	documentFrequencies: 包含各检索词的文档数（不含当前文档）
+//	total: 文档总数（不含当前文档）
+//	limit: 保留的检索词数量上限
+//
+// 返回值：
+//
+//	map[string]float64: 检索词到归一化权重的映射
+func Weigh(frequencies map[string]int, documentFrequencies map[string]int64, total int64, limit int) map[string]float64 {
+	type weightedTerm struct {
+		term   string
+		weight float64
+	}
+
+	weighted := make([]weightedTerm, 0, len(frequencies))
+	for term, frequency := range frequencies {
+		// 平滑 IDF，当前文档计入文档总数与文档频率
+		idf := math.Log(float64(total+1)/float64(documentFrequencies[term]+1)) + 1
+		weighted = append(weighted, weightedTerm{term: term, weight: (1 + math.Log(float64(frequency))) * idf})
+	}
+
+	sort.Slice(weighted, func(i, j int) bool {
+		if weighted[i].weight != weighted[j].weight {
+			return weighted[i].weight > weighted[j].weight
+		}
+		return weighted[i].term < weighted[j].term
+	})
+	if len(weighted) > limit {
+		weighted = weighted[:limit]
+	}
+
+	var norm float64
+	for _, w := range weighted {
+		norm += w.weight * w.weight
+	}
+	norm = math.Sqrt(norm)
+
+	weights := make(map[string]float64, len(weighted))
+	for _, w := range weighted {
+		weights[w.term] = w.weight / norm
+	}
+	return weights
+}
+
+// Terms 返回词频中的所有检索词
+// 参数：
+//
+//	frequencies: 检索词词频
+//
+// 返回值：
+//
+//	[]string: 检索词列表
+func Terms(frequencies map[string]int) []string {
+	terms := make([]string, 0, len(frequencies))
+	for term := range frequencies {
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// isCJK 判断是否为中日韩文字
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// isNumeric 判断是否为纯数字
+func isNumeric(term string) bool {
+	for _, r := range term {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package tfidf
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "latin words", text: "Hello, World! Go-lang", want: []string{"hello", "world", "go", "lang"}},
+		{name: "stop words and short tokens", text: "the cat is on a mat x", want: []string{"cat", "mat"}},
+		{name: "numbers", text: "version 2024 v2", want: []string{"version", "v2"}},
+		{name: "chinese bigrams", text: "分布式系统", want: []string{"分布", "布式", "式系", "系统"}},
+		{name: "single han character", text: "猫 cat", want: []string{"猫", "cat"}},
+		{name: "mixed scripts", text: "Go语言并发", want: []string{"go", "语言", "言并", "并发"}},
+		{name: "japanese and korean", text: "ひらがな 한국어", want: []string{"ひら", "らが", "がな", "한국", "국어"}},
+		{name: "urls and html removed", text: `<a href="https://example.com/x">link</a> see https://golang.org/doc`, want: []string{"link", "see"}},
+		{name: "overlong term dropped", text: strings.Repeat("a", MaxTermLength+1) + " ok", want: []string{"ok"}},
+		{name: "empty", text: "", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Tokenize(tt.text))
+		})
+	}
+}
+
+func TestTermFrequencies(t *testing.T) {
+	got := TermFrequencies("Go Concurrency", "go channels and go routines", 3)
+	assert.Equal(t, map[string]int{"go": 5, "concurrency": 3, "channels": 1, "routines": 1}, got)
+	assert.ElementsMatch(t, []string{"go", "concurrency", "channels", "routines"}, Terms(got))
+}
+
+func TestWeigh(t *testing.T) {
+	t.Run("normalized", func(t *testing.T) {
+		weights := Weigh(map[string]int{"go": 3, "rust": 1, "zig": 2}, map[string]int64{"go": 5}, 10, 10)
+		var sum float64
+		for _, w := range weights {
+			sum += w * w
+		}
+		assert.InDelta(t, 1, sum, 1e-9)
+	})
+
+	t.Run("rare terms weigh more", func(t *testing.T) {
+		weights := Weigh(map[string]int{"common": 1, "rare": 1}, map[string]int64{"common": 99, "rare": 1}, 100, 10)
+		assert.Greater(t, weights["rare"], weights["common"])
+	})
+
+	t.Run("frequent terms weigh more", func(t *testing.T) {
+		weights := Weigh(map[string]int{"often": 8, "once": 1}, nil, 10, 10)
+		assert.Greater(t, weights["often"], weights["once"])
+	})
+
+	t.Run("limit keeps highest weights", func(t *testing.T) {
+		weights := Weigh(map[string]int{"a1": 5, "b2": 3, "c3": 1, "d4": 1}, nil, 10, 2)
+		assert.Len(t, weights, 2)
+		assert.Contains(t, weights, "a1")
+		assert.Contains(t, weights, "b2")
+	})
+
+	t.Run("ties broken by term", func(t *testing.T) {
+		weights := Weigh(map[string]int{"beta": 1, "alpha": 1, "gamma": 1}, nil, 10, 2)
+		assert.Contains(t, weights, "alpha")
+		assert.Contains(t, weights, "beta")
+	})
+
+	t.Run("identical documents have similarity one", func(t *testing.T) {
+		frequencies := TermFrequencies("分布式系统", "raft consensus and 分布式 replication", 3)
+		a := Weigh(frequencies, map[string]int64{"raft": 2}, 20, 50)
+		b := Weigh(frequencies, map[string]int64{"raft": 2}, 20, 50)
+		var similarity float64
+		for term, w := range a {
+			similarity += w * b[term]
+		}
+		assert.InDelta(t, 1, similarity, 1e-9)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		weights := Weigh(map[string]int{}, nil, 0, 10)
+		assert.Empty(t, weights)
+		for _, w := range weights {
+			assert.False(t, math.IsNaN(w))
+		}
+	})
+}
@@ -3,7 +3,13 @@
 // 创建时间：2025-08-05
 package validator
 
-import "github.com/go-playground/validator/v10"
+import (
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/Done-0/jank/internal/utils/slugify"
+)
 
 // ValidErrRes 验证错误结果结构体
 type ValidErrRes struct {
@@ -16,6 +22,22 @@ type ValidErrRes struct {
 // NewValidator 全局验证器实例
 var NewValidator = validator.New()
 
+func init() {
+	// slug 格式校验：小写字母、数字，以单个连字符分隔
+	_ = NewValidator.RegisterValidation("slug", func(fl validator.FieldLevel) bool {
+		return slugify.IsValid(fl.Field().String())
+	})
+
+	// 资源地址校验：绝对 URL 或站内根路径（如本地存储的上传文件 /uploads/...）
+	_ = NewValidator.RegisterValidation("asset_url", func(fl validator.FieldLevel) bool {
+		s := fl.Field().String()
+		if strings.HasPrefix(s, "/") {
+			return !strings.HasPrefix(s, "//") && !strings.ContainsAny(s, "\\ \t\r\n")
+		}
+		return NewValidator.Var(s, "url") == nil
+	})
+}
+
 // Validate 参数验证器
 // 参数：
 //
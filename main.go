@@ -4,9 +4,17 @@
 package main
 
 import (
+	"os"
+
 	"github.com/Done-0/jank/cmd"
 )
 
 func main() {
+	// 子命令：按作者当前角色重新清洗已有文章的 HTML
+	if len(os.Args) > 1 && os.Args[1] == cmd.SanitizePostsCommand {
+		cmd.SanitizePosts()
+		return
+	}
+
 	cmd.Start()
 }
@@ -0,0 +1,29 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterAnalyticsRoutes 注册浏览统计相关路由
+func RegisterAnalyticsRoutes(r *route.RouterGroup) {
+	analyticsController, err := wire.NewAnalyticsController()
+	if err != nil {
+		log.Fatalf("Failed to initialize analytics controller: %v", err)
+	}
+
+	// 浏览统计路由组
+	analyticsGroup := r.Group("/analytics", jwt.New())
+	{
+		analyticsGroup.GET("/post-views", analyticsController.GetPostViews) // 获取文章每日浏览量
+		analyticsGroup.GET("/top-posts", analyticsController.ListTopPosts)  // 获取浏览量排行
+		analyticsGroup.GET("/referrers", analyticsController.ListReferrers) // 获取来源统计
+	}
+}
@@ -22,10 +22,15 @@ func RegisterCategoryRoutes(r *route.RouterGroup) {
 	// 分类路由组
 	categoryGroup := r.Group("/category")
 	{
-		categoryGroup.GET("/get", categoryController.GetCategory)           // 获取单个分类
-		categoryGroup.GET("/list", categoryController.ListCategories)       // 获取分类列表
-		categoryGroup.POST("/create", jwt.New(), categoryController.Create) // 创建分类
-		categoryGroup.POST("/update", jwt.New(), categoryController.Update) // 更新分类
-		categoryGroup.POST("/delete", jwt.New(), categoryController.Delete) // 删除分类
+		categoryGroup.GET("/get", categoryController.GetCategory)                 // 获取单个分类
+		categoryGroup.GET("/list", categoryController.ListCategories)             // 获取分类列表
+		categoryGroup.GET("/tree", categoryController.GetCategoryTree)            // 获取分类树（含文章数量）
+		categoryGroup.POST("/create", jwt.New(), categoryController.Create)       // 创建分类
+		categoryGroup.POST("/update", jwt.New(), categoryController.Update)       // 更新分类
+		categoryGroup.POST("/delete", jwt.New(), categoryController.Delete)       // 删除分类（须无子分类）
+		categoryGroup.POST("/move", jwt.New(), categoryController.Move)           // 移动分类子树
+		categoryGroup.POST("/merge", jwt.New(), categoryController.Merge)         // 合并分类，文章与子分类并入目标分类
+		categoryGroup.GET("/list-trash", jwt.New(), categoryController.ListTrash) // 获取回收站中的分类
+		categoryGroup.POST("/restore", jwt.New(), categoryController.Restore)     // 从回收站恢复分类
 	}
 }
@@ -0,0 +1,35 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterCommentRoutes 注册评论相关路由
+func RegisterCommentRoutes(r *route.RouterGroup) {
+	commentController, err := wire.NewCommentController()
+	if err != nil {
+		log.Fatalf("Failed to initialize comment controller: %v", err)
+	}
+
+	// 评论路由组
+	commentGroup := r.Group("/comment")
+	{
+		// 公开接口（登录用户评论时关联账号）
+		commentGroup.GET("/list", commentController.ListComments)                 // 获取文章评论列表
+		commentGroup.POST("/create", jwt.NewOptional(), commentController.Create) // 创建评论
+
+		// 审核接口（需要认证）
+		commentGroup.GET("/list-by-status", jwt.New(), commentController.ListCommentsByStatus) // 获取审核队列
+		commentGroup.POST("/approve", jwt.New(), commentController.Approve)                    // 审核通过评论
+		commentGroup.POST("/reject", jwt.New(), commentController.Reject)                      // 驳回评论
+		commentGroup.POST("/delete", jwt.New(), commentController.Delete)                      // 删除评论
+	}
+}
@@ -0,0 +1,25 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterFeedRoutes 注册订阅源路由，支持 category_id 查询参数输出分类订阅源
+func RegisterFeedRoutes(h *server.Hertz) {
+	feedController, err := wire.NewFeedController()
+	if err != nil {
+		log.Fatalf("Failed to initialize feed controller: %v", err)
+	}
+
+	h.GET(consts.FeedRSSPath, feedController.RSS)   // RSS 2.0 订阅源
+	h.GET(consts.FeedAtomPath, feedController.Atom) // Atom 订阅源
+	h.GET(consts.FeedJSONPath, feedController.JSON) // JSON Feed 订阅源
+}
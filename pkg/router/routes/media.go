@@ -0,0 +1,46 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package routes
+
+import (
+	"log"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterMediaRoutes 注册媒体文件相关路由（包含本地存储文件的静态访问路由）
+func RegisterMediaRoutes(h *server.Hertz, apiGroup *route.RouterGroup) {
+	mediaController, err := wire.NewMediaController()
+	if err != nil {
+		log.Fatalf("Failed to initialize media controller: %v", err)
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		log.Fatalf("Failed to get config: %v", err)
+	}
+
+	// 本地存储文件静态访问，去除访问路径前缀后映射到存储目录
+	if prefix := strings.Trim(cfgs.MediaConfig.Local.URLPrefix, "/"); prefix != "" && cfgs.MediaConfig.Local.Dir != "" {
+		h.StaticFS("/"+prefix, &app.FS{
+			Root:        cfgs.MediaConfig.Local.Dir,
+			PathRewrite: app.NewPathSlashesStripper(strings.Count(prefix, "/") + 1),
+		})
+	}
+
+	// 媒体文件路由组
+	mediaGroup := apiGroup.Group("/media", jwt.New())
+	{
+		mediaGroup.POST("/upload", mediaController.Upload) // 上传媒体文件
+		mediaGroup.GET("/list", mediaController.ListMedia) // 获取媒体文件列表
+		mediaGroup.POST("/delete", mediaController.Delete) // 删除媒体文件
+	}
+}
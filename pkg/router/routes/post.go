@@ -22,11 +22,35 @@ func RegisterPostRoutes(r *route.RouterGroup) {
 	// 文章路由组
 	postGroup := r.Group("/post")
 	{
-		postGroup.GET("/get", postController.GetPost)                                 // 获取单篇文章
-		postGroup.GET("/list-published", postController.ListPublishedPosts)           // 获取已发布文章列表
-		postGroup.GET("/list-by-status", jwt.New(), postController.ListPostsByStatus) // 根据状态获取文章列表（支持管理员查询所有文章）
-		postGroup.POST("/create", jwt.New(), postController.Create)                   // 创建文章
-		postGroup.POST("/update", jwt.New(), postController.Update)                   // 更新文章
-		postGroup.POST("/delete", jwt.New(), postController.Delete)                   // 删除文章
+		postGroup.GET("/get", jwt.NewOptional(), postController.GetPost)               // 获取单篇文章（作者与持有解锁凭证的读者可阅读密码保护文章）
+		postGroup.GET("/list-published", postController.ListPublishedPosts)            // 获取已发布文章列表
+		postGroup.GET("/list-by-status", jwt.New(), postController.ListPostsByStatus)  // 根据状态获取文章列表（支持管理员查询所有文章）
+		postGroup.GET("/list-by-author", postController.ListPostsByAuthor)             // 获取指定作者的已发布文章列表
+		postGroup.GET("/search", postController.SearchPosts)                           // 全文检索已发布文章
+		postGroup.GET("/related", postController.ListRelatedPosts)                     // 获取相关文章 ?id=xxx&limit=5
+		postGroup.GET("/list-featured", postController.ListFeaturedPosts)              // 获取精选文章列表
+		postGroup.POST("/create", jwt.New(), postController.Create)                    // 创建文章
+		postGroup.POST("/update", jwt.New(), postController.Update)                    // 更新文章
+		postGroup.POST("/delete", jwt.New(), postController.Delete)                    // 删除文章
+		postGroup.POST("/bulk", jwt.New(), postController.Bulk)                        // 批量修改状态、移动分类、删除、恢复或重新渲染文章
+		postGroup.GET("/list-trash", jwt.New(), postController.ListTrash)              // 获取回收站中的文章（拥有越权管理权限时含其他作者的文章）
+		postGroup.POST("/restore", jwt.New(), postController.Restore)                  // 从回收站恢复文章
+		postGroup.POST("/pin", jwt.New(), postController.Pin)                          // 设置或取消文章置顶
+		postGroup.POST("/feature", jwt.New(), postController.Feature)                  // 设置或取消文章精选
+		postGroup.POST("/reorder", jwt.New(), postController.ReorderPosts)             // 批量调整置顶或精选文章顺序
+		postGroup.GET("/list-revisions", jwt.New(), postController.ListRevisions)      // 获取文章修订列表
+		postGroup.GET("/diff-revisions", jwt.New(), postController.DiffRevisions)      // 对比两个文章修订
+		postGroup.POST("/restore-revision", jwt.New(), postController.RestoreRevision) // 将文章恢复为指定修订
+		postGroup.POST("/react", jwt.NewOptional(), postController.React)              // 添加文章表态（登录用户与匿名访客均可）
+		postGroup.POST("/unreact", jwt.NewOptional(), postController.Unreact)          // 取消文章表态
+		postGroup.GET("/list-reacted", jwt.New(), postController.ListReactedPosts)     // 获取当前用户表态过的文章列表 ?type=like
+		postGroup.POST("/unlock", postController.Unlock)                               // 输入访问密码解锁密码保护文章
+		postGroup.GET("/preview", postController.Preview)                              // 通过预览链接阅读文章 ?token=xxx，无需登录
+		postGroup.POST("/create-preview", jwt.New(), postController.CreatePreview)     // 创建文章预览链接
+		postGroup.GET("/list-previews", jwt.New(), postController.ListPreviews)        // 获取文章预览链接列表
+		postGroup.POST("/revoke-preview", jwt.New(), postController.RevokePreview)     // 撤销文章预览链接
+		postGroup.POST("/autosave", jwt.New(), postController.Autosave)                // 自动保存当前用户的文章草稿，post_id 为空时保存新文章草稿
+		postGroup.GET("/get-autosave", jwt.New(), postController.GetAutosave)          // 获取当前用户自动保存的文章草稿 ?post_id=xxx
+		postGroup.POST("/discard-autosave", jwt.New(), postController.DiscardAutosave) // 丢弃当前用户自动保存的文章草稿
 	}
 }
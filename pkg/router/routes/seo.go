@@ -0,0 +1,24 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterSEORoutes 注册站点地图与 robots.txt 路由
+func RegisterSEORoutes(h *server.Hertz) {
+	seoController, err := wire.NewSEOController()
+	if err != nil {
+		log.Fatalf("Failed to initialize seo controller: %v", err)
+	}
+
+	h.GET(consts.SitemapPath, seoController.Sitemap) // 站点地图，URL 数量超过上限时返回站点地图索引
+	h.GET(consts.RobotsPath, seoController.Robots)   // robots.txt
+}
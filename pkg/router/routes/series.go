@@ -0,0 +1,32 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterSeriesRoutes 注册文章系列相关路由
+func RegisterSeriesRoutes(r *route.RouterGroup) {
+	seriesController, err := wire.NewSeriesController()
+	if err != nil {
+		log.Fatalf("Failed to initialize series controller: %v", err)
+	}
+
+	// 系列路由组
+	seriesGroup := r.Group("/series")
+	{
+		seriesGroup.GET("/get", jwt.NewOptional(), seriesController.GetSeries) // 获取系列及其文章，创建者可见未发布文章
+		seriesGroup.GET("/list", seriesController.ListSeries)                  // 获取系列列表
+		seriesGroup.POST("/create", jwt.New(), seriesController.Create)        // 创建系列
+		seriesGroup.POST("/update", jwt.New(), seriesController.Update)        // 更新系列
+		seriesGroup.POST("/delete", jwt.New(), seriesController.Delete)        // 删除系列
+		seriesGroup.POST("/reorder", jwt.New(), seriesController.Reorder)      // 设置系列文章及顺序
+	}
+}
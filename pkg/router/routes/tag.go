@@ -0,0 +1,30 @@
+// Package routes 提供路由注册功能
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package routes
+
+import (
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/Done-0/jank/internal/middleware/jwt"
+	"github.com/Done-0/jank/pkg/wire"
+)
+
+// RegisterTagRoutes 注册标签相关路由
+func RegisterTagRoutes(r *route.RouterGroup) {
+	tagController, err := wire.NewTagController()
+	if err != nil {
+		log.Fatalf("Failed to initialize tag controller: %v", err)
+	}
+
+	// 标签路由组
+	tagGroup := r.Group("/tag")
+	{
+		tagGroup.GET("/list", tagController.ListTags)             // 获取标签列表
+		tagGroup.POST("/create", jwt.New(), tagController.Create) // 创建标签
+		tagGroup.POST("/update", jwt.New(), tagController.Update) // 更新标签
+		tagGroup.POST("/delete", jwt.New(), tagController.Delete) // 删除标签
+	}
+}
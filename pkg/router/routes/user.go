@@ -39,5 +39,8 @@ func RegisterUserRoutes(r *route.RouterGroup) {
 		userGroup.GET("/list", userController.ListUsers)                // 获取用户列表（管理员）
 
 		userGroup.POST("/role", jwt.New(), userController.UpdateUserRole) // 更新用户角色（管理员）
+		userGroup.POST("/delete", jwt.New(), userController.Delete)       // 将用户移入回收站（管理员）
+		userGroup.GET("/list-trash", jwt.New(), userController.ListTrash) // 获取回收站中的用户（管理员）
+		userGroup.POST("/restore", jwt.New(), userController.Restore)     // 从回收站恢复用户（管理员）
 	}
 }
@@ -0,0 +1,119 @@
+// Package controller 浏览统计控制器
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// AnalyticsController 浏览统计控制器
+type AnalyticsController struct {
+	analyticsService service.AnalyticsService
+}
+
+// NewAnalyticsController 创建浏览统计控制器
+func NewAnalyticsController(analyticsService service.AnalyticsService) *AnalyticsController {
+	return &AnalyticsController{
+		analyticsService: analyticsService,
+	}
+}
+
+// GetPostViews 获取文章每日浏览量
+// @Router /api/v1/analytics/post-views [get]
+func (ac *AnalyticsController) GetPostViews(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetPostViewsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := ac.analyticsService.GetPostViews(c, req)
+	if err != nil {
+		c.JSON(analyticsErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrAnalyticsPostViewsFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListTopPosts 获取浏览量排行
+// @Router /api/v1/analytics/top-posts [get]
+func (ac *AnalyticsController) ListTopPosts(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListTopPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := ac.analyticsService.ListTopPosts(c, req)
+	if err != nil {
+		c.JSON(analyticsErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrAnalyticsTopPostsFailed, errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListReferrers 获取来源统计
+// @Router /api/v1/analytics/referrers [get]
+func (ac *AnalyticsController) ListReferrers(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListReferrersRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := ac.analyticsService.ListReferrers(c, req)
+	if err != nil {
+		c.JSON(analyticsErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrAnalyticsReferrersFailed, errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// analyticsErrorStatus 根据浏览统计错误选择 HTTP 状态码
+func analyticsErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrPermissionDenied):
+		return consts.StatusForbidden
+	case errors.Is(err, service.ErrPostNotFound):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -5,11 +5,14 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"net/url"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 
 	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/cursor"
 	"github.com/Done-0/jank/internal/utils/errorx"
 	"github.com/Done-0/jank/internal/utils/validator"
 	"github.com/Done-0/jank/internal/utils/vo"
@@ -46,7 +49,17 @@ func (cc *CategoryController) GetCategory(ctx context.Context, c *app.RequestCon
 
 	response, err := cc.categoryService.GetCategory(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCategoryGetFailed, errorx.KV("id", req.ID))))
+		id := req.ID
+		if id == "" {
+			id = req.Slug
+		}
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCategoryGetFailed, errorx.KV("id", id))))
+		return
+	}
+
+	// 通过曾用 slug 访问时永久重定向到当前 slug
+	if req.Slug != "" && response.Slug != req.Slug {
+		c.Redirect(consts.StatusMovedPermanently, []byte(string(c.Path())+"?slug="+url.QueryEscape(response.Slug)))
 		return
 	}
 
@@ -70,7 +83,7 @@ func (cc *CategoryController) ListCategories(ctx context.Context, c *app.Request
 
 	response, err := cc.categoryService.ListCategories(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCategoryListFailed, errorx.KV("msg", "list categories failed"))))
+		c.JSON(categoryErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrCategoryListFailed, errorx.KV("msg", "list categories failed"))))
 		return
 	}
 
@@ -94,7 +107,11 @@ func (cc *CategoryController) Create(ctx context.Context, c *app.RequestContext)
 
 	response, err := cc.categoryService.Create(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCategoryCreateFailed, errorx.KV("name", req.Name))))
+		if isSlugConflict(err) {
+			c.JSON(consts.StatusConflict, vo.Fail(c, err, errorx.New(errno.ErrResourceConflict, errorx.KV("resource", "slug"), errorx.KV("id", req.Slug))))
+			return
+		}
+		c.JSON(categoryErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrCategoryCreateFailed, errorx.KV("name", req.Name))))
 		return
 	}
 
@@ -118,7 +135,11 @@ func (cc *CategoryController) Update(ctx context.Context, c *app.RequestContext)
 
 	response, err := cc.categoryService.Update(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCategoryUpdateFailed, errorx.KV("id", req.ID))))
+		if isSlugConflict(err) {
+			c.JSON(consts.StatusConflict, vo.Fail(c, err, errorx.New(errno.ErrResourceConflict, errorx.KV("resource", "slug"), errorx.KV("id", req.Slug))))
+			return
+		}
+		c.JSON(categoryErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrCategoryUpdateFailed, errorx.KV("id", req.ID))))
 		return
 	}
 
@@ -142,9 +163,143 @@ func (cc *CategoryController) Delete(ctx context.Context, c *app.RequestContext)
 
 	response, err := cc.categoryService.Delete(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCategoryDeleteFailed, errorx.KV("id", req.ID))))
+		c.JSON(categoryErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrCategoryDeleteFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// GetCategoryTree 获取分类树
+// @Router /api/v1/category/tree [get]
+func (cc *CategoryController) GetCategoryTree(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetCategoryTreeRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	response, err := cc.categoryService.GetCategoryTree(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrCategoryTreeFailed, errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Move 移动分类子树
+// @Router /api/v1/category/move [post]
+func (cc *CategoryController) Move(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.MoveCategoryRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.categoryService.Move(c, req)
+	if err != nil {
+		c.JSON(categoryErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrCategoryMoveFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Merge 合并分类
+// @Router /api/v1/category/merge [post]
+func (cc *CategoryController) Merge(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.MergeCategoryRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.categoryService.Merge(c, req)
+	if err != nil {
+		c.JSON(categoryErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrCategoryMergeFailed, errorx.KV("id", req.SourceID))))
 		return
 	}
 
 	c.JSON(consts.StatusOK, vo.Success(c, response))
 }
+
+// ListTrash 获取回收站中的分类
+// @Router /api/v1/category/list-trash [get]
+func (cc *CategoryController) ListTrash(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListTrashRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.categoryService.ListTrash(c, req)
+	if err != nil {
+		c.JSON(trashErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrCategoryTrashListFailed, errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Restore 从回收站恢复分类
+// @Router /api/v1/category/restore [post]
+func (cc *CategoryController) Restore(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.RestoreCategoryRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := cc.categoryService.Restore(c, req)
+	if err != nil {
+		c.JSON(trashErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrCategoryRestoreFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// categoryErrorStatus 根据分类错误选择 HTTP 状态码
+func categoryErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, cursor.ErrInvalidCursor),
+		errors.Is(err, service.ErrInvalidID),
+		errors.Is(err, service.ErrCategoryCycle),
+		isInvalidLocale(err),
+		errors.Is(err, service.ErrInvalidTranslationSource):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrCategoryNotFound),
+		errors.Is(err, service.ErrTranslationSourceNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrCategoryHasChildren),
+		errors.Is(err, service.ErrTranslationExists):
+		return consts.StatusConflict
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -0,0 +1,26 @@
+// Package dto 提供浏览统计相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// GetPostViewsRequest 获取文章每日浏览量请求，未指定日期时统计最近 30 天
+type GetPostViewsRequest struct {
+	PostID    string `query:"post_id" validate:"required"`                         // 文章 ID
+	StartDate string `query:"start_date" validate:"omitempty,datetime=2006-01-02"` // 开始日期（含）
+	EndDate   string `query:"end_date" validate:"omitempty,datetime=2006-01-02"`   // 结束日期（含）
+}
+
+// ListTopPostsRequest 获取浏览量最高的文章请求，未指定日期时统计最近 30 天
+type ListTopPostsRequest struct {
+	StartDate string `query:"start_date" validate:"omitempty,datetime=2006-01-02"` // 开始日期（含）
+	EndDate   string `query:"end_date" validate:"omitempty,datetime=2006-01-02"`   // 结束日期（含）
+	Limit     int64  `query:"limit" validate:"required,min=1,max=100"`             // 返回数量
+}
+
+// ListReferrersRequest 获取来源统计请求，未指定文章时统计全站，未指定日期时统计最近 30 天
+type ListReferrersRequest struct {
+	PostID    string `query:"post_id"`                                             // 文章 ID（可选）
+	StartDate string `query:"start_date" validate:"omitempty,datetime=2006-01-02"` // 开始日期（含）
+	EndDate   string `query:"end_date" validate:"omitempty,datetime=2006-01-02"`   // 结束日期（含）
+	Limit     int64  `query:"limit" validate:"required,min=1,max=100"`             // 返回数量
+}
@@ -5,11 +5,14 @@ package dto
 
 // CreateCategoryRequest 创建分类请求
 type CreateCategoryRequest struct {
-	Name        string `json:"name" validate:"required,min=1,max=100"`   // 分类名称
-	Description string `json:"description" validate:"omitempty,max=500"` // 分类描述
-	ParentID    string `json:"parent_id" validate:"omitempty"`           // 父分类 ID，为空表示顶级分类
-	Sort        int64  `json:"sort" validate:"omitempty,min=0"`          // 排序权重，数字越大越靠前
-	IsActive    bool   `json:"is_active" validate:"omitempty"`           // 是否启用，默认为true
+	Name          string `json:"name" validate:"required,min=1,max=100"`   // 分类名称
+	Slug          string `json:"slug" validate:"omitempty,slug"`           // 分类 slug，为空时根据名称自动生成
+	Description   string `json:"description" validate:"omitempty,max=500"` // 分类描述
+	ParentID      string `json:"parent_id" validate:"omitempty"`           // 父分类 ID，为空表示顶级分类
+	Sort          int64  `json:"sort" validate:"omitempty,min=0"`          // 排序权重，数字越大越靠前
+	IsActive      bool   `json:"is_active" validate:"omitempty"`           // 是否启用，默认为true
+	Locale        string `json:"locale" validate:"omitempty,max=16"`       // 语言（BCP 47 语言标签），须为站点支持的语言，为空时使用默认语言
+	TranslationOf string `json:"translation_of" validate:"omitempty"`      // 原分类 ID，不为空时作为该分类的译文加入其翻译组，组内不能已有同语言的分类
 }
 
 // DeleteCategoryRequest 删除分类请求
@@ -19,23 +22,47 @@ type DeleteCategoryRequest struct {
 
 // GetCategoryRequest 获取分类请求
 type GetCategoryRequest struct {
-	ID string `query:"id" validate:"required"` // 分类 ID
+	ID   string `query:"id" validate:"required_without=Slug"` // 分类 ID
+	Slug string `query:"slug" validate:"omitempty,slug"`      // 分类 slug，ID 为空时按 slug 查询，曾用 slug 会 301 跳转
 }
 
 // UpdateCategoryRequest 更新分类请求
 type UpdateCategoryRequest struct {
-	ID          string `json:"id" validate:"required"`                   // 分类 ID
-	Name        string `json:"name" validate:"omitempty,min=1,max=100"`  // 分类名称
-	Description string `json:"description" validate:"omitempty,max=500"` // 分类描述
-	ParentID    string `json:"parent_id" validate:"omitempty"`           // 父分类 ID，为空表示顶级分类
-	Sort        int64  `json:"sort" validate:"omitempty,min=0"`          // 排序权重，数字越大越靠前
-	IsActive    bool   `json:"is_active" validate:"omitempty"`           // 是否启用
+	ID            string `json:"id" validate:"required"`                   // 分类 ID
+	Name          string `json:"name" validate:"omitempty,min=1,max=100"`  // 分类名称
+	Slug          string `json:"slug" validate:"omitempty,slug"`           // 分类 slug，为空时不修改，修改后旧 slug 将 301 跳转到新 slug
+	Description   string `json:"description" validate:"omitempty,max=500"` // 分类描述
+	ParentID      string `json:"parent_id" validate:"omitempty"`           // 父分类 ID，为空表示顶级分类
+	Sort          int64  `json:"sort" validate:"omitempty,min=0"`          // 排序权重，数字越大越靠前
+	IsActive      bool   `json:"is_active" validate:"omitempty"`           // 是否启用
+	Locale        string `json:"locale" validate:"omitempty,max=16"`       // 语言，为空时不修改，翻译组内不能已有同语言的其他分类
+	TranslationOf string `json:"translation_of" validate:"omitempty"`      // 原分类 ID，为空时不修改，不为空时改为加入该分类的翻译组，传 0 时脱离原翻译组
 }
 
 // ListCategoriesRequest 获取分类列表请求
 type ListCategoriesRequest struct {
-	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
-	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
-	ParentID string `query:"parent_id" validate:"omitempty"`              // 父分类 ID，为空时获取顶级分类
-	IsActive *bool  `query:"is_active" validate:"omitempty"`              // 是否启用，为空时获取所有分类
+	PageNo    int64  `query:"page_no" validate:"omitempty,min=1"`                       // 页码，为空时使用游标分页
+	PageSize  int64  `query:"page_size" validate:"required,min=1,max=100"`              // 每页数量
+	Cursor    string `query:"cursor" validate:"omitempty,excluded_with=PageNo,max=512"` // 分页游标，取自上次响应的 next_cursor 或 prev_cursor，为空时获取第一页
+	WithTotal bool   `query:"with_total"`                                               // 游标分页时是否统计总数，默认不统计
+	ParentID  string `query:"parent_id" validate:"omitempty"`                           // 父分类 ID，为空时获取顶级分类
+	IsActive  *bool  `query:"is_active" validate:"omitempty"`                           // 是否启用，为空时获取所有分类
+	Lang      string `query:"lang" validate:"omitempty,max=16"`                         // 语言，不为空时每个分类只返回一个语言版本，依次回退到其基础语言与默认语言
+}
+
+// GetCategoryTreeRequest 获取分类树请求
+type GetCategoryTreeRequest struct {
+	IncludeInactive bool `query:"include_inactive"` // 是否包含未启用的分类，默认不包含（未启用分类的子分类同样隐藏）
+}
+
+// MoveCategoryRequest 移动分类子树请求
+type MoveCategoryRequest struct {
+	ID       string `json:"id" validate:"required"` // 分类 ID
+	ParentID string `json:"parent_id"`              // 新父分类 ID，为空或 0 表示移动为顶级分类，不能是分类自身或其子孙分类
+}
+
+// MergeCategoryRequest 合并分类请求
+type MergeCategoryRequest struct {
+	SourceID string `json:"source_id" validate:"required"`                  // 源分类 ID，合并后删除
+	TargetID string `json:"target_id" validate:"required,nefield=SourceID"` // 目标分类 ID，不能是源分类的子孙分类
 }
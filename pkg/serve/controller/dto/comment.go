@@ -0,0 +1,38 @@
+// Package dto 提供评论相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// CreateCommentRequest 创建评论请求
+type CreateCommentRequest struct {
+	PostID      string `json:"post_id" validate:"required"`                    // 文章 ID
+	ParentID    string `json:"parent_id" validate:"omitempty"`                 // 父评论 ID，为空表示顶级评论
+	AuthorName  string `json:"author_name" validate:"omitempty,min=1,max=64"`  // 匿名评论者昵称，未登录时必填
+	AuthorEmail string `json:"author_email" validate:"omitempty,email,max=64"` // 匿名评论者邮箱，未登录时必填
+	Content     string `json:"content" validate:"required,min=1,max=5000"`     // 评论内容
+}
+
+// ListCommentsRequest 获取文章评论列表请求
+type ListCommentsRequest struct {
+	PostID   string `query:"post_id" validate:"required"`                 // 文章 ID
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
+
+// ListCommentsByStatusRequest 根据状态获取评论列表请求（审核队列）
+type ListCommentsByStatusRequest struct {
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`                       // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"`             // 每页数量
+	Status   string `query:"status" validate:"omitempty,oneof=pending approved spam"` // 评论状态，为空时获取所有评论
+	PostID   string `query:"post_id" validate:"omitempty"`                            // 文章 ID，为空时不按文章筛选
+}
+
+// ModerateCommentRequest 审核评论请求
+type ModerateCommentRequest struct {
+	ID string `json:"id" validate:"required"` // 评论 ID
+}
+
+// DeleteCommentRequest 删除评论请求
+type DeleteCommentRequest struct {
+	ID string `json:"id" validate:"required"` // 评论 ID
+}
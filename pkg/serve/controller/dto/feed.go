@@ -0,0 +1,9 @@
+// Package dto 提供订阅源相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// GetFeedRequest 获取订阅源请求
+type GetFeedRequest struct {
+	CategoryID *int64 `query:"category_id" validate:"omitempty"` // 分类ID，为空时输出全站订阅源
+}
@@ -5,12 +5,19 @@ package dto
 
 // CreatePostRequest 创建文章请求
 type CreatePostRequest struct {
-	Title       string `json:"title" validate:"required,min=1,max=255"`                            // 文章标题
-	Description string `json:"description" validate:"omitempty,max=500"`                           // 文章描述/摘要
-	Image       string `json:"image" validate:"omitempty,url"`                                     // 文章封面图片
-	Status      string `json:"status" validate:"omitempty,oneof=draft published private archived"` // 文章状态
-	CategoryID  string `json:"category_id" validate:"omitempty"`                                   // 分类 ID
-	Markdown    string `json:"markdown" validate:"omitempty,max=100000"`                           // Markdown 内容
+	Title         string   `json:"title" validate:"required,min=1,max=255"`                                                         // 文章标题
+	Slug          string   `json:"slug" validate:"omitempty,slug"`                                                                  // 文章 slug，为空时根据标题自动生成
+	Description   string   `json:"description" validate:"omitempty,max=500"`                                                        // 文章描述/摘要
+	Image         string   `json:"image" validate:"omitempty,asset_url"`                                                            // 文章封面图片，外部 URL 或媒体上传返回的地址
+	Status        string   `json:"status" validate:"omitempty,oneof=draft published private archived scheduled"`                    // 文章状态
+	CategoryID    string   `json:"category_id" validate:"omitempty"`                                                                // 分类 ID
+	TagIDs        []string `json:"tag_ids" validate:"omitempty,max=20,dive,required"`                                               // 标签 ID 列表
+	Markdown      string   `json:"markdown" validate:"omitempty,max=100000"`                                                        // Markdown 内容
+	PublishAt     string   `json:"publish_at" validate:"required_if=Status scheduled,omitempty,datetime=2006-01-02T15:04:05Z07:00"` // 定时发布时间（RFC3339），状态为 scheduled 时必填且须晚于当前时间
+	Visibility    string   `json:"visibility" validate:"omitempty,oneof=public password unlisted"`                                  // 访问方式，为空时为 public
+	Password      string   `json:"password" validate:"required_if=Visibility password,omitempty,min=4,max=72"`                      // 访问密码，访问方式为 password 时必填
+	Locale        string   `json:"locale" validate:"omitempty,max=16"`                                                              // 语言（BCP 47 语言标签），须为站点支持的语言，为空时使用默认语言
+	TranslationOf string   `json:"translation_of" validate:"omitempty"`                                                             // 原文文章 ID，不为空时作为该文章的译文加入其翻译组，组内不能已有同语言的文章
 }
 
 // DeletePostRequest 删除文章请求
@@ -20,31 +27,76 @@ type DeletePostRequest struct {
 
 // GetPostRequest 获取文章请求
 type GetPostRequest struct {
-	ID string `query:"id" validate:"required"` // 文章 ID
+	ID   string `query:"id" validate:"required_without=Slug"` // 文章 ID
+	Slug string `query:"slug" validate:"omitempty,slug"`      // 文章 slug，ID 为空时按 slug 查询，曾用 slug 会 301 跳转
+	Lang string `query:"lang" validate:"omitempty,max=16"`    // 期望语言，不为空时按回退顺序返回该文章最优先语言的已发布版本，均无则返回原文章
 }
 
 // UpdatePostRequest 更新文章请求
 type UpdatePostRequest struct {
-	ID          string `json:"id" validate:"required"`                                             // 文章 ID
-	Title       string `json:"title" validate:"omitempty,min=1,max=255"`                           // 文章标题
-	Description string `json:"description" validate:"omitempty,max=500"`                           // 文章描述/摘要
-	Image       string `json:"image" validate:"omitempty,url"`                                     // 文章封面图片
-	Status      string `json:"status" validate:"omitempty,oneof=draft published private archived"` // 文章状态
-	CategoryID  string `json:"category_id" validate:"omitempty"`                                   // 分类 ID
-	Markdown    string `json:"markdown" validate:"omitempty,max=100000"`                           // Markdown内容
+	ID            string   `json:"id" validate:"required"`                                                       // 文章 ID
+	Title         string   `json:"title" validate:"omitempty,min=1,max=255"`                                     // 文章标题
+	Slug          string   `json:"slug" validate:"omitempty,slug"`                                               // 文章 slug，为空时不修改，修改后旧 slug 将 301 跳转到新 slug
+	Description   string   `json:"description" validate:"omitempty,max=500"`                                     // 文章描述/摘要
+	Image         string   `json:"image" validate:"omitempty,asset_url"`                                         // 文章封面图片，外部 URL 或媒体上传返回的地址
+	Status        string   `json:"status" validate:"omitempty,oneof=draft published private archived scheduled"` // 文章状态
+	CategoryID    string   `json:"category_id" validate:"omitempty"`                                             // 分类 ID
+	TagIDs        []string `json:"tag_ids" validate:"omitempty,max=20,dive,required"`                            // 标签 ID 列表，为空时不修改，传空数组时清空标签
+	Markdown      string   `json:"markdown" validate:"omitempty,max=100000"`                                     // Markdown内容
+	PublishAt     string   `json:"publish_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`           // 定时发布时间（RFC3339），仅在文章为 scheduled 状态时生效
+	Visibility    string   `json:"visibility" validate:"omitempty,oneof=public password unlisted"`               // 访问方式，为空时不修改
+	Password      string   `json:"password" validate:"omitempty,min=4,max=72"`                                   // 访问密码，切换为 password 时必填，已是 password 时传入则修改密码
+	Version       int64    `json:"version" validate:"omitempty,min=1"`                                           // 编辑所基于的文章版本号，与当前版本不一致时拒绝更新；为空时使用 If-Match 请求头，均未提供时不校验
+	Locale        string   `json:"locale" validate:"omitempty,max=16"`                                           // 语言，为空时不修改，翻译组内不能已有同语言的其他文章
+	TranslationOf string   `json:"translation_of" validate:"omitempty"`                                          // 原文文章 ID，为空时不修改，不为空时改为加入该文章的翻译组，传 0 时脱离原翻译组
+}
+
+// UnlockPostRequest 解锁密码保护文章请求
+type UnlockPostRequest struct {
+	ID       string `json:"id" validate:"required"`              // 文章 ID
+	Password string `json:"password" validate:"required,max=72"` // 访问密码
 }
 
 // ListPublishedPostsRequest 获取文章列表请求
 type ListPublishedPostsRequest struct {
-	PageNo     int64  `query:"page_no" validate:"required,min=1"`           // 页码
-	PageSize   int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
-	CategoryID *int64 `query:"category_id" validate:"omitempty"`            // 分类ID，为空时不按分类筛选
+	PageNo             int64  `query:"page_no" validate:"omitempty,min=1"`                       // 页码，为空时使用游标分页
+	PageSize           int64  `query:"page_size" validate:"required,min=1,max=100"`              // 每页数量
+	Cursor             string `query:"cursor" validate:"omitempty,excluded_with=PageNo,max=512"` // 分页游标，取自上次响应的 next_cursor 或 prev_cursor，为空时获取第一页
+	WithTotal          bool   `query:"with_total"`                                               // 游标分页时是否统计总数，默认不统计
+	CategoryID         *int64 `query:"category_id" validate:"omitempty"`                         // 分类ID，为空时不按分类筛选
+	IncludeDescendants bool   `query:"include_descendants"`                                      // 按分类筛选时是否包含所有子孙分类下的文章
+	TagID              *int64 `query:"tag_id" validate:"omitempty"`                              // 标签ID，为空时不按标签筛选
+	Lang               string `query:"lang" validate:"omitempty,max=16"`                         // 语言，不为空时每篇文章只返回一个语言版本，依次回退到其基础语言与默认语言，均无对应版本的文章不返回
 }
 
 // ListPostsByStatusRequest 根据状态获取文章列表请求
 type ListPostsByStatusRequest struct {
-	PageNo     int64  `query:"page_no" validate:"required,min=1"`                                  // 页码
-	PageSize   int64  `query:"page_size" validate:"required,min=1,max=100"`                        // 每页数量
-	Status     string `query:"status" validate:"omitempty,oneof=draft published private archived"` // 文章状态，为空时获取所有文章
-	CategoryID *int64 `query:"category_id" validate:"omitempty"`                                   // 分类ID，为空时不按分类筛选，有值时必须大于0
+	PageNo             int64  `query:"page_no" validate:"omitempty,min=1"`                                           // 页码，为空时使用游标分页
+	PageSize           int64  `query:"page_size" validate:"required,min=1,max=100"`                                  // 每页数量
+	Cursor             string `query:"cursor" validate:"omitempty,excluded_with=PageNo,max=512"`                     // 分页游标，取自上次响应的 next_cursor 或 prev_cursor，为空时获取第一页
+	WithTotal          bool   `query:"with_total"`                                                                   // 游标分页时是否统计总数，默认不统计
+	Status             string `query:"status" validate:"omitempty,oneof=draft published private archived scheduled"` // 文章状态，为空时获取所有文章
+	CategoryID         *int64 `query:"category_id" validate:"omitempty"`                                             // 分类ID，为空时不按分类筛选，有值时必须大于0
+	IncludeDescendants bool   `query:"include_descendants"`                                                          // 按分类筛选时是否包含所有子孙分类下的文章
+	Lang               string `query:"lang" validate:"omitempty,max=16"`                                             // 语言，不为空时只返回该语言的文章，不回退
+}
+
+// SearchPostsRequest 全文检索文章请求
+type SearchPostsRequest struct {
+	Q        string `query:"q" validate:"required,min=1,max=100"`         // 检索关键词
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
+
+// ListPostsByAuthorRequest 获取指定作者文章列表请求
+type ListPostsByAuthorRequest struct {
+	AuthorID string `query:"author_id" validate:"required"`               // 作者用户 ID
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
+
+// ListRelatedPostsRequest 获取相关文章请求
+type ListRelatedPostsRequest struct {
+	ID    string `query:"id" validate:"required"`                  // 文章 ID
+	Limit int64  `query:"limit" validate:"omitempty,min=1,max=20"` // 返回数量，默认 5
 }
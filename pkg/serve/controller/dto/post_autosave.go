@@ -0,0 +1,26 @@
+// Package dto 提供文章自动保存相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// AutosavePostRequest 自动保存草稿请求，每个用户每篇文章保留一份草稿，新文章共用一份
+type AutosavePostRequest struct {
+	PostID      string   `json:"post_id" validate:"omitempty"`                      // 文章 ID，为空表示尚未创建的新文章
+	BaseVersion int64    `json:"base_version" validate:"omitempty,min=1"`           // 草稿所基于的文章版本号，新文章为空
+	Title       string   `json:"title" validate:"omitempty,max=255"`                // 文章标题
+	Description string   `json:"description" validate:"omitempty,max=500"`          // 文章描述/摘要
+	Image       string   `json:"image" validate:"omitempty,max=255"`                // 文章封面图片
+	CategoryID  string   `json:"category_id" validate:"omitempty"`                  // 分类 ID
+	TagIDs      []string `json:"tag_ids" validate:"omitempty,max=20,dive,required"` // 标签 ID 列表
+	Markdown    string   `json:"markdown" validate:"omitempty,max=100000"`          // Markdown 内容
+}
+
+// GetPostAutosaveRequest 获取自动保存草稿请求
+type GetPostAutosaveRequest struct {
+	PostID string `query:"post_id" validate:"omitempty"` // 文章 ID，为空表示新文章的草稿
+}
+
+// DiscardPostAutosaveRequest 丢弃自动保存草稿请求
+type DiscardPostAutosaveRequest struct {
+	PostID string `json:"post_id" validate:"omitempty"` // 文章 ID，为空表示新文章的草稿
+}
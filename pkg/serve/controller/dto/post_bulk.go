@@ -0,0 +1,14 @@
+// Package dto 提供文章批量操作相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// BulkPostsRequest 批量操作文章请求
+type BulkPostsRequest struct {
+	IDs          []string `json:"ids" validate:"required,min=1,max=500,dive,required"`                                             // 文章 ID 列表
+	Operation    string   `json:"operation" validate:"required,oneof=status category delete restore rerender"`                     // 操作类型：status 修改状态、category 移动分类、delete 删除、restore 恢复已删除文章、rerender 重新渲染 Markdown
+	Status       string   `json:"status" validate:"required_if=Operation status,omitempty,oneof=draft published private archived"` // 目标状态，operation 为 status 时必填，不支持批量设置定时发布
+	CategoryID   string   `json:"category_id" validate:"required_if=Operation category"`                                           // 目标分类 ID，operation 为 category 时必填
+	AllOrNothing bool     `json:"all_or_nothing"`                                                                                  // 是否全部成功才提交，任一文章失败时整体回滚
+	DryRun       bool     `json:"dry_run"`                                                                                         // 是否仅校验并返回每篇文章的预期结果，不做任何修改
+}
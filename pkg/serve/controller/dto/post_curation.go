@@ -0,0 +1,31 @@
+// Package dto 提供文章置顶与精选相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// PinPostRequest 设置文章置顶请求
+type PinPostRequest struct {
+	ID          string `json:"id" validate:"required"`                                               // 文章 ID
+	Pinned      bool   `json:"pinned"`                                                               // 是否置顶，false 表示取消置顶
+	PinnedUntil string `json:"pinned_until" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"` // 置顶到期时间（RFC3339），为空表示永久置顶，须晚于当前时间
+	Weight      int64  `json:"weight" validate:"omitempty,min=0"`                                    // 置顶排序权重，数字越大越靠前
+}
+
+// FeaturePostRequest 设置文章精选请求
+type FeaturePostRequest struct {
+	ID       string `json:"id" validate:"required"`            // 文章 ID
+	Featured bool   `json:"featured"`                          // 是否精选，false 表示取消精选
+	Weight   int64  `json:"weight" validate:"omitempty,min=0"` // 精选排序权重，数字越大越靠前
+}
+
+// ReorderPostsRequest 批量调整置顶或精选文章顺序请求
+type ReorderPostsRequest struct {
+	Type    string   `json:"type" validate:"required,oneof=pinned featured"`           // 排序列表类型：pinned 置顶，featured 精选
+	PostIDs []string `json:"post_ids" validate:"required,min=1,max=100,dive,required"` // 文章 ID 列表，按期望顺序排列，排在前面的权重更高
+}
+
+// ListFeaturedPostsRequest 获取精选文章列表请求
+type ListFeaturedPostsRequest struct {
+	PageNo   int64 `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64 `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
@@ -0,0 +1,26 @@
+// Package dto 提供文章预览链接相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// CreatePostPreviewRequest 创建文章预览链接请求
+type CreatePostPreviewRequest struct {
+	PostID    string `json:"post_id" validate:"required"`                        // 文章 ID
+	ExpiresIn int64  `json:"expires_in" validate:"omitempty,min=60,max=2592000"` // 有效期（秒），为空时默认 72 小时，最长 30 天
+}
+
+// ListPostPreviewsRequest 获取文章预览链接列表请求
+type ListPostPreviewsRequest struct {
+	PostID string `query:"post_id" validate:"required"` // 文章 ID
+}
+
+// RevokePostPreviewRequest 撤销文章预览链接请求
+type RevokePostPreviewRequest struct {
+	PostID  string `json:"post_id" validate:"required"`  // 文章 ID
+	TokenID string `json:"token_id" validate:"required"` // 预览令牌 ID
+}
+
+// PreviewPostRequest 通过预览链接获取文章请求
+type PreviewPostRequest struct {
+	Token string `query:"token" validate:"required,max=256"` // 预览令牌
+}
@@ -0,0 +1,18 @@
+// Package dto 提供文章互动表态相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// ReactPostRequest 文章表态请求，用于添加与取消表态
+type ReactPostRequest struct {
+	PostID      string `json:"post_id" validate:"required"`              // 文章 ID
+	Type        string `json:"type" validate:"required,max=32"`          // 表态类型，须为配置中的类型
+	Fingerprint string `json:"fingerprint" validate:"omitempty,max=128"` // 匿名访客的浏览器指纹，与 IP 共同用于去重，未提供时使用 User-Agent
+}
+
+// ListReactedPostsRequest 获取当前用户表态过的文章列表请求
+type ListReactedPostsRequest struct {
+	Type     string `query:"type" validate:"omitempty,max=32"`            // 表态类型，为空时包含所有类型
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
@@ -0,0 +1,24 @@
+// Package dto 提供文章修订历史相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// ListPostRevisionsRequest 获取文章修订列表请求
+type ListPostRevisionsRequest struct {
+	PostID   string `query:"post_id" validate:"required"`                 // 文章 ID
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
+
+// DiffPostRevisionsRequest 对比文章修订请求
+type DiffPostRevisionsRequest struct {
+	PostID string `query:"post_id" validate:"required"` // 文章 ID
+	From   string `query:"from" validate:"required"`    // 旧修订 ID
+	To     string `query:"to" validate:"required"`      // 新修订 ID
+}
+
+// RestorePostRevisionRequest 恢复文章修订请求
+type RestorePostRevisionRequest struct {
+	PostID     string `json:"post_id" validate:"required"`     // 文章 ID
+	RevisionID string `json:"revision_id" validate:"required"` // 要恢复的修订 ID
+}
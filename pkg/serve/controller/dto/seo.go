@@ -0,0 +1,9 @@
+// Package dto 提供搜索引擎优化相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// GetSitemapRequest 获取站点地图请求
+type GetSitemapRequest struct {
+	Page int64 `query:"page" validate:"omitempty,min=1"` // 子站点地图页码，为空时 URL 数量超过上限则返回站点地图索引
+}
@@ -0,0 +1,45 @@
+// Package dto 提供文章系列相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// CreateSeriesRequest 创建系列请求
+type CreateSeriesRequest struct {
+	Title       string   `json:"title" validate:"required,min=1,max=255"`             // 系列标题
+	Slug        string   `json:"slug" validate:"omitempty,slug"`                      // 系列 slug，为空时根据标题自动生成
+	Description string   `json:"description" validate:"omitempty,max=500"`            // 系列描述
+	Image       string   `json:"image" validate:"omitempty,asset_url"`                // 系列封面图片，外部 URL 或媒体上传返回的地址
+	PostIDs     []string `json:"post_ids" validate:"omitempty,max=200,dive,required"` // 系列文章 ID 列表，按阅读顺序排列
+}
+
+// UpdateSeriesRequest 更新系列请求
+type UpdateSeriesRequest struct {
+	ID          string `json:"id" validate:"required"`                   // 系列 ID
+	Title       string `json:"title" validate:"omitempty,min=1,max=255"` // 系列标题
+	Slug        string `json:"slug" validate:"omitempty,slug"`           // 系列 slug，为空时不修改
+	Description string `json:"description" validate:"omitempty,max=500"` // 系列描述
+	Image       string `json:"image" validate:"omitempty,asset_url"`     // 系列封面图片
+}
+
+// DeleteSeriesRequest 删除系列请求
+type DeleteSeriesRequest struct {
+	ID string `json:"id" validate:"required"` // 系列 ID
+}
+
+// ReorderSeriesRequest 设置系列文章及顺序请求
+type ReorderSeriesRequest struct {
+	ID      string   `json:"id" validate:"required"`                    // 系列 ID
+	PostIDs []string `json:"post_ids" validate:"max=200,dive,required"` // 系列文章 ID 列表，按阅读顺序排列，未列出的文章将移出系列
+}
+
+// GetSeriesRequest 获取系列请求
+type GetSeriesRequest struct {
+	ID   string `query:"id" validate:"required_without=Slug"` // 系列 ID
+	Slug string `query:"slug" validate:"omitempty,slug"`      // 系列 slug，ID 为空时按 slug 查询
+}
+
+// ListSeriesRequest 获取系列列表请求
+type ListSeriesRequest struct {
+	PageNo   int64 `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64 `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
@@ -0,0 +1,29 @@
+// Package dto 提供标签相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// CreateTagRequest 创建标签请求
+type CreateTagRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=64"`    // 标签名称
+	Description string `json:"description" validate:"omitempty,max=500"` // 标签描述
+}
+
+// UpdateTagRequest 更新标签请求
+type UpdateTagRequest struct {
+	ID          string  `json:"id" validate:"required"`                   // 标签 ID
+	Name        string  `json:"name" validate:"omitempty,min=1,max=64"`   // 标签名称，为空时保持原值
+	Description *string `json:"description" validate:"omitempty,max=500"` // 标签描述，未传时保持原值，传空字符串时清空
+}
+
+// DeleteTagRequest 删除标签请求
+type DeleteTagRequest struct {
+	ID string `json:"id" validate:"required"` // 标签 ID
+}
+
+// ListTagsRequest 获取标签列表请求
+type ListTagsRequest struct {
+	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+	Keyword  string `query:"keyword" validate:"omitempty,max=64"`         // 标签名称关键词，为空时获取所有标签
+}
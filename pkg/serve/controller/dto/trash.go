@@ -0,0 +1,30 @@
+// Package dto 提供回收站相关的数据传输对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package dto
+
+// ListTrashRequest 获取回收站列表请求，文章、分类与用户共用
+type ListTrashRequest struct {
+	PageNo   int64 `query:"page_no" validate:"required,min=1"`           // 页码
+	PageSize int64 `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
+}
+
+// RestorePostRequest 从回收站恢复文章请求
+type RestorePostRequest struct {
+	ID string `json:"id" validate:"required"` // 文章 ID
+}
+
+// RestoreCategoryRequest 从回收站恢复分类请求
+type RestoreCategoryRequest struct {
+	ID string `json:"id" validate:"required"` // 分类 ID
+}
+
+// DeleteUserRequest 删除用户请求
+type DeleteUserRequest struct {
+	ID string `json:"id" validate:"required"` // 用户 ID
+}
+
+// RestoreUserRequest 从回收站恢复用户请求
+type RestoreUserRequest struct {
+	ID string `json:"id" validate:"required"` // 用户 ID
+}
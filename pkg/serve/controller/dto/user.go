@@ -25,7 +25,7 @@ type RefreshTokenRequest struct {
 // UpdateRequest 更新用户信息请求
 type UpdateRequest struct {
 	Nickname string `json:"nickname" validate:"omitempty,min=2,max=20"` // 昵称
-	Avatar   string `json:"avatar" validate:"omitempty,url"`            // 头像
+	Avatar   string `json:"avatar" validate:"omitempty,asset_url"`      // 头像，外部 URL 或媒体上传返回的地址
 }
 
 // ResetPasswordRequest 重置密码请求
@@ -37,10 +37,12 @@ type ResetPasswordRequest struct {
 
 // ListUsersRequest 获取用户列表请求
 type ListUsersRequest struct {
-	PageNo   int64  `query:"page_no" validate:"required,min=1"`           // 页码
-	PageSize int64  `query:"page_size" validate:"required,min=1,max=100"` // 每页数量
-	Keyword  string `query:"keyword" validate:"omitempty"`                // 搜索关键词（邮箱、昵称）
-	Role     string `query:"role" validate:"omitempty"`                   // 角色筛选
+	PageNo    int64  `query:"page_no" validate:"omitempty,min=1"`                       // 页码，为空时使用游标分页
+	PageSize  int64  `query:"page_size" validate:"required,min=1,max=100"`              // 每页数量
+	Cursor    string `query:"cursor" validate:"omitempty,excluded_with=PageNo,max=512"` // 分页游标，取自上次响应的 next_cursor 或 prev_cursor，为空时获取第一页
+	WithTotal bool   `query:"with_total"`                                               // 游标分页时是否统计总数，默认不统计
+	Keyword   string `query:"keyword" validate:"omitempty"`                             // 搜索关键词（邮箱、昵称）
+	Role      string `query:"role" validate:"omitempty"`                                // 角色筛选
 }
 
 // UpdateUserRoleRequest 管理员更新用户角色请求
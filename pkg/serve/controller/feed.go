@@ -0,0 +1,167 @@
+// Package controller 订阅源控制器
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	constants "github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// FeedController 订阅源控制器
+type FeedController struct {
+	feedService service.FeedService
+}
+
+// NewFeedController 创建订阅源控制器
+func NewFeedController(feedService service.FeedService) *FeedController {
+	return &FeedController{
+		feedService: feedService,
+	}
+}
+
+// RSS 获取 RSS 2.0 订阅源
+// @Router /feed.xml [get]
+func (fc *FeedController) RSS(ctx context.Context, c *app.RequestContext) {
+	req, ok := bindFeedRequest(c)
+	if !ok {
+		return
+	}
+
+	feed, lastModified, err := fc.feedService.GetRSSFeed(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostFeedFailed, errorx.KV("msg", "build rss feed failed"))))
+		return
+	}
+
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostFeedFailed, errorx.KV("msg", "marshal rss feed failed"))))
+		return
+	}
+
+	writeFeed(c, constants.FeedRSSContentType, append([]byte(xml.Header), body...), lastModified)
+}
+
+// Atom 获取 Atom 订阅源
+// @Router /atom.xml [get]
+func (fc *FeedController) Atom(ctx context.Context, c *app.RequestContext) {
+	req, ok := bindFeedRequest(c)
+	if !ok {
+		return
+	}
+
+	feed, lastModified, err := fc.feedService.GetAtomFeed(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostFeedFailed, errorx.KV("msg", "build atom feed failed"))))
+		return
+	}
+
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostFeedFailed, errorx.KV("msg", "marshal atom feed failed"))))
+		return
+	}
+
+	writeFeed(c, constants.FeedAtomContentType, append([]byte(xml.Header), body...), lastModified)
+}
+
+// JSON 获取 JSON Feed 订阅源
+// @Router /feed.json [get]
+func (fc *FeedController) JSON(ctx context.Context, c *app.RequestContext) {
+	req, ok := bindFeedRequest(c)
+	if !ok {
+		return
+	}
+
+	feed, lastModified, err := fc.feedService.GetJSONFeed(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostFeedFailed, errorx.KV("msg", "build json feed failed"))))
+		return
+	}
+
+	body, err := json.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostFeedFailed, errorx.KV("msg", "marshal json feed failed"))))
+		return
+	}
+
+	writeFeed(c, constants.FeedJSONContentType, body, lastModified)
+}
+
+// bindFeedRequest 绑定并校验订阅源请求参数，失败时直接写入响应
+func bindFeedRequest(c *app.RequestContext) (*dto.GetFeedRequest, bool) {
+	req := new(dto.GetFeedRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return nil, false
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return nil, false
+	}
+
+	return req, true
+}
+
+// writeFeed 写入订阅源响应，支持 ETag / If-None-Match 与 Last-Modified / If-Modified-Since 条件请求
+func writeFeed(c *app.RequestContext, contentType string, body []byte, lastModified time.Time) {
+	sum := sha256.Sum256(body)
+	etag := fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:16]))
+
+	c.Header(constants.HeaderETag, etag)
+	c.Header(constants.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", constants.FeedCacheMaxAge))
+	if !lastModified.IsZero() {
+		c.Header(constants.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(c, etag, lastModified) {
+		c.Status(consts.StatusNotModified)
+		return
+	}
+
+	c.Data(consts.StatusOK, contentType, body)
+}
+
+// notModified 判断条件请求是否命中缓存，If-None-Match 优先于 If-Modified-Since
+func notModified(c *app.RequestContext, etag string, lastModified time.Time) bool {
+	if inm := string(c.GetHeader(constants.HeaderIfNoneMatch)); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	ims := string(c.GetHeader(constants.HeaderIfModifiedSince))
+	if ims == "" || lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(since)
+}
@@ -0,0 +1,112 @@
+// Package controller 媒体文件控制器
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// MediaController 媒体文件控制器
+type MediaController struct {
+	mediaService service.MediaService
+}
+
+// NewMediaController 创建媒体文件控制器
+func NewMediaController(mediaService service.MediaService) *MediaController {
+	return &MediaController{
+		mediaService: mediaService,
+	}
+}
+
+// Upload 上传媒体文件（multipart/form-data，文件字段名为 file）
+// @Router /api/v1/media/upload [post]
+func (mc *MediaController) Upload(ctx context.Context, c *app.RequestContext) {
+	response, err := mc.mediaService.Upload(c)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMediaTooLarge):
+			c.JSON(consts.StatusRequestEntityTooLarge, vo.Fail(c, err, errorx.New(errno.ErrMediaTooLarge, errorx.KV("msg", err.Error()))))
+		case errors.Is(err, service.ErrMediaTypeNotAllowed):
+			c.JSON(consts.StatusUnsupportedMediaType, vo.Fail(c, err, errorx.New(errno.ErrMediaTypeForbidden, errorx.KV("msg", err.Error()))))
+		default:
+			c.JSON(mediaErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrMediaUploadFailed, errorx.KV("msg", "upload media failed"))))
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListMedia 获取媒体文件列表
+// @Router /api/v1/media/list [get]
+func (mc *MediaController) ListMedia(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListMediaRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := mc.mediaService.ListMedia(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrMediaListFailed, errorx.KV("msg", "list media failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Delete 删除媒体文件
+// @Router /api/v1/media/delete [post]
+func (mc *MediaController) Delete(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DeleteMediaRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := mc.mediaService.Delete(c, req)
+	if err != nil {
+		c.JSON(mediaErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrMediaDeleteFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// mediaErrorStatus 根据媒体文件错误选择 HTTP 状态码
+func mediaErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrPermissionDenied):
+		return consts.StatusForbidden
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -5,12 +5,19 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 
+	constants "github.com/Done-0/jank/internal/types/consts"
 	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/cursor"
 	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/locale"
 	"github.com/Done-0/jank/internal/utils/validator"
 	"github.com/Done-0/jank/internal/utils/vo"
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
@@ -19,13 +26,15 @@ import (
 
 // PostController 文章控制器
 type PostController struct {
-	postService service.PostService
+	postService      service.PostService
+	analyticsService service.AnalyticsService
 }
 
 // NewPostController 创建文章控制器
-func NewPostController(postService service.PostService) *PostController {
+func NewPostController(postService service.PostService, analyticsService service.AnalyticsService) *PostController {
 	return &PostController{
-		postService: postService,
+		postService:      postService,
+		analyticsService: analyticsService,
 	}
 }
 
@@ -46,10 +55,32 @@ func (pc *PostController) GetPost(ctx context.Context, c *app.RequestContext) {
 
 	response, err := pc.postService.GetPost(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostGetFailed, errorx.KV("id", req.ID))))
+		id := req.ID
+		if id == "" {
+			id = req.Slug
+		}
+		if isInvalidLocale(err) {
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+			return
+		}
+		c.JSON(getPostErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostGetFailed, errorx.KV("id", id))))
 		return
 	}
 
+	// 通过曾用 slug 访问时永久重定向到当前 slug
+	// 按 lang 切换到其他语言版本时临时重定向到译文的 slug，原 slug 仍是原文的有效地址
+	if req.Slug != "" && response.Slug != req.Slug {
+		if req.Lang != "" {
+			c.Redirect(consts.StatusFound, []byte(string(c.Path())+"?slug="+url.QueryEscape(response.Slug)+"&lang="+url.QueryEscape(req.Lang)))
+			return
+		}
+		c.Redirect(consts.StatusMovedPermanently, []byte(string(c.Path())+"?slug="+url.QueryEscape(response.Slug)))
+		return
+	}
+
+	pc.analyticsService.RecordView(c, response)
+
+	c.Header(constants.HeaderETag, fmt.Sprintf(constants.PostETagFormat, response.Version))
 	c.JSON(consts.StatusOK, vo.Success(c, response))
 }
 
@@ -70,7 +101,7 @@ func (pc *PostController) ListPublishedPosts(ctx context.Context, c *app.Request
 
 	response, err := pc.postService.ListPublishedPosts(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostListFailed, errorx.KV("msg", "list posts failed"))))
+		c.JSON(listErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostListFailed, errorx.KV("msg", "list posts failed"))))
 		return
 	}
 
@@ -94,7 +125,79 @@ func (pc *PostController) ListPostsByStatus(ctx context.Context, c *app.RequestC
 
 	response, err := pc.postService.ListPostsByStatus(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostListFailed, errorx.KV("msg", "list posts by status failed"))))
+		c.JSON(listErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostListFailed, errorx.KV("msg", "list posts by status failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListPostsByAuthor 获取指定作者的文章列表
+// @Router /api/v1/post/list-by-author [get]
+func (pc *PostController) ListPostsByAuthor(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListPostsByAuthorRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListPostsByAuthor(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostListFailed, errorx.KV("msg", "list posts by author failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// SearchPosts 全文检索文章
+// @Router /api/v1/post/search [get]
+func (pc *PostController) SearchPosts(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.SearchPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.SearchPosts(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostSearchFailed, errorx.KV("msg", req.Q))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListRelatedPosts 获取相关文章
+// @Router /api/v1/post/related [get]
+func (pc *PostController) ListRelatedPosts(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListRelatedPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListRelatedPosts(c, req)
+	if err != nil {
+		c.JSON(getPostErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostRelatedListFailed, errorx.KV("id", req.ID))))
 		return
 	}
 
@@ -118,7 +221,11 @@ func (pc *PostController) Create(ctx context.Context, c *app.RequestContext) {
 
 	response, err := pc.postService.Create(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostCreateFailed, errorx.KV("title", req.Title))))
+		if isSlugConflict(err) {
+			c.JSON(consts.StatusConflict, vo.Fail(c, err, errorx.New(errno.ErrResourceConflict, errorx.KV("resource", "slug"), errorx.KV("id", req.Slug))))
+			return
+		}
+		c.JSON(postWriteErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostCreateFailed, errorx.KV("title", req.Title))))
 		return
 	}
 
@@ -142,10 +249,27 @@ func (pc *PostController) Update(ctx context.Context, c *app.RequestContext) {
 
 	response, err := pc.postService.Update(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostUpdateFailed, errorx.KV("id", req.ID))))
+		if conflict, ok := postVersionConflict(err); ok {
+			c.JSON(consts.StatusConflict, vo.Fail(c, conflict, errorx.New(errno.ErrPostVersionConflict, errorx.KV("id", req.ID))))
+			return
+		}
+		if isInvalidIfMatch(err) {
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+			return
+		}
+		if isSlugConflict(err) {
+			c.JSON(consts.StatusConflict, vo.Fail(c, err, errorx.New(errno.ErrResourceConflict, errorx.KV("resource", "slug"), errorx.KV("id", req.Slug))))
+			return
+		}
+		if strings.Contains(err.Error(), "password is required") {
+			c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", err.Error()))))
+			return
+		}
+		c.JSON(postWriteErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostUpdateFailed, errorx.KV("id", req.ID))))
 		return
 	}
 
+	c.Header(constants.HeaderETag, fmt.Sprintf(constants.PostETagFormat, response.Version))
 	c.JSON(consts.StatusOK, vo.Success(c, response))
 }
 
@@ -166,9 +290,707 @@ func (pc *PostController) Delete(ctx context.Context, c *app.RequestContext) {
 
 	response, err := pc.postService.Delete(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostDeleteFailed, errorx.KV("id", req.ID))))
+		c.JSON(postWriteErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostDeleteFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Bulk 批量操作文章
+// @Router /api/v1/post/bulk [post]
+func (pc *PostController) Bulk(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.BulkPostsRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Bulk(c, req)
+	if err != nil {
+		c.JSON(bulkErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostBulkFailed, errorx.KV("operation", req.Operation), errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListTrash 获取回收站中的文章
+// @Router /api/v1/post/list-trash [get]
+func (pc *PostController) ListTrash(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListTrashRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListTrash(c, req)
+	if err != nil {
+		c.JSON(trashErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostTrashListFailed, errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Restore 从回收站恢复文章
+// @Router /api/v1/post/restore [post]
+func (pc *PostController) Restore(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.RestorePostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Restore(c, req)
+	if err != nil {
+		c.JSON(trashErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostRestoreFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListRevisions 获取文章修订列表
+// @Router /api/v1/post/list-revisions [get]
+func (pc *PostController) ListRevisions(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListPostRevisionsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListRevisions(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostRevisionListFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// DiffRevisions 对比两个文章修订
+// @Router /api/v1/post/diff-revisions [get]
+func (pc *PostController) DiffRevisions(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DiffPostRevisionsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.DiffRevisions(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostRevisionDiffFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// RestoreRevision 将文章恢复为指定修订
+// @Router /api/v1/post/restore-revision [post]
+func (pc *PostController) RestoreRevision(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.RestorePostRevisionRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.RestoreRevision(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostRevisionRestoreFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// React 添加文章表态
+// @Router /api/v1/post/react [post]
+func (pc *PostController) React(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ReactPostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.React(c, req)
+	if err != nil {
+		c.JSON(reactionErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostReactFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Unreact 取消文章表态
+// @Router /api/v1/post/unreact [post]
+func (pc *PostController) Unreact(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ReactPostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Unreact(c, req)
+	if err != nil {
+		c.JSON(reactionErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostUnreactFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListReactedPosts 获取当前用户表态过的文章列表
+// @Router /api/v1/post/list-reacted [get]
+func (pc *PostController) ListReactedPosts(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListReactedPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListReactedPosts(c, req)
+	if err != nil {
+		c.JSON(reactionErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostReactedListFailed, errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListFeaturedPosts 获取精选文章列表
+// @Router /api/v1/post/list-featured [get]
+func (pc *PostController) ListFeaturedPosts(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListFeaturedPostsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListFeaturedPosts(c, req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostFeaturedListFailed, errorx.KV("msg", "list featured posts failed"))))
 		return
 	}
 
 	c.JSON(consts.StatusOK, vo.Success(c, response))
 }
+
+// Pin 设置或取消文章置顶
+// @Router /api/v1/post/pin [post]
+func (pc *PostController) Pin(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.PinPostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Pin(c, req)
+	if err != nil {
+		c.JSON(curationErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostPinFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Feature 设置或取消文章精选
+// @Router /api/v1/post/feature [post]
+func (pc *PostController) Feature(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.FeaturePostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Feature(c, req)
+	if err != nil {
+		c.JSON(curationErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostFeatureFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ReorderPosts 批量调整置顶或精选文章顺序
+// @Router /api/v1/post/reorder [post]
+func (pc *PostController) ReorderPosts(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ReorderPostsRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ReorderPosts(c, req)
+	if err != nil {
+		c.JSON(curationErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostReorderFailed, errorx.KV("type", req.Type))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Unlock 解锁密码保护文章
+// @Router /api/v1/post/unlock [post]
+func (pc *PostController) Unlock(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.UnlockPostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Unlock(c, req)
+	if err != nil {
+		c.JSON(unlockErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostUnlockFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// CreatePreview 创建文章预览链接
+// @Router /api/v1/post/create-preview [post]
+func (pc *PostController) CreatePreview(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.CreatePostPreviewRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.CreatePreview(c, req)
+	if err != nil {
+		c.JSON(previewErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostPreviewCreateFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListPreviews 获取文章预览链接列表
+// @Router /api/v1/post/list-previews [get]
+func (pc *PostController) ListPreviews(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListPostPreviewsRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.ListPreviews(c, req)
+	if err != nil {
+		c.JSON(previewErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostPreviewListFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// RevokePreview 撤销文章预览链接
+// @Router /api/v1/post/revoke-preview [post]
+func (pc *PostController) RevokePreview(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.RevokePostPreviewRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.RevokePreview(c, req)
+	if err != nil {
+		c.JSON(previewErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostPreviewRevokeFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Preview 通过预览链接获取文章
+// @Router /api/v1/post/preview [get]
+func (pc *PostController) Preview(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.PreviewPostRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Preview(c, req)
+	if err != nil {
+		c.JSON(previewErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostPreviewFailed, errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	// 预览内容不应被搜索引擎收录或被共享缓存保存
+	c.Header(constants.HeaderXRobotsTag, "noindex, nofollow")
+	c.Header(constants.HeaderCacheControl, "private, no-store")
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Autosave 自动保存文章草稿
+// @Router /api/v1/post/autosave [post]
+func (pc *PostController) Autosave(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.AutosavePostRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.Autosave(c, req)
+	if err != nil {
+		c.JSON(autosaveErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostAutosaveFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// GetAutosave 获取自动保存的文章草稿
+// @Router /api/v1/post/get-autosave [get]
+func (pc *PostController) GetAutosave(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetPostAutosaveRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.GetAutosave(c, req)
+	if err != nil {
+		c.JSON(autosaveErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostAutosaveGetFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// DiscardAutosave 丢弃自动保存的文章草稿
+// @Router /api/v1/post/discard-autosave [post]
+func (pc *PostController) DiscardAutosave(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DiscardPostAutosaveRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := pc.postService.DiscardAutosave(c, req)
+	if err != nil {
+		c.JSON(autosaveErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrPostAutosaveDiscardFailed, errorx.KV("id", req.PostID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// getPostErrorStatus 根据获取文章错误选择 HTTP 状态码，文章不存在或无权阅读未公开的文章时返回 404
+func getPostErrorStatus(err error) int {
+	if errors.Is(err, service.ErrPostNotFound) {
+		return consts.StatusNotFound
+	}
+	return consts.StatusInternalServerError
+}
+
+// listErrorStatus 根据列表查询错误选择 HTTP 状态码，非法分页游标或语言返回 400
+func listErrorStatus(err error) int {
+	if errors.Is(err, cursor.ErrInvalidCursor) || isInvalidLocale(err) {
+		return consts.StatusBadRequest
+	}
+	return consts.StatusInternalServerError
+}
+
+// isInvalidLocale 判断错误是否因为语言标签格式不合法或站点不支持该语言
+func isInvalidLocale(err error) bool {
+	return errors.Is(err, locale.ErrInvalidLocale) || errors.Is(err, locale.ErrUnsupportedLocale)
+}
+
+// postWriteErrorStatus 根据创建、更新或删除文章的错误选择 HTTP 状态码
+func postWriteErrorStatus(err error) int {
+	switch {
+	case isInvalidLocale(err),
+		errors.Is(err, service.ErrInvalidID),
+		errors.Is(err, service.ErrInvalidTranslationSource):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrPermissionDenied):
+		return consts.StatusForbidden
+	case errors.Is(err, service.ErrPostNotFound),
+		errors.Is(err, service.ErrTranslationSourceNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrTranslationExists):
+		return consts.StatusConflict
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// bulkErrorStatus 根据批量操作错误选择 HTTP 状态码，单篇文章的失败记录在结果中，不影响状态码
+func bulkErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrCategoryNotFound):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// trashErrorStatus 根据回收站错误选择 HTTP 状态码，文章、分类与用户回收站共用
+func trashErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID),
+		errors.Is(err, service.ErrCannotDeleteSelf):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrPermissionDenied):
+		return consts.StatusForbidden
+	case errors.Is(err, service.ErrPostNotFound),
+		errors.Is(err, service.ErrCategoryNotFound),
+		errors.Is(err, service.ErrUserNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrAlreadyInUse):
+		return consts.StatusConflict
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// postVersionConflict 从文章编辑冲突错误中取出冲突详情，作为响应数据返回服务端当前的文章副本
+func postVersionConflict(err error) (any, bool) {
+	var conflictErr *service.PostVersionConflictError
+	if !errors.As(err, &conflictErr) {
+		return nil, false
+	}
+	return conflictErr.Conflict, true
+}
+
+// isSlugConflict 判断创建或更新失败是否因为显式指定的 slug 已被占用，文章与分类共用
+func isSlugConflict(err error) bool {
+	return errors.Is(err, service.ErrSlugConflict)
+}
+
+// isInvalidIfMatch 判断更新文章失败是否因为 If-Match 请求头格式错误
+func isInvalidIfMatch(err error) bool {
+	return errors.Is(err, service.ErrInvalidIfMatch)
+}
+
+// autosaveErrorStatus 根据草稿自动保存错误选择 HTTP 状态码
+func autosaveErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrPermissionDenied):
+		return consts.StatusForbidden
+	case errors.Is(err, service.ErrPostNotFound),
+		errors.Is(err, service.ErrAutosaveNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrAutosaveUnavailable):
+		return consts.StatusServiceUnavailable
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// reactionErrorStatus 根据表态错误选择 HTTP 状态码
+func reactionErrorStatus(err error) int {
+	switch {
+	case strings.Contains(err.Error(), "unsupported reaction type"),
+		strings.Contains(err.Error(), "not open for reactions"),
+		errors.Is(err, service.ErrInvalidID):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrPostNotFound):
+		return consts.StatusNotFound
+	case strings.Contains(err.Error(), "anonymous reactions are unavailable"):
+		return consts.StatusServiceUnavailable
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// curationErrorStatus 根据置顶与精选错误选择 HTTP 状态码
+func curationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID),
+		strings.Contains(err.Error(), "invalid pinned_until format"),
+		strings.Contains(err.Error(), "pinned_until must be in the future"),
+		strings.Contains(err.Error(), "duplicate post ID"),
+		strings.Contains(err.Error(), "is not pinned"),
+		strings.Contains(err.Error(), "is not featured"):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrPermissionDenied):
+		return consts.StatusForbidden
+	case errors.Is(err, service.ErrPostNotFound):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// unlockErrorStatus 根据文章解锁错误选择 HTTP 状态码
+func unlockErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID),
+		strings.Contains(err.Error(), "not password protected"):
+		return consts.StatusBadRequest
+	case strings.Contains(err.Error(), "incorrect post password"):
+		return consts.StatusForbidden
+	case errors.Is(err, service.ErrTooManyAttempts):
+		return consts.StatusTooManyRequests
+	case errors.Is(err, service.ErrPostNotFound):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// previewErrorStatus 根据预览链接错误选择 HTTP 状态码
+func previewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID),
+		strings.Contains(err.Error(), "too many preview links"):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrPermissionDenied):
+		return consts.StatusForbidden
+	case strings.Contains(err.Error(), "invalid preview token"),
+		strings.Contains(err.Error(), "preview link not found"),
+		errors.Is(err, service.ErrPostNotFound):
+		return consts.StatusNotFound
+	case strings.Contains(err.Error(), "preview links are unavailable"):
+		return consts.StatusServiceUnavailable
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -0,0 +1,77 @@
+// Package controller 搜索引擎优化控制器
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	constants "github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// SEOController 搜索引擎优化控制器
+type SEOController struct {
+	seoService service.SEOService
+}
+
+// NewSEOController 创建搜索引擎优化控制器
+func NewSEOController(seoService service.SEOService) *SEOController {
+	return &SEOController{
+		seoService: seoService,
+	}
+}
+
+// Sitemap 获取站点地图
+// @Router /sitemap.xml [get]
+func (sc *SEOController) Sitemap(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetSitemapRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	body, err := sc.seoService.GetSitemap(c, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "out of range") {
+			c.JSON(consts.StatusNotFound, vo.Fail(c, err, errorx.New(errno.ErrResourceNotFound, errorx.KV("resource", "sitemap"), errorx.KV("id", strconv.FormatInt(req.Page, 10)))))
+			return
+		}
+
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrPostSitemapFailed, errorx.KV("msg", "build sitemap failed"))))
+		return
+	}
+
+	c.Header(constants.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", constants.FeedCacheMaxAge))
+	c.Data(consts.StatusOK, constants.SitemapContentType, body)
+}
+
+// Robots 获取 robots.txt
+// @Router /robots.txt [get]
+func (sc *SEOController) Robots(ctx context.Context, c *app.RequestContext) {
+	body, err := sc.seoService.GetRobots(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrInternalServer, errorx.KV("msg", "build robots.txt failed"))))
+		return
+	}
+
+	c.Header(constants.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", constants.FeedCacheMaxAge))
+	c.Data(consts.StatusOK, constants.RobotsContentType, body)
+}
@@ -0,0 +1,195 @@
+// Package controller 文章系列控制器
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/Done-0/jank/internal/types/errno"
+	"github.com/Done-0/jank/internal/utils/errorx"
+	"github.com/Done-0/jank/internal/utils/validator"
+	"github.com/Done-0/jank/internal/utils/vo"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// SeriesController 文章系列控制器
+type SeriesController struct {
+	seriesService service.SeriesService
+}
+
+// NewSeriesController 创建文章系列控制器
+func NewSeriesController(seriesService service.SeriesService) *SeriesController {
+	return &SeriesController{
+		seriesService: seriesService,
+	}
+}
+
+// GetSeries 获取系列及其文章
+// @Router /api/v1/series/get [get]
+func (sc *SeriesController) GetSeries(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.GetSeriesRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.GetSeries(c, req)
+	if err != nil {
+		c.JSON(seriesErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrSeriesGetFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListSeries 获取系列列表
+// @Router /api/v1/series/list [get]
+func (sc *SeriesController) ListSeries(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListSeriesRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.ListSeries(c, req)
+	if err != nil {
+		c.JSON(seriesErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrSeriesListFailed, errorx.KV("msg", "list series failed"))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Create 创建系列
+// @Router /api/v1/series/create [post]
+func (sc *SeriesController) Create(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.CreateSeriesRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.Create(c, req)
+	if err != nil {
+		c.JSON(seriesErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrSeriesCreateFailed, errorx.KV("title", req.Title))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Update 更新系列
+// @Router /api/v1/series/update [post]
+func (sc *SeriesController) Update(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.UpdateSeriesRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.Update(c, req)
+	if err != nil {
+		c.JSON(seriesErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrSeriesUpdateFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Delete 删除系列
+// @Router /api/v1/series/delete [post]
+func (sc *SeriesController) Delete(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DeleteSeriesRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.Delete(c, req)
+	if err != nil {
+		c.JSON(seriesErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrSeriesDeleteFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Reorder 设置系列文章及顺序，未列出的文章将移出系列
+// @Router /api/v1/series/reorder [post]
+func (sc *SeriesController) Reorder(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ReorderSeriesRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := sc.seriesService.Reorder(c, req)
+	if err != nil {
+		c.JSON(seriesErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrSeriesReorderFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// seriesErrorStatus 根据系列错误选择 HTTP 状态码
+func seriesErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidID),
+		strings.Contains(err.Error(), "duplicate post ID"),
+		strings.Contains(err.Error(), "already belongs to another series"),
+		errors.Is(err, service.ErrSlugConflict):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAuthenticationRequired):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrPermissionDenied):
+		return consts.StatusForbidden
+	case strings.Contains(err.Error(), "not found"):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -190,7 +190,7 @@ func (uc *UserController) ListUsers(ctx context.Context, c *app.RequestContext)
 
 	response, err := uc.userService.ListUsers(c, req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, vo.Fail(c, err, errorx.New(errno.ErrUserListFailed, errorx.KV("msg", "list users failed"))))
+		c.JSON(listErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrUserListFailed, errorx.KV("msg", "list users failed"))))
 		return
 	}
 
@@ -220,3 +220,75 @@ func (uc *UserController) UpdateUserRole(ctx context.Context, c *app.RequestCont
 
 	c.JSON(consts.StatusOK, vo.Success(c, response))
 }
+
+// Delete 管理员将用户移入回收站
+// @Router /api/v1/user/delete [post]
+func (uc *UserController) Delete(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.DeleteUserRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := uc.userService.Delete(c, req)
+	if err != nil {
+		c.JSON(trashErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrUserDeleteFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// ListTrash 管理员获取回收站中的用户
+// @Router /api/v1/user/list-trash [get]
+func (uc *UserController) ListTrash(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.ListTrashRequest)
+	if err := c.BindQuery(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind query failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := uc.userService.ListTrash(c, req)
+	if err != nil {
+		c.JSON(trashErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrUserTrashListFailed, errorx.KV("msg", err.Error()))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
+
+// Restore 管理员从回收站恢复用户
+// @Router /api/v1/user/restore [post]
+func (uc *UserController) Restore(ctx context.Context, c *app.RequestContext) {
+	req := new(dto.RestoreUserRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, err, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "bind JSON failed"))))
+		return
+	}
+
+	errors := validator.Validate(req)
+	if errors != nil {
+		c.JSON(consts.StatusBadRequest, vo.Fail(c, errors, errorx.New(errno.ErrInvalidParams, errorx.KV("msg", "validation failed"))))
+		return
+	}
+
+	response, err := uc.userService.Restore(c, req)
+	if err != nil {
+		c.JSON(trashErrorStatus(err), vo.Fail(c, err, errorx.New(errno.ErrUserRestoreFailed, errorx.KV("id", req.ID))))
+		return
+	}
+
+	c.JSON(consts.StatusOK, vo.Success(c, response))
+}
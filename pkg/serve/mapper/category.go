@@ -7,13 +7,27 @@ import (
 	"github.com/cloudwego/hertz/pkg/app"
 
 	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/utils/cursor"
 )
 
 // CategoryMapper 分类数据访问接口
 type CategoryMapper interface {
-	GetCategoryByID(c *app.RequestContext, categoryID int64) (*category.Category, error)                                                   // 根据 ID 获取分类
-	ListCategories(c *app.RequestContext, pageNo, pageSize int64, parentID *int64, isActive *bool) ([]*category.Category, int64, error) // 获取分类列表，支持按父分类和状态筛选
-	CreateCategory(c *app.RequestContext, category *category.Category) error                                                             // 创建分类
-	UpdateCategory(c *app.RequestContext, category *category.Category) error                                                             // 更新分类
-	DeleteCategory(c *app.RequestContext, categoryID int64) error                                                                        // 删除分类
+	GetCategoryByID(c *app.RequestContext, categoryID int64) (*category.Category, error)                                                                                                                 // 根据 ID 获取分类
+	GetCategoryBySlug(c *app.RequestContext, slug string) (*category.Category, error)                                                                                                                    // 根据 slug 获取分类
+	IsCategorySlugTaken(c *app.RequestContext, slug string, excludeID int64) (bool, error)                                                                                                               // 判断 slug 是否已被其他分类占用（含已删除分类）
+	ListCategories(c *app.RequestContext, pageNo, pageSize int64, parentID *int64, isActive *bool, locales []string) ([]*category.Category, int64, error)                                                // 获取分类列表，支持按父分类和状态筛选，locales 不为空时每个翻译组只返回最优先语言的版本
+	ListCategoriesWithCursor(c *app.RequestContext, cur *cursor.Cursor, limit int64, withTotal bool, parentID *int64, isActive *bool, locales []string) (*cursor.Page[*category.Category], int64, error) // 按游标获取分类列表，排序同上，withTotal 为 false 时不统计总数
+	ListActiveCategories(c *app.RequestContext) ([]*category.Category, error)                                                                                                                            // 获取全部启用的分类
+	ListCategoryTranslations(c *app.RequestContext, groupID int64) ([]*category.Category, error)                                                                                                         // 获取翻译组内的全部分类，按 ID 正序
+	CreateCategory(c *app.RequestContext, category *category.Category) error                                                                                                                             // 创建分类
+	UpdateCategory(c *app.RequestContext, category *category.Category) error                                                                                                                             // 更新分类
+	DeleteCategory(c *app.RequestContext, categoryID int64) error                                                                                                                                        // 将分类移入回收站并释放 slug，文章保留对该分类的引用
+	GetDeletedCategoryByID(c *app.RequestContext, categoryID int64) (*category.Category, error)                                                                                                          // 根据 ID 获取回收站中的分类
+	ListDeletedCategories(c *app.RequestContext, pageNo, pageSize int64) ([]*category.Category, int64, error)                                                                                            // 获取回收站中的分类，按删除时间倒序
+	RestoreCategory(c *app.RequestContext, category *category.Category, slug string, parentID int64) error                                                                                               // 从回收站恢复分类，slug 为空时保持原值
+	ListAllCategories(c *app.RequestContext) ([]*category.Category, error)                                                                                                                               // 获取全部未删除的分类（含未启用）
+	LockAllCategories(c *app.RequestContext) error                                                                                                                                                       // 在当前事务中锁定全部未删除的分类行，SQLite 无行锁时跳过
+	CountChildCategories(c *app.RequestContext, parentID int64) (int64, error)                                                                                                                           // 统计直接子分类数量
+	CountPublishedPostsByCategoryIDs(c *app.RequestContext, categoryIDs []int64) (map[int64]int64, error)                                                                                                // 批量统计各分类下已发布文章数量（不含子分类）
+	MergeCategory(c *app.RequestContext, sourceID, targetID int64) error                                                                                                                                 // 将源分类的文章与子分类并入目标分类，并删除源分类
 }
@@ -0,0 +1,20 @@
+// Package mapper 提供评论相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/comment"
+)
+
+// CommentMapper 评论数据访问接口
+type CommentMapper interface {
+	GetCommentByID(c *app.RequestContext, commentID int64) (*comment.Comment, error)                                                     // 根据 ID 获取评论
+	ListApprovedComments(c *app.RequestContext, pageNo, pageSize, postID int64) ([]*comment.Comment, int64, error)                       // 获取文章下已通过审核的评论，按时间正序
+	ListCommentsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, postID *int64) ([]*comment.Comment, int64, error) // 根据状态获取评论列表，status为空时获取所有评论，postID为空时不按文章筛选
+	CreateComment(c *app.RequestContext, comment *comment.Comment) error                                                                 // 创建评论
+	UpdateCommentStatus(c *app.RequestContext, commentID int64, status string) error                                                     // 更新评论审核状态
+	DeleteComment(c *app.RequestContext, commentID int64) error                                                                          // 删除评论
+}
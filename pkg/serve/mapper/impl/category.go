@@ -8,9 +8,13 @@ import (
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/Done-0/jank/internal/model/base"
 	"github.com/Done-0/jank/internal/model/category"
 	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/cursor"
 	"github.com/Done-0/jank/internal/utils/db"
 	"github.com/Done-0/jank/pkg/serve/mapper"
 )
@@ -26,20 +30,85 @@ func NewCategoryMapper() mapper.CategoryMapper {
 // GetCategoryByID 根据ID获取分类
 func (m *CategoryMapperImpl) GetCategoryByID(c *app.RequestContext, categoryID int64) (*category.Category, error) {
 	var cat category.Category
-	err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", categoryID, false).First(&cat).Error
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", categoryID).First(&cat).Error
 	if err != nil {
 		return nil, err
 	}
 	return &cat, nil
 }
 
-// ListCategories 获取分类列表，支持按父分类和状态筛选
-func (m *CategoryMapperImpl) ListCategories(c *app.RequestContext, pageNo, pageSize int64, parentID *int64, isActive *bool) ([]*category.Category, int64, error) {
+// GetCategoryBySlug 根据 slug 获取分类
+func (m *CategoryMapperImpl) GetCategoryBySlug(c *app.RequestContext, slug string) (*category.Category, error) {
+	var cat category.Category
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("slug = ?", slug).First(&cat).Error
+	if err != nil {
+		return nil, err
+	}
+	return &cat, nil
+}
+
+// IsCategorySlugTaken 判断 slug 是否已被其他分类占用，唯一索引包含已删除分类，因此不过滤 deleted
+// 移入回收站的分类已释放 slug，只有释放前删除的分类仍会占用
+func (m *CategoryMapperImpl) IsCategorySlugTaken(c *app.RequestContext, slug string, excludeID int64) (bool, error) {
+	var count int64
+	if err := db.GetDBFromContext(c).Model(&category.Category{}).Where("slug = ? AND id <> ?", slug, excludeID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ListCategories 获取分类列表，支持按父分类和状态筛选，locales 不为空时每个翻译组只返回最优先语言的版本
+func (m *CategoryMapperImpl) ListCategories(c *app.RequestContext, pageNo, pageSize int64, parentID *int64, isActive *bool, locales []string) ([]*category.Category, int64, error) {
 	var categories []*category.Category
 	var total int64
 
-	// 构建查询条件
-	query := db.GetDBFromContext(c).Model(&category.Category{}).Where("deleted = ?", false)
+	query := categoriesQuery(c, parentID, isActive, locales)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("sort DESC, id ASC").Offset(int(offset)).Limit(int(pageSize)).Find(&categories).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return categories, total, nil
+}
+
+// ListCategoriesWithCursor 按游标获取分类列表，排序与 ListCategories 一致，withTotal 为 false 时不统计总数
+func (m *CategoryMapperImpl) ListCategoriesWithCursor(c *app.RequestContext, cur *cursor.Cursor, limit int64, withTotal bool, parentID *int64, isActive *bool, locales []string) (*cursor.Page[*category.Category], int64, error) {
+	var categories []*category.Category
+	var total int64
+
+	query := categoriesQuery(c, parentID, isActive, locales)
+	if withTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// 由复合索引 idx_categories_sort_id 支撑键集条件与排序
+	columns := []keysetColumn{{SQL: "sort", Desc: true}, {SQL: "id"}}
+	query, err := keysetPage(query, columns, cur, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := query.Find(&categories).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return cursor.Paginate(categories, limit, cur, func(cat *category.Category) []int64 {
+		return []int64{cat.Sort, cat.ID}
+	}), total, nil
+}
+
+// categoriesQuery 构建分类列表的筛选条件
+// 按语言回退时，同组内满足状态筛选条件的更优先语言版本会隐藏当前分类，不论其父分类是否满足筛选条件
+func categoriesQuery(c *app.RequestContext, parentID *int64, isActive *bool, locales []string) *gorm.DB {
+	query := db.GetDBFromContext(c).Model(&category.Category{}).Scopes(base.NotDeleted)
 
 	// 按父分类筛选
 	if parentID != nil {
@@ -51,18 +120,31 @@ func (m *CategoryMapperImpl) ListCategories(c *app.RequestContext, pageNo, pageS
 		query = query.Where("is_active = ?", *isActive)
 	}
 
-	// 统计总数
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
+	return localeFallback(query, "categories", locales, func(preferred *gorm.DB) *gorm.DB {
+		preferred = preferred.Scopes(base.NotDeletedIn(translationAlias))
+		if isActive != nil {
+			preferred = preferred.Where(translationAlias+".is_active = ?", *isActive)
+		}
+		return preferred
+	})
+}
 
-	// 分页查询
-	offset := (pageNo - 1) * pageSize
-	if err := query.Order("sort DESC, id ASC").Offset(int(offset)).Limit(int(pageSize)).Find(&categories).Error; err != nil {
-		return nil, 0, err
+// ListCategoryTranslations 获取翻译组内的全部分类，按 ID 正序
+func (m *CategoryMapperImpl) ListCategoryTranslations(c *app.RequestContext, groupID int64) ([]*category.Category, error) {
+	var categories []*category.Category
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("translation_group_id = ?", groupID).Order("id ASC").Find(&categories).Error; err != nil {
+		return nil, err
 	}
+	return categories, nil
+}
 
-	return categories, total, nil
+// ListActiveCategories 获取全部启用的分类
+func (m *CategoryMapperImpl) ListActiveCategories(c *app.RequestContext) ([]*category.Category, error) {
+	var categories []*category.Category
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("is_active = ?", true).Order("sort DESC, id ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
 }
 
 // CreateCategory 创建分类
@@ -75,43 +157,147 @@ func (m *CategoryMapperImpl) UpdateCategory(c *app.RequestContext, cat *category
 	return db.GetDBFromContext(c).Save(cat).Error
 }
 
-// DeleteCategory 删除分类（软删除，级联删除所有子分类）
+// DeleteCategory 将分类移入回收站，slug 暂存到扩展字段并释放
+// 文章保留对该分类的引用以便恢复后重新归类，分类被永久清理时才清空；仍有子分类的分类须先移动或合并子分类，由服务层校验
 func (m *CategoryMapperImpl) DeleteCategory(c *app.RequestContext, categoryID int64) error {
-	dbConn := db.GetDBFromContext(c)
+	return softDeleteCategory(db.GetDBFromContext(c), categoryID)
+}
 
-	var allCategoryIDs []int64
-	var currentLevelIDs []int64
-	currentLevelIDs = append(currentLevelIDs, categoryID)
+// GetDeletedCategoryByID 根据 ID 获取回收站中的分类
+func (m *CategoryMapperImpl) GetDeletedCategoryByID(c *app.RequestContext, categoryID int64) (*category.Category, error) {
+	var cat category.Category
+	if err := db.GetDBFromContext(c).Scopes(base.OnlyDeleted).Where("id = ?", categoryID).First(&cat).Error; err != nil {
+		return nil, err
+	}
+	return &cat, nil
+}
 
-	for len(currentLevelIDs) > 0 {
-		var nextLevelIDs []int64
+// ListDeletedCategories 获取回收站中的分类，按删除时间倒序
+func (m *CategoryMapperImpl) ListDeletedCategories(c *app.RequestContext, pageNo, pageSize int64) ([]*category.Category, int64, error) {
+	var categories []*category.Category
+	var total int64
 
-		var childCategories []category.Category
-		dbConn.Select("id").Where("parent_id IN ? AND deleted = ?", currentLevelIDs, false).Find(&childCategories)
+	query := db.GetDBFromContext(c).Model(&category.Category{}).Scopes(base.OnlyDeleted)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
 
-		for _, child := range childCategories {
-			allCategoryIDs = append(allCategoryIDs, child.ID)
-			nextLevelIDs = append(nextLevelIDs, child.ID)
-		}
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("gmt_deleted DESC, id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&categories).Error; err != nil {
+		return nil, 0, err
+	}
 
-		currentLevelIDs = nextLevelIDs
+	return categories, total, nil
+}
+
+// RestoreCategory 从回收站恢复分类，slug 为空时保持原值
+func (m *CategoryMapperImpl) RestoreCategory(c *app.RequestContext, cat *category.Category, slug string, parentID int64) error {
+	restored := map[string]any{"parent_id": parentID}
+	if slug != "" {
+		restored["slug"] = slug
 	}
 
-	allCategoryIDs = append(allCategoryIDs, categoryID)
+	result := db.GetDBFromContext(c).Model(&category.Category{}).
+		Scopes(base.OnlyDeleted).
+		Where("id = ?", cat.ID).
+		Updates(base.RestoreColumns(cat.Ext, restored))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
 
-	return dbConn.Transaction(func(tx *gorm.DB) error {
+// ListAllCategories 获取全部未删除的分类（含未启用），按排序权重倒序，用于构建分类树
+func (m *CategoryMapperImpl) ListAllCategories(c *app.RequestContext) ([]*category.Category, error) {
+	var categories []*category.Category
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Order("sort DESC, id ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// LockAllCategories 在当前事务中以 SELECT ... FOR UPDATE 锁定全部未删除的分类行，串行化分类树的并发调整
+// SQLite 不支持行锁，写事务本身串行执行，直接跳过
+func (m *CategoryMapperImpl) LockAllCategories(c *app.RequestContext) error {
+	tx := db.GetDBFromContext(c)
+	if tx.Dialector.Name() == "sqlite" {
+		return nil
+	}
+	var ids []int64
+	return tx.Model(&category.Category{}).Scopes(base.NotDeleted).Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &ids).Error
+}
+
+// CountChildCategories 统计分类下未删除的直接子分类数量
+func (m *CategoryMapperImpl) CountChildCategories(c *app.RequestContext, parentID int64) (int64, error) {
+	var count int64
+	if err := db.GetDBFromContext(c).Model(&category.Category{}).Scopes(base.NotDeleted).Where("parent_id = ?", parentID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountPublishedPostsByCategoryIDs 批量统计各分类下已发布文章数量（不含仅链接可见文章，不含子分类）
+func (m *CategoryMapperImpl) CountPublishedPostsByCategoryIDs(c *app.RequestContext, categoryIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(categoryIDs))
+	if len(categoryIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CategoryID int64
+		Count      int64
+	}
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).
+		Select("category_id, COUNT(*) AS count").
+		Scopes(base.NotDeleted).
+		Where("category_id IN ? AND status = ? AND visibility <> ?", categoryIDs, consts.PostStatusPublished, consts.PostVisibilityUnlisted).
+		Group("category_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.CategoryID] = row.Count
+	}
+	return counts, nil
+}
+
+// MergeCategory 将源分类合并到目标分类：文章（含已删除文章）改为引用目标分类，直接子分类改挂到目标分类下，源分类移入回收站
+func (m *CategoryMapperImpl) MergeCategory(c *app.RequestContext, sourceID, targetID int64) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Model(&post.Post{}).
-			Where("category_id IN ? AND deleted = ?", allCategoryIDs, false).
-			Update("category_id", nil).Error; err != nil {
-			return fmt.Errorf("failed to clear post category references: %w", err)
+			Where("category_id = ?", sourceID).
+			Update("category_id", targetID).Error; err != nil {
+			return fmt.Errorf("failed to move posts to target category: %w", err)
 		}
 
 		if err := tx.Model(&category.Category{}).
-			Where("id IN ? AND deleted = ?", allCategoryIDs, false).
-			Update("deleted", true).Error; err != nil {
-			return fmt.Errorf("failed to delete categories: %w", err)
+			Scopes(base.NotDeleted).
+			Where("parent_id = ?", sourceID).
+			Update("parent_id", targetID).Error; err != nil {
+			return fmt.Errorf("failed to move child categories to target category: %w", err)
+		}
+
+		if err := softDeleteCategory(tx, sourceID); err != nil {
+			return fmt.Errorf("failed to delete source category: %w", err)
 		}
 
 		return nil
 	})
 }
+
+// softDeleteCategory 软删除分类并释放 slug
+func softDeleteCategory(tx *gorm.DB, categoryID int64) error {
+	var cat category.Category
+	if err := tx.Scopes(base.NotDeleted).Where("id = ?", categoryID).First(&cat).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&category.Category{}).
+		Scopes(base.NotDeleted).
+		Where("id = ?", categoryID).
+		Updates(base.SoftDeleteColumns(cat.Ext, base.ReleasedColumn{Name: "slug", Value: cat.Slug})).Error
+}
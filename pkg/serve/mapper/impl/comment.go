@@ -0,0 +1,110 @@
+// Package impl 提供评论相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/comment"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// CommentMapperImpl 评论数据访问实现
+type CommentMapperImpl struct{}
+
+// NewCommentMapper 创建评论数据访问实例
+func NewCommentMapper() mapper.CommentMapper {
+	return &CommentMapperImpl{}
+}
+
+// GetCommentByID 根据 ID 获取评论
+func (m *CommentMapperImpl) GetCommentByID(c *app.RequestContext, commentID int64) (*comment.Comment, error) {
+	var cm comment.Comment
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", commentID).First(&cm).Error
+	if err != nil {
+		return nil, err
+	}
+	return &cm, nil
+}
+
+// ListApprovedComments 获取文章下已通过审核的评论，按时间正序
+func (m *CommentMapperImpl) ListApprovedComments(c *app.RequestContext, pageNo, pageSize, postID int64) ([]*comment.Comment, int64, error) {
+	var comments []*comment.Comment
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&comment.Comment{}).Scopes(base.NotDeleted).Where("post_id = ? AND status = ?", postID, consts.CommentStatusApproved)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id ASC").Offset(int(offset)).Limit(int(pageSize)).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// ListCommentsByStatus 根据状态获取评论列表，status 为空时获取所有评论，postID 为空时不按文章筛选
+func (m *CommentMapperImpl) ListCommentsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, postID *int64) ([]*comment.Comment, int64, error) {
+	var comments []*comment.Comment
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&comment.Comment{}).Scopes(base.NotDeleted)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if postID != nil {
+		query = query.Where("post_id = ?", *postID)
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// CreateComment 创建评论
+func (m *CommentMapperImpl) CreateComment(c *app.RequestContext, cm *comment.Comment) error {
+	return db.GetDBFromContext(c).Create(cm).Error
+}
+
+// UpdateCommentStatus 更新评论审核状态
+func (m *CommentMapperImpl) UpdateCommentStatus(c *app.RequestContext, commentID int64, status string) error {
+	return db.GetDBFromContext(c).Model(&comment.Comment{}).Scopes(base.NotDeleted).Where("id = ?", commentID).Update("status", status).Error
+}
+
+// DeleteComment 删除评论（软删除，级联删除所有回复）
+func (m *CommentMapperImpl) DeleteComment(c *app.RequestContext, commentID int64) error {
+	dbConn := db.GetDBFromContext(c)
+
+	allCommentIDs := []int64{commentID}
+	currentLevelIDs := []int64{commentID}
+
+	for len(currentLevelIDs) > 0 {
+		var replyIDs []int64
+		if err := dbConn.Model(&comment.Comment{}).Scopes(base.NotDeleted).Where("parent_id IN ?", currentLevelIDs).Pluck("id", &replyIDs).Error; err != nil {
+			return err
+		}
+
+		allCommentIDs = append(allCommentIDs, replyIDs...)
+		currentLevelIDs = replyIDs
+	}
+
+	return dbConn.Model(&comment.Comment{}).Scopes(base.NotDeleted).Where("id IN ?", allCommentIDs).Updates(base.SoftDeleteColumns(nil)).Error
+}
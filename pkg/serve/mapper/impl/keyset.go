@@ -0,0 +1,76 @@
+// Package impl 键集分页查询辅助函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Done-0/jank/internal/utils/cursor"
+)
+
+// keysetColumn 键集分页的排序列，列表的排序列须以唯一的 ID 列结尾
+type keysetColumn struct {
+	SQL  string // 列名或排序表达式
+	Vars []any  // 表达式参数
+	Desc bool   // 是否倒序
+}
+
+// keysetPage 按游标追加键集条件与排序，并多取一行用于判断是否还有数据
+// 条件展开为 (a < ?) OR (a = ? AND b < ?) ... 的形式而非行值比较，三种数据库均可利用排序列上的索引
+// 向前翻页时比较方向与排序方向同时反转，查询结果为倒序，由 cursor.Paginate 恢复展示顺序
+func keysetPage(query *gorm.DB, columns []keysetColumn, cur *cursor.Cursor, limit int64) (*gorm.DB, error) {
+	backward := false
+	if cur != nil {
+		if len(cur.Keys) != len(columns) {
+			return nil, fmt.Errorf("%w: key count mismatch", cursor.ErrInvalidCursor)
+		}
+		backward = cur.Backward
+
+		conds := make([]string, 0, len(columns))
+		var vars []any
+		for i, col := range columns {
+			parts := make([]string, 0, i+1)
+			for j := 0; j < i; j++ {
+				parts = append(parts, columns[j].SQL+" = ?")
+				vars = append(vars, columns[j].Vars...)
+				vars = append(vars, cur.Keys[j])
+			}
+			op := " > ?"
+			if col.Desc != backward {
+				op = " < ?"
+			}
+			parts = append(parts, col.SQL+op)
+			vars = append(vars, col.Vars...)
+			vars = append(vars, cur.Keys[i])
+			conds = append(conds, "("+strings.Join(parts, " AND ")+")")
+		}
+		query = query.Where("("+strings.Join(conds, " OR ")+")", vars...)
+	}
+
+	return query.Order(keysetOrder(columns, backward)).Limit(int(limit) + 1), nil
+}
+
+// keysetOrder 生成排序子句，reverse 为 true 时反转全部排序方向
+// GORM 合并 ORDER BY 子句时会丢弃表达式，因此完整排序写在同一表达式中，调用后不应再追加 Order
+func keysetOrder(columns []keysetColumn, reverse bool) clause.OrderBy {
+	parts := make([]string, 0, len(columns))
+	var vars []any
+	for _, col := range columns {
+		dir := " ASC"
+		if col.Desc != reverse {
+			dir = " DESC"
+		}
+		parts = append(parts, col.SQL+dir)
+		vars = append(vars, col.Vars...)
+	}
+	return clause.OrderBy{Expression: clause.Expr{
+		SQL:                strings.Join(parts, ", "),
+		Vars:               vars,
+		WithoutParentheses: true,
+	}}
+}
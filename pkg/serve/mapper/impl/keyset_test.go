@@ -0,0 +1,134 @@
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/Done-0/jank/internal/utils/cursor"
+)
+
+// keysetRow 键集分页测试数据，排序列存在重复值以覆盖同值边界
+type keysetRow struct {
+	ID   int64 `gorm:"primaryKey"`
+	Sort int64
+}
+
+func openKeysetDB(t *testing.T, count int) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	// 内存数据库按连接隔离，限制为单连接保证各查询访问同一数据库
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&keysetRow{}))
+
+	rows := make([]*keysetRow, 0, count)
+	for i := 1; i <= count; i++ {
+		rows = append(rows, &keysetRow{ID: int64(i), Sort: int64(i % 3)})
+	}
+	require.NoError(t, db.Create(rows).Error)
+	return db
+}
+
+func fetchKeysetPage(t *testing.T, db *gorm.DB, columns []keysetColumn, cur *cursor.Cursor, limit int64, keys func(*keysetRow) []int64) *cursor.Page[*keysetRow] {
+	t.Helper()
+
+	query, err := keysetPage(db.Model(&keysetRow{}), columns, cur, limit)
+	require.NoError(t, err)
+	var rows []*keysetRow
+	require.NoError(t, query.Find(&rows).Error)
+	return cursor.Paginate(rows, limit, cur, keys)
+}
+
+func rowIDs(rows []*keysetRow) []int64 {
+	ids := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func TestKeysetPage(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []keysetColumn
+		keys    func(*keysetRow) []int64
+		order   string
+	}{
+		{
+			name:    "id desc",
+			columns: []keysetColumn{{SQL: "id", Desc: true}},
+			keys:    func(r *keysetRow) []int64 { return []int64{r.ID} },
+			order:   "id DESC",
+		},
+		{
+			name:    "sort desc id asc",
+			columns: []keysetColumn{{SQL: "sort", Desc: true}, {SQL: "id"}},
+			keys:    func(r *keysetRow) []int64 { return []int64{r.Sort, r.ID} },
+			order:   "sort DESC, id ASC",
+		},
+		{
+			name:    "expression with vars",
+			columns: []keysetColumn{{SQL: "CASE WHEN sort = ? THEN 1 ELSE 0 END", Vars: []any{2}, Desc: true}, {SQL: "id", Desc: true}},
+			keys: func(r *keysetRow) []int64 {
+				if r.Sort == 2 {
+					return []int64{1, r.ID}
+				}
+				return []int64{0, r.ID}
+			},
+			order: "CASE WHEN sort = 2 THEN 1 ELSE 0 END DESC, id DESC",
+		},
+	}
+
+	for _, tt := range tests {
+		for _, limit := range []int64{1, 3, 4, 10, 11} {
+			t.Run(fmt.Sprintf("%s/limit %d", tt.name, limit), func(t *testing.T) {
+				db := openKeysetDB(t, 10)
+				var want []*keysetRow
+				require.NoError(t, db.Order(tt.order).Find(&want).Error)
+
+				// 向后翻页至最后一页，拼接结果应与完整排序一致
+				var got []int64
+				var pages []*cursor.Page[*keysetRow]
+				var cur *cursor.Cursor
+				for {
+					page := fetchKeysetPage(t, db, tt.columns, cur, limit, tt.keys)
+					assert.LessOrEqual(t, int64(len(page.List)), limit)
+					assert.Equal(t, cur != nil, page.Prev != nil, "prev cursor exists unless on the first page")
+					got = append(got, rowIDs(page.List)...)
+					pages = append(pages, page)
+					if page.Next == nil {
+						break
+					}
+					cur = page.Next
+				}
+				assert.Equal(t, rowIDs(want), got)
+
+				// 从最后一页向前翻页，每一页应与向后翻页时的对应页一致
+				for i := len(pages) - 1; i > 0; i-- {
+					prev := fetchKeysetPage(t, db, tt.columns, pages[i].Prev, limit, tt.keys)
+					assert.Equal(t, rowIDs(pages[i-1].List), rowIDs(prev.List))
+					assert.Equal(t, i > 1, prev.Prev != nil, "prev cursor is nil only on the first page")
+					assert.NotNil(t, prev.Next)
+				}
+			})
+		}
+	}
+}
+
+func TestKeysetPageKeyCountMismatch(t *testing.T) {
+	db := openKeysetDB(t, 1)
+	columns := []keysetColumn{{SQL: "sort", Desc: true}, {SQL: "id"}}
+
+	_, err := keysetPage(db.Model(&keysetRow{}), columns, &cursor.Cursor{Keys: []int64{1}}, 10)
+	assert.True(t, errors.Is(err, cursor.ErrInvalidCursor))
+}
@@ -0,0 +1,91 @@
+// Package impl 提供媒体文件相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/media"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// MediaMapperImpl 媒体文件数据访问实现
+type MediaMapperImpl struct{}
+
+// NewMediaMapper 创建媒体文件数据访问实例
+func NewMediaMapper() mapper.MediaMapper {
+	return &MediaMapperImpl{}
+}
+
+// GetMediaByID 根据 ID 获取媒体文件
+func (m *MediaMapperImpl) GetMediaByID(c *app.RequestContext, mediaID int64) (*media.Media, error) {
+	var md media.Media
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", mediaID).First(&md).Error; err != nil {
+		return nil, err
+	}
+	return &md, nil
+}
+
+// GetMediaByHash 根据内容哈希获取任一用户上传的媒体文件，用于复用存储文件
+func (m *MediaMapperImpl) GetMediaByHash(c *app.RequestContext, hash string) (*media.Media, error) {
+	var md media.Media
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("hash = ?", hash).First(&md).Error; err != nil {
+		return nil, err
+	}
+	return &md, nil
+}
+
+// GetUploaderMediaByHash 根据内容哈希获取指定用户上传的媒体文件
+func (m *MediaMapperImpl) GetUploaderMediaByHash(c *app.RequestContext, uploaderID int64, hash string) (*media.Media, error) {
+	var md media.Media
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("uploader_id = ? AND hash = ?", uploaderID, hash).First(&md).Error; err != nil {
+		return nil, err
+	}
+	return &md, nil
+}
+
+// ListMedia 获取媒体文件列表，uploaderID 为空时获取所有用户上传的文件
+func (m *MediaMapperImpl) ListMedia(c *app.RequestContext, pageNo, pageSize int64, uploaderID *int64) ([]*media.Media, int64, error) {
+	var list []*media.Media
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&media.Media{}).Scopes(base.NotDeleted)
+	if uploaderID != nil {
+		query = query.Where("uploader_id = ?", *uploaderID)
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return list, total, nil
+}
+
+// CountMediaByStorageKey 统计引用同一存储文件的媒体记录数量
+func (m *MediaMapperImpl) CountMediaByStorageKey(c *app.RequestContext, storage, storageKey string) (int64, error) {
+	var count int64
+	if err := db.GetDBFromContext(c).Model(&media.Media{}).Scopes(base.NotDeleted).Where("storage = ? AND storage_key = ?", storage, storageKey).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CreateMedia 创建媒体文件记录
+func (m *MediaMapperImpl) CreateMedia(c *app.RequestContext, md *media.Media) error {
+	return db.GetDBFromContext(c).Create(md).Error
+}
+
+// DeleteMedia 删除媒体文件记录（软删除）
+func (m *MediaMapperImpl) DeleteMedia(c *app.RequestContext, mediaID int64) error {
+	return db.GetDBFromContext(c).Model(&media.Media{}).Scopes(base.NotDeleted).Where("id = ?", mediaID).Updates(base.SoftDeleteColumns(nil)).Error
+}
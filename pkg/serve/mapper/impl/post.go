@@ -4,10 +4,20 @@
 package impl
 
 import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/Done-0/jank/internal/model/base"
 	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/tag"
 	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/cursor"
 	"github.com/Done-0/jank/internal/utils/db"
 	"github.com/Done-0/jank/pkg/serve/mapper"
 )
@@ -23,23 +33,127 @@ func NewPostMapper() mapper.PostMapper {
 // GetPostByID 根据ID获取文章
 func (m *PostMapperImpl) GetPostByID(c *app.RequestContext, postID int64) (*post.Post, error) {
 	var p post.Post
-	err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", postID, false).First(&p).Error
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", postID).First(&p).Error
 	if err != nil {
 		return nil, err
 	}
 	return &p, nil
 }
 
-// ListPublishedPosts 获取已发布文章列表，categoryID 为空时不按分类筛选
-func (m *PostMapperImpl) ListPublishedPosts(c *app.RequestContext, pageNo, pageSize int64, categoryID *int64) ([]*post.Post, int64, error) {
+// GetPostsByIDs 批量获取文章
+func (m *PostMapperImpl) GetPostsByIDs(c *app.RequestContext, postIDs []int64) ([]*post.Post, error) {
+	var posts []*post.Post
+	if len(postIDs) == 0 {
+		return posts, nil
+	}
+
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id IN ?", postIDs).Find(&posts).Error
+	if err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// GetPostsByIDsWithDeleted 批量获取文章，包含已删除文章
+func (m *PostMapperImpl) GetPostsByIDsWithDeleted(c *app.RequestContext, postIDs []int64) ([]*post.Post, error) {
+	var posts []*post.Post
+	if len(postIDs) == 0 {
+		return posts, nil
+	}
+
+	if err := db.GetDBFromContext(c).Where("id IN ?", postIDs).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// GetPostBySlug 根据 slug 获取文章
+func (m *PostMapperImpl) GetPostBySlug(c *app.RequestContext, slug string) (*post.Post, error) {
+	var p post.Post
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("slug = ?", slug).First(&p).Error
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// IsPostSlugTaken 判断 slug 是否已被其他文章占用，唯一索引包含已删除文章，因此不过滤 deleted
+// 移入回收站的文章已释放 slug，只有释放前删除的文章仍会占用
+func (m *PostMapperImpl) IsPostSlugTaken(c *app.RequestContext, slug string, excludeID int64) (bool, error) {
+	var count int64
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).Where("slug = ? AND id <> ?", slug, excludeID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ListPublishedPosts 获取已发布文章列表（不含仅链接可见文章），categoryIDs 为空时不按分类筛选，tagID 为空时不按标签筛选
+// locales 为语言回退顺序，不为空时每个翻译组只返回最优先语言的版本
+func (m *PostMapperImpl) ListPublishedPosts(c *app.RequestContext, pageNo, pageSize int64, categoryIDs []int64, tagID *int64, locales []string) ([]*post.Post, int64, error) {
+	var posts []*post.Post
+	var total int64
+
+	query := publishedPostsQuery(c, categoryIDs, tagID, locales)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询，生效中的置顶文章排在最前
+	offset := (pageNo - 1) * pageSize
+	if err := orderPinnedFirst(query).Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return posts, total, nil
+}
+
+// ListPublishedPostsWithCursor 按游标获取已发布文章列表，排序与 ListPublishedPosts 一致，withTotal 为 false 时不统计总数
+func (m *PostMapperImpl) ListPublishedPostsWithCursor(c *app.RequestContext, cur *cursor.Cursor, limit int64, withTotal bool, categoryIDs []int64, tagID *int64, locales []string) (*cursor.Page[*post.Post], int64, error) {
 	var posts []*post.Post
 	var total int64
 
-	query := db.GetDBFromContext(c).Model(&post.Post{}).Where("deleted = ? AND status = ?", false, consts.PostStatusPublished)
-	if categoryID != nil {
-		query = query.Where("category_id = ?", *categoryID)
+	query := publishedPostsQuery(c, categoryIDs, tagID, locales)
+	if withTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+
+	now := time.Now().Unix()
+	columns := pinnedFirstColumns(now)
+	queryCursor := cur
+	if cur != nil && !cur.Backward && len(cur.Keys) == len(columns) && cur.Keys[0] == 0 {
+		// 游标已越过全部置顶文章，后续只有非置顶文章，去掉置顶排序列后按 ID 倒序扫描即可走主键索引
+		query = query.Where("NOT ("+pinnedActiveCondition+")", true, now)
+		columns = columns[2:]
+		queryCursor = &cursor.Cursor{Keys: cur.Keys[2:]}
+	}
+
+	query, err := keysetPage(query, columns, queryCursor, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, 0, err
 	}
 
+	return cursor.Paginate(posts, limit, cur, func(p *post.Post) []int64 {
+		if isPinnedActive(p, now) {
+			return []int64{1, p.PinnedWeight, p.ID}
+		}
+		return []int64{0, 0, p.ID}
+	}), total, nil
+}
+
+// ListPostsByStatus 根据状态获取文章列表，status 为空时获取所有文章，categoryIDs 为空时不按分类筛选，locale 为空时不按语言筛选
+func (m *PostMapperImpl) ListPostsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, categoryIDs []int64, locale string) ([]*post.Post, int64, error) {
+	var posts []*post.Post
+	var total int64
+
+	query := postsByStatusQuery(c, status, categoryIDs, locale)
+
 	// 统计总数
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -54,27 +168,46 @@ func (m *PostMapperImpl) ListPublishedPosts(c *app.RequestContext, pageNo, pageS
 	return posts, total, nil
 }
 
-// ListPostsByStatus 根据状态获取文章列表，status 为空时获取所有文章，categoryID 为空时不按分类筛选
-func (m *PostMapperImpl) ListPostsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, categoryID *int64) ([]*post.Post, int64, error) {
+// ListPostsByStatusWithCursor 按游标根据状态获取文章列表，按 ID 倒序，withTotal 为 false 时不统计总数
+func (m *PostMapperImpl) ListPostsByStatusWithCursor(c *app.RequestContext, cur *cursor.Cursor, limit int64, withTotal bool, status string, categoryIDs []int64, locale string) (*cursor.Page[*post.Post], int64, error) {
 	var posts []*post.Post
 	var total int64
 
-	query := db.GetDBFromContext(c).Model(&post.Post{}).Where("deleted = ?", false)
-	if status != "" {
-		query = query.Where("status = ?", status)
+	query := postsByStatusQuery(c, status, categoryIDs, locale)
+	if withTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
-	if categoryID != nil {
-		query = query.Where("category_id = ?", *categoryID)
+
+	query, err := keysetPage(query, []keysetColumn{{SQL: "id", Desc: true}}, cur, limit)
+	if err != nil {
+		return nil, 0, err
 	}
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return cursor.Paginate(posts, limit, cur, func(p *post.Post) []int64 {
+		return []int64{p.ID}
+	}), total, nil
+}
+
+// ListPublishedPostsByAuthor 获取指定作者的已发布文章列表
+func (m *PostMapperImpl) ListPublishedPostsByAuthor(c *app.RequestContext, pageNo, pageSize, authorID int64) ([]*post.Post, int64, error) {
+	var posts []*post.Post
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("status = ? AND visibility <> ? AND author_id = ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted, authorID)
 
 	// 统计总数
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// 分页查询
+	// 分页查询，生效中的置顶文章排在最前
 	offset := (pageNo - 1) * pageSize
-	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+	if err := orderPinnedFirst(query).Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -87,7 +220,7 @@ func (m *PostMapperImpl) ListPublicPosts(c *app.RequestContext, pageNo, pageSize
 	var total int64
 
 	// 查询已发布和已归档的文章
-	query := db.GetDBFromContext(c).Model(&post.Post{}).Where("deleted = ? AND status IN (?, ?)", false, consts.PostStatusPublished, consts.PostStatusArchived)
+	query := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("status IN (?, ?) AND visibility <> ?", consts.PostStatusPublished, consts.PostStatusArchived, consts.PostVisibilityUnlisted)
 
 	// 统计总数
 	if err := query.Count(&total).Error; err != nil {
@@ -103,26 +236,327 @@ func (m *PostMapperImpl) ListPublicPosts(c *app.RequestContext, pageNo, pageSize
 	return posts, total, nil
 }
 
+// ListFeaturedPosts 获取已发布的精选文章列表，按精选权重倒序
+func (m *PostMapperImpl) ListFeaturedPosts(c *app.RequestContext, pageNo, pageSize int64) ([]*post.Post, int64, error) {
+	var posts []*post.Post
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("status = ? AND visibility <> ? AND featured = ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted, true)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("featured_weight DESC").Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return posts, total, nil
+}
+
+// SearchPublishedPosts 全文检索已发布文章，按标题、摘要、正文的加权相关度排序
+// 仅检索公开文章，避免通过关键词命中推断密码保护文章的内容
+func (m *PostMapperImpl) SearchPublishedPosts(c *app.RequestContext, keyword string, pageNo, pageSize int64) ([]*post.Post, int64, error) {
+	var posts []*post.Post
+	var total int64
+
+	tx := db.GetDBFromContext(c)
+	query := tx.Model(&post.Post{}).Scopes(base.NotDeleted).Where("posts.status = ? AND posts.visibility = ?", consts.PostStatusPublished, consts.PostVisibilityPublic)
+
+	var order clause.Expr
+	switch tx.Dialector.Name() {
+	case "postgres":
+		query = query.Where(fmt.Sprintf("posts.%s @@ plainto_tsquery('simple', ?)", consts.PostSearchVectorColumn), keyword)
+		order = clause.Expr{SQL: fmt.Sprintf("ts_rank(posts.%s, plainto_tsquery('simple', ?)) DESC, posts.id DESC", consts.PostSearchVectorColumn), Vars: []any{keyword}}
+	case "mysql":
+		query = query.Where("MATCH(posts.title, posts.description, posts.markdown) AGAINST (? IN NATURAL LANGUAGE MODE)", keyword)
+		order = clause.Expr{SQL: "MATCH(posts.title, posts.description, posts.markdown) AGAINST (? IN NATURAL LANGUAGE MODE) DESC, posts.id DESC", Vars: []any{keyword}}
+	default:
+		if match, ok := buildFTSMatchQuery(keyword); ok && tx.Migrator().HasTable(consts.PostFTSTable) {
+			query = query.Joins(fmt.Sprintf("JOIN %[1]s ON %[1]s.rowid = posts.id", consts.PostFTSTable)).Where(fmt.Sprintf("%s MATCH ?", consts.PostFTSTable), match)
+			order = clause.Expr{SQL: fmt.Sprintf("bm25(%s, 10.0, 5.0, 1.0), posts.id DESC", consts.PostFTSTable)}
+			break
+		}
+		// FTS5 不可用或关键词过短时降级为 LIKE 匹配，标题命中优先
+		for _, term := range strings.Fields(keyword) {
+			pattern := likeContainsPattern(term)
+			query = query.Where(`(posts.title LIKE ? ESCAPE '\' OR posts.description LIKE ? ESCAPE '\' OR posts.markdown LIKE ? ESCAPE '\')`, pattern, pattern, pattern)
+		}
+		order = clause.Expr{SQL: `CASE WHEN posts.title LIKE ? ESCAPE '\' THEN 0 ELSE 1 END, posts.id DESC`, Vars: []any{likeContainsPattern(keyword)}}
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Select("posts.*").Clauses(clause.OrderBy{Expression: order}).Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return posts, total, nil
+}
+
+// buildFTSMatchQuery 将关键词转换为 FTS5 MATCH 表达式，每个词作为短语并以 AND 连接
+// trigram 分词器无法匹配少于 3 个字符的词，此时返回 false 以便降级处理
+func buildFTSMatchQuery(keyword string) (string, bool) {
+	terms := strings.Fields(keyword)
+	if len(terms) == 0 {
+		return "", false
+	}
+
+	phrases := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if utf8.RuneCountInString(term) < 3 {
+			return "", false
+		}
+		phrases = append(phrases, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+	}
+	return strings.Join(phrases, " AND "), true
+}
+
+// likeReplacer 转义 LIKE 通配符与转义符本身，配合 ESCAPE '\' 使用
+var likeReplacer = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// likeContainsPattern 构造包含匹配的 LIKE 模式，关键词中的 %、_ 与 \ 按字面匹配
+func likeContainsPattern(term string) string {
+	return "%" + likeReplacer.Replace(term) + "%"
+}
+
+// CountPublishedPosts 统计已发布文章数量（不含仅链接可见文章）
+func (m *PostMapperImpl) CountPublishedPosts(c *app.RequestContext) (int64, error) {
+	var total int64
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("status = ? AND visibility <> ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// ListPublishedPostTimestamps 获取已发布文章的 ID 与修改时间，仅查询必要字段
+func (m *PostMapperImpl) ListPublishedPostTimestamps(c *app.RequestContext, offset, limit int64) ([]*post.Post, error) {
+	var posts []*post.Post
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).Select("id, gmt_modified").Scopes(base.NotDeleted).Where("status = ? AND visibility <> ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted).
+		Order("id DESC").Offset(int(offset)).Limit(int(limit)).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// ListPublishedPostIDsByCategory 获取同分类的已发布文章 ID，按 ID 倒序
+func (m *PostMapperImpl) ListPublishedPostIDsByCategory(c *app.RequestContext, categoryID, excludeID, limit int64) ([]int64, error) {
+	var postIDs []int64
+	if err := db.GetDBFromContext(c).Model(&post.Post{}).
+		Scopes(base.NotDeleted).
+		Where("category_id = ? AND id <> ? AND status = ? AND visibility <> ?", categoryID, excludeID, consts.PostStatusPublished, consts.PostVisibilityUnlisted).
+		Order("id DESC").Limit(int(limit)).
+		Pluck("id", &postIDs).Error; err != nil {
+		return nil, err
+	}
+	return postIDs, nil
+}
+
+// ListPublishedPostIDsByTags 获取含任一标签的已发布文章 ID，按共有标签数倒序
+func (m *PostMapperImpl) ListPublishedPostIDsByTags(c *app.RequestContext, tagIDs []int64, excludeID, limit int64) ([]int64, error) {
+	if len(tagIDs) == 0 {
+		return []int64{}, nil
+	}
+
+	var postIDs []int64
+	if err := db.GetDBFromContext(c).Model(&tag.PostTag{}).
+		Joins("JOIN posts ON posts.id = post_tags.post_id AND posts.status = ? AND posts.visibility <> ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted).
+		Scopes(base.NotDeletedIn("posts")).
+		Where("post_tags.tag_id IN ? AND post_tags.post_id <> ?", tagIDs, excludeID).
+		Group("post_tags.post_id").
+		Order("COUNT(*) DESC").Order("post_tags.post_id DESC").Limit(int(limit)).
+		Pluck("post_tags.post_id", &postIDs).Error; err != nil {
+		return nil, err
+	}
+	return postIDs, nil
+}
+
+// ListPostTranslations 获取翻译组内的全部文章，按 ID 正序
+func (m *PostMapperImpl) ListPostTranslations(c *app.RequestContext, groupID int64) ([]*post.Post, error) {
+	var posts []*post.Post
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("translation_group_id = ?", groupID).Order("id ASC").Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
 // CreatePost 创建文章
 func (m *PostMapperImpl) CreatePost(c *app.RequestContext, p *post.Post) error {
+	if p.Version == 0 {
+		p.Version = 1
+	}
 	if err := db.GetDBFromContext(c).Create(p).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
-// UpdatePost 更新文章
+// UpdatePost 更新文章，仅当数据库中的版本号仍为 p.Version 时写入，写入后 p.Version 加一
+// 读取文章后已被他人修改时返回 mapper.ErrPostVersionConflict，p 保持不变
 func (m *PostMapperImpl) UpdatePost(c *app.RequestContext, p *post.Post) error {
-	if err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", p.ID, false).Updates(p).Error; err != nil {
-		return err
+	version := p.Version
+	p.Version = version + 1
+	result := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ? AND version = ?", p.ID, version).Updates(p)
+	if result.Error != nil || result.RowsAffected == 0 {
+		p.Version = version
+		if result.Error != nil {
+			return result.Error
+		}
+		return mapper.ErrPostVersionConflict
+	}
+	// Updates 会忽略零值字段，取消定时发布时需单独清空发布时间
+	if p.PublishAt == nil {
+		if err := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("id = ?", p.ID).Update("publish_at", nil).Error; err != nil {
+			return err
+		}
+	}
+	// 取消密码保护时同理需单独清空密码哈希
+	if p.PasswordHash == "" {
+		if err := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("id = ?", p.ID).Update("password_hash", "").Error; err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-// DeletePost 删除文章（软删除）
+// UpdatePostPin 设置文章置顶状态，仅更新置顶相关字段，不影响修改时间
+func (m *PostMapperImpl) UpdatePostPin(c *app.RequestContext, postID int64, pinned bool, pinnedUntil *int64, weight int64) error {
+	return db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("id = ?", postID).UpdateColumns(map[string]any{
+		"pinned":        pinned,
+		"pinned_until":  pinnedUntil,
+		"pinned_weight": weight,
+	}).Error
+}
+
+// UpdatePostFeature 设置文章精选状态，仅更新精选相关字段，不影响修改时间
+func (m *PostMapperImpl) UpdatePostFeature(c *app.RequestContext, postID int64, featured bool, weight int64) error {
+	return db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("id = ?", postID).UpdateColumns(map[string]any{
+		"featured":        featured,
+		"featured_weight": weight,
+	}).Error
+}
+
+// DeletePost 将文章移入回收站，slug 暂存到扩展字段并释放，以便其他文章使用
 func (m *PostMapperImpl) DeletePost(c *app.RequestContext, postID int64) error {
-	if err := db.GetDBFromContext(c).Model(&post.Post{}).Where("id = ? AND deleted = ?", postID, false).Update("deleted", true).Error; err != nil {
+	var p post.Post
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", postID).First(&p).Error; err != nil {
 		return err
 	}
+
+	return db.GetDBFromContext(c).Model(&post.Post{}).
+		Scopes(base.NotDeleted).
+		Where("id = ?", postID).
+		Updates(base.SoftDeleteColumns(p.Ext, base.ReleasedColumn{Name: "slug", Value: p.Slug})).Error
+}
+
+// GetDeletedPostByID 根据 ID 获取回收站中的文章
+func (m *PostMapperImpl) GetDeletedPostByID(c *app.RequestContext, postID int64) (*post.Post, error) {
+	var p post.Post
+	if err := db.GetDBFromContext(c).Scopes(base.OnlyDeleted).Where("id = ?", postID).First(&p).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListDeletedPosts 获取回收站中的文章，按删除时间倒序，authorID 不为空时仅返回该作者的文章
+func (m *PostMapperImpl) ListDeletedPosts(c *app.RequestContext, pageNo, pageSize int64, authorID *int64) ([]*post.Post, int64, error) {
+	var posts []*post.Post
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.OnlyDeleted)
+	if authorID != nil {
+		query = query.Where("author_id = ?", *authorID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("gmt_deleted DESC, id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return posts, total, nil
+}
+
+// RestorePost 从回收站恢复文章，slug 为空时保持原值
+func (m *PostMapperImpl) RestorePost(c *app.RequestContext, p *post.Post, slug string) error {
+	restored := map[string]any{}
+	if slug != "" {
+		restored["slug"] = slug
+	}
+
+	result := db.GetDBFromContext(c).Model(&post.Post{}).
+		Scopes(base.OnlyDeleted).
+		Where("id = ?", p.ID).
+		Updates(base.RestoreColumns(p.Ext, restored))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
 	return nil
 }
+
+// pinnedActiveCondition 置顶生效条件：已置顶且未设置到期时间或尚未到期，参数依次为 true 与当前时间
+const pinnedActiveCondition = "pinned = ? AND (pinned_until IS NULL OR pinned_until > ?)"
+
+// orderPinnedFirst 按置顶状态排序：未过期的置顶文章排在最前并按置顶权重倒序，其余按 ID 倒序
+// 调用后不应再追加 Order，原因见 keysetOrder
+func orderPinnedFirst(query *gorm.DB) *gorm.DB {
+	return query.Order(keysetOrder(pinnedFirstColumns(time.Now().Unix()), false))
+}
+
+// pinnedFirstColumns 返回置顶优先排序的排序列：置顶是否生效、生效置顶的权重、ID
+func pinnedFirstColumns(now int64) []keysetColumn {
+	return []keysetColumn{
+		{SQL: "CASE WHEN " + pinnedActiveCondition + " THEN 1 ELSE 0 END", Vars: []any{true, now}, Desc: true},
+		{SQL: "CASE WHEN " + pinnedActiveCondition + " THEN pinned_weight ELSE 0 END", Vars: []any{true, now}, Desc: true},
+		{SQL: "id", Desc: true},
+	}
+}
+
+// isPinnedActive 判断文章置顶在指定时间是否生效，与 pinnedActiveCondition 保持一致
+func isPinnedActive(p *post.Post, now int64) bool {
+	return p.Pinned && (p.PinnedUntil == nil || *p.PinnedUntil > now)
+}
+
+// publishedPostsQuery 构建已发布文章列表的筛选条件（不含仅链接可见文章）
+// 按语言回退时，同组内已发布且非仅链接可见的更优先语言版本会隐藏当前文章，不论其分类与标签是否满足筛选条件
+func publishedPostsQuery(c *app.RequestContext, categoryIDs []int64, tagID *int64, locales []string) *gorm.DB {
+	query := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted).Where("status = ? AND visibility <> ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted)
+	if len(categoryIDs) > 0 {
+		query = query.Where("category_id IN ?", categoryIDs)
+	}
+	if tagID != nil {
+		query = query.Where("id IN (?)", db.GetDBFromContext(c).Model(&tag.PostTag{}).Select("post_id").Where("tag_id = ?", *tagID))
+	}
+	return localeFallback(query, "posts", locales, func(preferred *gorm.DB) *gorm.DB {
+		return preferred.Scopes(base.NotDeletedIn(translationAlias)).Where(translationAlias+".status = ? AND "+translationAlias+".visibility <> ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted)
+	})
+}
+
+// postsByStatusQuery 构建按状态筛选文章的条件，status 为空时不按状态筛选，locale 为空时不按语言筛选
+func postsByStatusQuery(c *app.RequestContext, status string, categoryIDs []int64, locale string) *gorm.DB {
+	query := db.GetDBFromContext(c).Model(&post.Post{}).Scopes(base.NotDeleted)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if len(categoryIDs) > 0 {
+		query = query.Where("category_id IN ?", categoryIDs)
+	}
+	if locale != "" {
+		query = query.Where("locale = ?", locale)
+	}
+	return query
+}
@@ -0,0 +1,105 @@
+// Package impl 提供文章互动表态相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// PostReactionMapperImpl 文章表态数据访问实现
+type PostReactionMapperImpl struct{}
+
+// NewPostReactionMapper 创建文章表态数据访问实例
+func NewPostReactionMapper() mapper.PostReactionMapper {
+	return &PostReactionMapperImpl{}
+}
+
+// CreatePostReaction 创建表态，依赖唯一索引保证并发请求下同一用户的同类表态只记录一次
+func (m *PostReactionMapperImpl) CreatePostReaction(c *app.RequestContext, reaction *post.PostReaction) (bool, error) {
+	result := db.GetDBFromContext(c).Clauses(clause.OnConflict{DoNothing: true}).Create(reaction)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeletePostReaction 删除表态，取消表态后可再次表态，因此直接物理删除以免与唯一索引冲突
+func (m *PostReactionMapperImpl) DeletePostReaction(c *app.RequestContext, postID, userID int64, reactionType string) (bool, error) {
+	result := db.GetDBFromContext(c).
+		Where("post_id = ? AND user_id = ? AND type = ?", postID, userID, reactionType).
+		Delete(&post.PostReaction{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// IncrReactionCount 表态数量加一，计数记录不存在时创建
+func (m *PostReactionMapperImpl) IncrReactionCount(c *app.RequestContext, postID int64, reactionType string) error {
+	return db.GetDBFromContext(c).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "post_id"}, {Name: "type"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"count": gorm.Expr("? + 1", clause.Column{Table: "post_reaction_counts", Name: "count"}),
+		}),
+	}).Create(&post.PostReactionCount{PostID: postID, Type: reactionType, Count: 1}).Error
+}
+
+// DecrReactionCount 表态数量减一，不会小于 0
+func (m *PostReactionMapperImpl) DecrReactionCount(c *app.RequestContext, postID int64, reactionType string) error {
+	return db.GetDBFromContext(c).Model(&post.PostReactionCount{}).
+		Where("post_id = ? AND type = ? AND count > ?", postID, reactionType, 0).
+		Update("count", gorm.Expr("? - 1", clause.Column{Name: "count"})).Error
+}
+
+// ListReactionCounts 获取文章各类表态数量
+func (m *PostReactionMapperImpl) ListReactionCounts(c *app.RequestContext, postID int64) ([]*post.PostReactionCount, error) {
+	var counts []*post.PostReactionCount
+	if err := db.GetDBFromContext(c).
+		Scopes(base.NotDeleted).
+		Where("post_id = ?", postID).
+		Find(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// ListReactedPosts 获取用户表态过的已发布文章，reactionType 为空时包含所有表态类型，按最近表态时间倒序
+func (m *PostReactionMapperImpl) ListReactedPosts(c *app.RequestContext, userID int64, reactionType string, pageNo, pageSize int64) ([]*post.Post, int64, error) {
+	var posts []*post.Post
+	var total int64
+
+	reacted := db.GetDBFromContext(c).Model(&post.PostReaction{}).
+		Select("post_id, MAX(gmt_created) AS reacted_at").
+		Where("user_id = ?", userID)
+	if reactionType != "" {
+		reacted = reacted.Where("type = ?", reactionType)
+	}
+	reacted = reacted.Group("post_id")
+
+	query := db.GetDBFromContext(c).Model(&post.Post{}).
+		Joins("JOIN (?) AS reacted ON reacted.post_id = posts.id", reacted).
+		Scopes(base.NotDeleted).
+		Where("posts.status = ?", consts.PostStatusPublished)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Select("posts.*").Order("reacted.reacted_at DESC").Order("posts.id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return posts, total, nil
+}
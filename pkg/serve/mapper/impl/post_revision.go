@@ -0,0 +1,65 @@
+// Package impl 提供文章修订历史相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// PostRevisionMapperImpl 文章修订数据访问实现
+type PostRevisionMapperImpl struct{}
+
+// NewPostRevisionMapper 创建文章修订数据访问实例
+func NewPostRevisionMapper() mapper.PostRevisionMapper {
+	return &PostRevisionMapperImpl{}
+}
+
+// GetPostRevisionByID 根据 ID 获取修订
+func (m *PostRevisionMapperImpl) GetPostRevisionByID(c *app.RequestContext, revisionID int64) (*post.PostRevision, error) {
+	var revision post.PostRevision
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", revisionID).First(&revision).Error; err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// ListPostRevisions 获取文章修订列表，按时间倒序
+func (m *PostRevisionMapperImpl) ListPostRevisions(c *app.RequestContext, postID, pageNo, pageSize int64) ([]*post.PostRevision, int64, error) {
+	var revisions []*post.PostRevision
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&post.PostRevision{}).Scopes(base.NotDeleted).Where("post_id = ?", postID)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询，雪花 ID 随时间递增
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&revisions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return revisions, total, nil
+}
+
+// GetLatestPostRevision 获取文章最新的修订，雪花 ID 随时间递增
+func (m *PostRevisionMapperImpl) GetLatestPostRevision(c *app.RequestContext, postID int64) (*post.PostRevision, error) {
+	var revision post.PostRevision
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("post_id = ?", postID).Order("id DESC").First(&revision).Error; err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// CreatePostRevision 创建修订
+func (m *PostRevisionMapperImpl) CreatePostRevision(c *app.RequestContext, revision *post.PostRevision) error {
+	return db.GetDBFromContext(c).Create(revision).Error
+}
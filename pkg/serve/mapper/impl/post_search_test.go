@@ -0,0 +1,83 @@
+package impl
+
+import (
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+)
+
+func TestLikeContainsPattern(t *testing.T) {
+	tests := []struct {
+		term string
+		want string
+	}{
+		{term: "go", want: "%go%"},
+		{term: "100%", want: `%100\%%`},
+		{term: "snake_case", want: `%snake\_case%`},
+		{term: `C:\path`, want: `%C:\\path%`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.term, func(t *testing.T) {
+			assert.Equal(t, tt.want, likeContainsPattern(tt.term))
+		})
+	}
+}
+
+// TestSearchPublishedPostsLikeFallback 未建立 FTS5 虚拟表时降级为 LIKE 匹配，通配符按字面匹配
+func TestSearchPublishedPostsLikeFallback(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&post.Post{}))
+	global.DB = db
+
+	posts := []*post.Post{
+		{Title: "Discount 100% off", Markdown: "sale"},
+		{Title: "snake_case naming", Markdown: "style"},
+		{Title: "Plain title", Markdown: "nothing special"},
+		{Title: "Windows paths", Markdown: `C:\Users`},
+	}
+	for _, p := range posts {
+		p.Status = consts.PostStatusPublished
+		p.Visibility = consts.PostVisibilityPublic
+	}
+	require.NoError(t, db.Create(posts).Error)
+
+	tests := []struct {
+		keyword string
+		want    []string
+	}{
+		{keyword: "%", want: []string{"Discount 100% off"}},
+		{keyword: "_", want: []string{"snake_case naming"}},
+		{keyword: `\`, want: []string{"Windows paths"}},
+		{keyword: "title", want: []string{"Plain title"}},
+		// 标题命中优先，其余按 ID 倒序
+		{keyword: "s", want: []string{"Windows paths", "snake_case naming", "Discount 100% off", "Plain title"}},
+	}
+
+	mapper := NewPostMapper()
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			found, total, err := mapper.SearchPublishedPosts(&app.RequestContext{}, tt.keyword, 1, 10)
+			require.NoError(t, err)
+			titles := make([]string, 0, len(found))
+			for _, p := range found {
+				titles = append(titles, p.Title)
+			}
+			assert.Equal(t, tt.want, titles)
+			assert.Equal(t, int64(len(tt.want)), total)
+		})
+	}
+}
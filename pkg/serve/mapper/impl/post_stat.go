@@ -0,0 +1,91 @@
+// Package impl 提供文章浏览统计相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// PostStatMapperImpl 文章浏览统计数据访问实现
+type PostStatMapperImpl struct{}
+
+// NewPostStatMapper 创建文章浏览统计数据访问实例
+func NewPostStatMapper() mapper.PostStatMapper {
+	return &PostStatMapperImpl{}
+}
+
+// ListDailyPostStats 获取文章每日浏览量，按日期升序
+func (m *PostStatMapperImpl) ListDailyPostStats(c *app.RequestContext, postID int64, startDate, endDate string) ([]*post.PostStat, error) {
+	var stats []*post.PostStat
+	if err := db.GetDBFromContext(c).
+		Scopes(base.NotDeleted).
+		Where("post_id = ? AND date >= ? AND date <= ?", postID, startDate, endDate).
+		Order("date ASC").
+		Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// ListTopPosts 获取时间范围内浏览量最高的文章，不含已删除文章
+func (m *PostStatMapperImpl) ListTopPosts(c *app.RequestContext, startDate, endDate string, limit int64) ([]*mapper.PostViews, error) {
+	var result []*mapper.PostViews
+	if err := db.GetDBFromContext(c).Table("post_stats").
+		Select("post_stats.post_id AS post_id, SUM(post_stats.views) AS views").
+		Joins("JOIN posts ON posts.id = post_stats.post_id").
+		Scopes(base.NotDeleted, base.NotDeletedIn("posts")).
+		Where("post_stats.date >= ? AND post_stats.date <= ?", startDate, endDate).
+		Group("post_stats.post_id").
+		Order("views DESC").
+		Limit(int(limit)).
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListReferrers 获取来源浏览量排行，postID 为空时统计全站
+func (m *PostStatMapperImpl) ListReferrers(c *app.RequestContext, postID *int64, startDate, endDate string, limit int64) ([]*mapper.ReferrerViews, error) {
+	query := db.GetDBFromContext(c).Model(&post.PostReferrerStat{}).
+		Select("referrer, SUM(views) AS views").
+		Scopes(base.NotDeleted).
+		Where("date >= ? AND date <= ?", startDate, endDate)
+	if postID != nil {
+		query = query.Where("post_id = ?", *postID)
+	}
+
+	var result []*mapper.ReferrerViews
+	if err := query.Group("referrer").Order("views DESC").Limit(int(limit)).Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetTotalViewsByPostIDs 批量获取文章累计浏览量
+func (m *PostStatMapperImpl) GetTotalViewsByPostIDs(c *app.RequestContext, postIDs []int64) (map[int64]int64, error) {
+	views := make(map[int64]int64, len(postIDs))
+	if len(postIDs) == 0 {
+		return views, nil
+	}
+
+	var result []*mapper.PostViews
+	if err := db.GetDBFromContext(c).Model(&post.PostStat{}).
+		Select("post_id, SUM(views) AS views").
+		Scopes(base.NotDeleted).
+		Where("post_id IN ?", postIDs).
+		Group("post_id").
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+
+	for _, r := range result {
+		views[r.PostID] = r.Views
+	}
+	return views, nil
+}
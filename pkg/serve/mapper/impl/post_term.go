@@ -0,0 +1,104 @@
+// Package impl 提供文章关键词索引相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// PostTermMapperImpl 文章关键词数据访问实现
+type PostTermMapperImpl struct{}
+
+// NewPostTermMapper 创建文章关键词数据访问实例
+func NewPostTermMapper() mapper.PostTermMapper {
+	return &PostTermMapperImpl{}
+}
+
+// ReplacePostTerms 重建文章关键词并标记文章已建立索引，旧关键词直接物理删除
+func (m *PostTermMapperImpl) ReplacePostTerms(c *app.RequestContext, postID int64, terms []*post.PostTerm) error {
+	if err := db.GetDBFromContext(c).Where("post_id = ?", postID).Delete(&post.PostTerm{}).Error; err != nil {
+		return err
+	}
+	if len(terms) > 0 {
+		if err := db.GetDBFromContext(c).Create(&terms).Error; err != nil {
+			return err
+		}
+	}
+	// 未提取到关键词的文章同样标记，避免每次启动重复回填；使用 UpdateColumn 避免修改 gmt_modified
+	return db.GetDBFromContext(c).Model(&post.Post{}).Where("id = ?", postID).UpdateColumn("terms_indexed", true).Error
+}
+
+// ListPostTerms 获取文章关键词
+func (m *PostTermMapperImpl) ListPostTerms(c *app.RequestContext, postID int64) ([]*post.PostTerm, error) {
+	var terms []*post.PostTerm
+	if err := db.GetDBFromContext(c).Where("post_id = ?", postID).Find(&terms).Error; err != nil {
+		return nil, err
+	}
+	return terms, nil
+}
+
+// CountIndexedPosts 统计已建立关键词索引的文章数量
+func (m *PostTermMapperImpl) CountIndexedPosts(c *app.RequestContext, excludeID int64) (int64, error) {
+	var total int64
+	if err := db.GetDBFromContext(c).Model(&post.PostTerm{}).
+		Where("post_id <> ?", excludeID).
+		Distinct("post_id").
+		Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// CountTermDocuments 统计包含各检索词的文章数量
+func (m *PostTermMapperImpl) CountTermDocuments(c *app.RequestContext, terms []string, excludeID int64) (map[string]int64, error) {
+	counts := make(map[string]int64, len(terms))
+	if len(terms) == 0 {
+		return counts, nil
+	}
+
+	// 分批查询，避免 IN 参数过多
+	for start := 0; start < len(terms); start += consts.PostTermQueryBatchSize {
+		end := min(start+consts.PostTermQueryBatchSize, len(terms))
+
+		var result []struct {
+			Term  string
+			Total int64
+		}
+		if err := db.GetDBFromContext(c).Model(&post.PostTerm{}).
+			Select("term, COUNT(*) AS total").
+			Where("term IN ? AND post_id <> ?", terms[start:end], excludeID).
+			Group("term").
+			Scan(&result).Error; err != nil {
+			return nil, err
+		}
+		for _, r := range result {
+			counts[r.Term] = r.Total
+		}
+	}
+	return counts, nil
+}
+
+// ListMatchingTerms 获取其他已发布文章中与给定检索词相同的关键词
+func (m *PostTermMapperImpl) ListMatchingTerms(c *app.RequestContext, terms []string, excludeID int64) ([]*post.PostTerm, error) {
+	var matched []*post.PostTerm
+	if len(terms) == 0 {
+		return matched, nil
+	}
+
+	if err := db.GetDBFromContext(c).Model(&post.PostTerm{}).
+		Select("post_terms.post_id, post_terms.term, post_terms.weight").
+		Joins("JOIN posts ON posts.id = post_terms.post_id AND posts.status = ? AND posts.visibility <> ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted).
+		Scopes(base.NotDeletedIn("posts")).
+		Where("post_terms.term IN ? AND post_terms.post_id <> ?", terms, excludeID).
+		Find(&matched).Error; err != nil {
+		return nil, err
+	}
+	return matched, nil
+}
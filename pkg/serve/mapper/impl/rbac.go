@@ -6,6 +6,7 @@ package impl
 import (
 	"github.com/cloudwego/hertz/pkg/app"
 
+	"github.com/Done-0/jank/internal/model/base"
 	"github.com/Done-0/jank/internal/model/rbac"
 	"github.com/Done-0/jank/internal/utils/db"
 	"github.com/Done-0/jank/pkg/serve/mapper"
@@ -22,7 +23,7 @@ func NewRBACMapper() mapper.RBACMapper {
 // CreatePermission 创建权限
 func (m *RBACMapperImpl) CreatePermission(c *app.RequestContext, name, description, role, resource, action string) (*rbac.Policy, error) {
 	var count int64
-	if err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Where("ptype = ? AND v0 = ? AND v1 = ? AND v2 = ? AND deleted = ?", "p", role, resource, action, false).Count(&count).Error; err != nil {
+	if err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ? AND v1 = ? AND v2 = ?", "p", role, resource, action).Count(&count).Error; err != nil {
 		return nil, err
 	}
 	if count > 0 {
@@ -47,7 +48,7 @@ func (m *RBACMapperImpl) CreatePermission(c *app.RequestContext, name, descripti
 
 // DeletePermission 删除权限（软删除）
 func (m *RBACMapperImpl) DeletePermission(c *app.RequestContext, role, resource, action string) (bool, error) {
-	result := db.GetDBFromContext(c).Model(&rbac.Policy{}).Where("ptype = ? AND v0 = ? AND v1 = ? AND v2 = ? AND deleted = ?", "p", role, resource, action, false).Update("deleted", true)
+	result := db.GetDBFromContext(c).Model(&rbac.Policy{}).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ? AND v1 = ? AND v2 = ?", "p", role, resource, action).Updates(base.SoftDeleteColumns(nil))
 	if result.Error != nil {
 		return false, result.Error
 	}
@@ -59,7 +60,8 @@ func (m *RBACMapperImpl) DeletePermission(c *app.RequestContext, role, resource,
 func (m *RBACMapperImpl) ListPermissions(c *app.RequestContext) ([]*rbac.Policy, error) {
 	var policies []*rbac.Policy
 	err := db.GetDBFromContext(c).
-		Where("ptype = ? AND deleted = ?", "p", false).
+		Scopes(base.NotDeleted).
+		Where("ptype = ?", "p").
 		Order("id DESC").
 		Find(&policies).Error
 
@@ -84,7 +86,7 @@ func (m *RBACMapperImpl) ListPermissions(c *app.RequestContext) ([]*rbac.Policy,
 // PermissionExists 检查权限是否存在
 func (m *RBACMapperImpl) PermissionExists(c *app.RequestContext, resource, action string) (bool, error) {
 	var count int64
-	err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Where("ptype = ? AND v1 = ? AND v2 = ? AND deleted = ?", "p", resource, action, false).Count(&count).Error
+	err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Scopes(base.NotDeleted).Where("ptype = ? AND v1 = ? AND v2 = ?", "p", resource, action).Count(&count).Error
 	return count > 0, err
 }
 
@@ -92,7 +94,8 @@ func (m *RBACMapperImpl) PermissionExists(c *app.RequestContext, resource, actio
 func (m *RBACMapperImpl) ListRoles(c *app.RequestContext) ([]*rbac.Policy, error) {
 	var policies []*rbac.Policy
 	err := db.GetDBFromContext(c).
-		Where("ptype = ? AND deleted = ?", "p", false).
+		Scopes(base.NotDeleted).
+		Where("ptype = ?", "p").
 		Order("id DESC").
 		Find(&policies).Error
 
@@ -116,21 +119,21 @@ func (m *RBACMapperImpl) ListRoles(c *app.RequestContext) ([]*rbac.Policy, error
 // RoleExists 检查角色是否存在
 func (m *RBACMapperImpl) RoleExists(c *app.RequestContext, role string) (bool, error) {
 	var count int64
-	err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Where("ptype = ? AND v0 = ? AND deleted = ?", "p", role, false).Count(&count).Error
+	err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ?", "p", role).Count(&count).Error
 	return count > 0, err
 }
 
 // GetRolePermissions 获取角色权限
 func (m *RBACMapperImpl) GetRolePermissions(c *app.RequestContext, role string) ([]*rbac.Policy, error) {
 	var policies []*rbac.Policy
-	err := db.GetDBFromContext(c).Where("ptype = ? AND v0 = ? AND deleted = ?", "p", role, false).Order("id DESC").Find(&policies).Error
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ?", "p", role).Order("id DESC").Find(&policies).Error
 	return policies, err
 }
 
 // AssignRole 分配角色
 func (m *RBACMapperImpl) AssignRole(c *app.RequestContext, user, role string) (*rbac.Policy, error) {
 	var count int64
-	if err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Where("ptype = ? AND v0 = ? AND v1 = ? AND deleted = ?", "g", user, role, false).Count(&count).Error; err != nil {
+	if err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ? AND v1 = ?", "g", user, role).Count(&count).Error; err != nil {
 		return nil, err
 	}
 	if count > 0 {
@@ -152,7 +155,7 @@ func (m *RBACMapperImpl) AssignRole(c *app.RequestContext, user, role string) (*
 
 // RevokeRole 撤销角色（软删除）
 func (m *RBACMapperImpl) RevokeRole(c *app.RequestContext, user, role string) (bool, error) {
-	result := db.GetDBFromContext(c).Model(&rbac.Policy{}).Where("ptype = ? AND v0 = ? AND v1 = ? AND deleted = ?", "g", user, role, false).Update("deleted", true)
+	result := db.GetDBFromContext(c).Model(&rbac.Policy{}).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ? AND v1 = ?", "g", user, role).Updates(base.SoftDeleteColumns(nil))
 	if result.Error != nil {
 		return false, result.Error
 	}
@@ -163,14 +166,14 @@ func (m *RBACMapperImpl) RevokeRole(c *app.RequestContext, user, role string) (b
 // GetUserRoles 获取用户角色
 func (m *RBACMapperImpl) GetUserRoles(c *app.RequestContext, user string) ([]*rbac.Policy, error) {
 	var policies []*rbac.Policy
-	err := db.GetDBFromContext(c).Where("ptype = ? AND v0 = ? AND deleted = ?", "g", user, false).Order("id DESC").Find(&policies).Error
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ?", "g", user).Order("id DESC").Find(&policies).Error
 	return policies, err
 }
 
 // UserHasRole 检查用户是否有指定角色
 func (m *RBACMapperImpl) UserHasRole(c *app.RequestContext, user, role string) (bool, error) {
 	var count int64
-	err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Where("ptype = ? AND v0 = ? AND v1 = ? AND deleted = ?", "g", user, role, false).Count(&count).Error
+	err := db.GetDBFromContext(c).Model(&rbac.Policy{}).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ? AND v1 = ?", "g", user, role).Count(&count).Error
 	return count > 0, err
 }
 
@@ -178,7 +181,8 @@ func (m *RBACMapperImpl) UserHasRole(c *app.RequestContext, user, role string) (
 func (m *RBACMapperImpl) ListUsers(c *app.RequestContext) ([]*rbac.Policy, error) {
 	var policies []*rbac.Policy
 	err := db.GetDBFromContext(c).
-		Where("ptype = ? AND deleted = ?", "g", false).
+		Scopes(base.NotDeleted).
+		Where("ptype = ?", "g").
 		Order("id DESC").
 		Find(&policies).Error
 
@@ -203,8 +207,9 @@ func (m *RBACMapperImpl) ListUsers(c *app.RequestContext) ([]*rbac.Policy, error
 func (m *RBACMapperImpl) CheckPermission(c *app.RequestContext, user, resource, action string) (bool, error) {
 	var directCount int64
 	err := db.GetDBFromContext(c).Model(&rbac.Policy{}).
-		Where("ptype = ? AND v0 = ? AND (v1 = ? OR v1 = ?) AND (v2 = ? OR v2 = ?) AND deleted = ?",
-			"p", user, resource, "*", action, "*", false).
+		Scopes(base.NotDeleted).
+		Where("ptype = ? AND v0 = ? AND (v1 = ? OR v1 = ?) AND (v2 = ? OR v2 = ?)",
+			"p", user, resource, "*", action, "*").
 		Count(&directCount).Error
 	if err != nil {
 		return false, err
@@ -214,7 +219,7 @@ func (m *RBACMapperImpl) CheckPermission(c *app.RequestContext, user, resource,
 	}
 
 	var userRoles []*rbac.Policy
-	err = db.GetDBFromContext(c).Where("ptype = ? AND v0 = ? AND deleted = ?", "g", user, false).Find(&userRoles).Error
+	err = db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("ptype = ? AND v0 = ?", "g", user).Find(&userRoles).Error
 	if err != nil {
 		return false, err
 	}
@@ -222,8 +227,9 @@ func (m *RBACMapperImpl) CheckPermission(c *app.RequestContext, user, resource,
 	for _, rolePolicy := range userRoles {
 		var roleCount int64
 		err = db.GetDBFromContext(c).Model(&rbac.Policy{}).
-			Where("ptype = ? AND v0 = ? AND (v1 = ? OR v1 = ?) AND (v2 = ? OR v2 = ?) AND deleted = ?",
-				"p", rolePolicy.V1, resource, "*", action, "*", false).
+			Scopes(base.NotDeleted).
+			Where("ptype = ? AND v0 = ? AND (v1 = ? OR v1 = ?) AND (v2 = ? OR v2 = ?)",
+				"p", rolePolicy.V1, resource, "*", action, "*").
 			Count(&roleCount).Error
 		if err != nil {
 			return false, err
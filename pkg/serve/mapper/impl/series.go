@@ -0,0 +1,205 @@
+// Package impl 提供文章系列相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// SeriesMapperImpl 文章系列数据访问实现
+type SeriesMapperImpl struct{}
+
+// NewSeriesMapper 创建文章系列数据访问实例
+func NewSeriesMapper() mapper.SeriesMapper {
+	return &SeriesMapperImpl{}
+}
+
+// GetSeriesByID 根据 ID 获取系列
+func (m *SeriesMapperImpl) GetSeriesByID(c *app.RequestContext, seriesID int64) (*series.Series, error) {
+	var s series.Series
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", seriesID).First(&s).Error
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// GetSeriesBySlug 根据 slug 获取系列
+func (m *SeriesMapperImpl) GetSeriesBySlug(c *app.RequestContext, slug string) (*series.Series, error) {
+	var s series.Series
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("slug = ?", slug).First(&s).Error
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// IsSeriesSlugTaken 判断 slug 是否已被其他系列占用，唯一索引包含已删除系列，因此不过滤 deleted
+func (m *SeriesMapperImpl) IsSeriesSlugTaken(c *app.RequestContext, slug string, excludeID int64) (bool, error) {
+	var count int64
+	if err := db.GetDBFromContext(c).Model(&series.Series{}).Where("slug = ? AND id <> ?", slug, excludeID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ListSeries 获取系列列表，按创建时间倒序
+func (m *SeriesMapperImpl) ListSeries(c *app.RequestContext, pageNo, pageSize int64) ([]*series.Series, int64, error) {
+	var list []*series.Series
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&series.Series{}).Scopes(base.NotDeleted)
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("gmt_created DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return list, total, nil
+}
+
+// CountPublishedPostsBySeriesIDs 统计系列下已发布文章数量
+func (m *SeriesMapperImpl) CountPublishedPostsBySeriesIDs(c *app.RequestContext, seriesIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(seriesIDs))
+	if len(seriesIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		SeriesID int64
+		Count    int64
+	}
+	err := db.GetDBFromContext(c).Model(&series.SeriesPost{}).
+		Select("series_posts.series_id AS series_id, COUNT(*) AS count").
+		Joins("JOIN posts ON posts.id = series_posts.post_id").
+		Scopes(base.NotDeletedIn("posts")).
+		Where("series_posts.series_id IN ? AND posts.status = ? AND posts.visibility <> ?", seriesIDs, consts.PostStatusPublished, consts.PostVisibilityUnlisted).
+		Group("series_posts.series_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.SeriesID] = row.Count
+	}
+	return counts, nil
+}
+
+// CreateSeries 创建系列
+func (m *SeriesMapperImpl) CreateSeries(c *app.RequestContext, s *series.Series) error {
+	return db.GetDBFromContext(c).Create(s).Error
+}
+
+// UpdateSeries 更新系列
+func (m *SeriesMapperImpl) UpdateSeries(c *app.RequestContext, s *series.Series) error {
+	return db.GetDBFromContext(c).Save(s).Error
+}
+
+// DeleteSeries 删除系列（软删除，同时解除与文章的关联）
+func (m *SeriesMapperImpl) DeleteSeries(c *app.RequestContext, seriesID int64) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", seriesID).Delete(&series.SeriesPost{}).Error; err != nil {
+			return fmt.Errorf("failed to clear series post references: %w", err)
+		}
+
+		if err := tx.Model(&series.Series{}).Scopes(base.NotDeleted).Where("id = ?", seriesID).Updates(base.SoftDeleteColumns(nil)).Error; err != nil {
+			return fmt.Errorf("failed to delete series: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetSeriesPostByPostID 获取文章所属系列关联
+func (m *SeriesMapperImpl) GetSeriesPostByPostID(c *app.RequestContext, postID int64) (*series.SeriesPost, error) {
+	var sp series.SeriesPost
+	if err := db.GetDBFromContext(c).Where("post_id = ?", postID).First(&sp).Error; err != nil {
+		return nil, err
+	}
+	return &sp, nil
+}
+
+// ListSeriesPostsByPostIDs 批量获取文章所属系列关联
+func (m *SeriesMapperImpl) ListSeriesPostsByPostIDs(c *app.RequestContext, postIDs []int64) ([]*series.SeriesPost, error) {
+	var seriesPosts []*series.SeriesPost
+	if len(postIDs) == 0 {
+		return seriesPosts, nil
+	}
+
+	if err := db.GetDBFromContext(c).Where("post_id IN ?", postIDs).Find(&seriesPosts).Error; err != nil {
+		return nil, err
+	}
+	return seriesPosts, nil
+}
+
+// ListSeriesPostIDs 获取系列内全部文章 ID（包括已删除文章），按顺序排列
+func (m *SeriesMapperImpl) ListSeriesPostIDs(c *app.RequestContext, seriesID int64) ([]int64, error) {
+	var postIDs []int64
+	if err := db.GetDBFromContext(c).Model(&series.SeriesPost{}).
+		Where("series_id = ?", seriesID).
+		Order("position ASC").
+		Pluck("post_id", &postIDs).Error; err != nil {
+		return nil, err
+	}
+	return postIDs, nil
+}
+
+// ListSeriesPosts 获取系列内未删除的文章，按顺序排列，publishedOnly 为 true 时仅返回已发布且非仅链接可见的文章
+func (m *SeriesMapperImpl) ListSeriesPosts(c *app.RequestContext, seriesID int64, publishedOnly bool) ([]*post.Post, error) {
+	var posts []*post.Post
+
+	query := db.GetDBFromContext(c).Model(&post.Post{}).
+		Select("posts.*").
+		Joins("JOIN series_posts ON series_posts.post_id = posts.id").
+		Scopes(base.NotDeleted).
+		Where("series_posts.series_id = ?", seriesID)
+	if publishedOnly {
+		query = query.Where("posts.status = ? AND posts.visibility <> ?", consts.PostStatusPublished, consts.PostVisibilityUnlisted)
+	}
+
+	if err := query.Order("series_posts.position ASC").Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// SetSeriesPosts 设置系列文章及顺序（覆盖原有关联），顺序按 postIDs 从 1 开始编号
+func (m *SeriesMapperImpl) SetSeriesPosts(c *app.RequestContext, seriesID int64, postIDs []int64) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", seriesID).Delete(&series.SeriesPost{}).Error; err != nil {
+			return fmt.Errorf("failed to clear series posts: %w", err)
+		}
+
+		if len(postIDs) == 0 {
+			return nil
+		}
+
+		seriesPosts := make([]*series.SeriesPost, 0, len(postIDs))
+		for i, postID := range postIDs {
+			seriesPosts = append(seriesPosts, &series.SeriesPost{PostID: postID, SeriesID: seriesID, Position: int64(i + 1)})
+		}
+		if err := tx.Create(&seriesPosts).Error; err != nil {
+			return fmt.Errorf("failed to create series posts: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,43 @@
+// Package impl 提供 slug 历史相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/slug"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// SlugHistoryMapperImpl slug 历史数据访问实现
+type SlugHistoryMapperImpl struct{}
+
+// NewSlugHistoryMapper 创建 slug 历史数据访问实例
+func NewSlugHistoryMapper() mapper.SlugHistoryMapper {
+	return &SlugHistoryMapperImpl{}
+}
+
+// GetSlugHistory 根据实体类型与曾用 slug 获取历史记录
+func (m *SlugHistoryMapperImpl) GetSlugHistory(c *app.RequestContext, entityType, s string) (*slug.SlugHistory, error) {
+	var h slug.SlugHistory
+	err := db.GetDBFromContext(c).Where("entity_type = ? AND slug = ?", entityType, s).First(&h).Error
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// SaveSlugHistory 记录曾用 slug，同一实体类型下同名旧记录会被覆盖
+func (m *SlugHistoryMapperImpl) SaveSlugHistory(c *app.RequestContext, entityType string, entityID int64, s string) error {
+	if err := m.DeleteSlugHistory(c, entityType, s); err != nil {
+		return err
+	}
+	return db.GetDBFromContext(c).Create(&slug.SlugHistory{EntityType: entityType, EntityID: entityID, Slug: s}).Error
+}
+
+// DeleteSlugHistory 删除曾用 slug 记录（物理删除）
+func (m *SlugHistoryMapperImpl) DeleteSlugHistory(c *app.RequestContext, entityType, s string) error {
+	return db.GetDBFromContext(c).Where("entity_type = ? AND slug = ?", entityType, s).Delete(&slug.SlugHistory{}).Error
+}
@@ -0,0 +1,203 @@
+// Package impl 提供标签相关的数据访问实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+)
+
+// TagMapperImpl 标签数据访问实现
+type TagMapperImpl struct{}
+
+// NewTagMapper 创建标签数据访问实例
+func NewTagMapper() mapper.TagMapper {
+	return &TagMapperImpl{}
+}
+
+// GetTagByID 根据 ID 获取标签
+func (m *TagMapperImpl) GetTagByID(c *app.RequestContext, tagID int64) (*tag.Tag, error) {
+	var t tag.Tag
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", tagID).First(&t).Error
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// GetTagByName 根据名称获取标签
+func (m *TagMapperImpl) GetTagByName(c *app.RequestContext, name string) (*tag.Tag, error) {
+	var t tag.Tag
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("name = ?", name).First(&t).Error
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// GetTagsByIDs 批量获取标签
+func (m *TagMapperImpl) GetTagsByIDs(c *app.RequestContext, tagIDs []int64) ([]*tag.Tag, error) {
+	var tags []*tag.Tag
+	if len(tagIDs) == 0 {
+		return tags, nil
+	}
+
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id IN ?", tagIDs).Order("id ASC").Find(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ListTags 获取标签列表，keyword 为空时不按名称筛选
+func (m *TagMapperImpl) ListTags(c *app.RequestContext, pageNo, pageSize int64, keyword string) ([]*tag.Tag, int64, error) {
+	var tags []*tag.Tag
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&tag.Tag{}).Scopes(base.NotDeleted)
+	if keyword != "" {
+		query = query.Where("name LIKE ?", "%"+strings.TrimSpace(keyword)+"%")
+	}
+
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.Order("id ASC").Offset(int(offset)).Limit(int(pageSize)).Find(&tags).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return tags, total, nil
+}
+
+// CountPublishedPostsByTagIDs 统计标签下已发布文章数量
+func (m *TagMapperImpl) CountPublishedPostsByTagIDs(c *app.RequestContext, tagIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(tagIDs))
+	if len(tagIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		TagID int64
+		Count int64
+	}
+	err := db.GetDBFromContext(c).Model(&tag.PostTag{}).
+		Select("post_tags.tag_id AS tag_id, COUNT(*) AS count").
+		Joins("JOIN posts ON posts.id = post_tags.post_id").
+		Scopes(base.NotDeletedIn("posts")).
+		Where("post_tags.tag_id IN ? AND posts.status = ? AND posts.visibility <> ?", tagIDs, consts.PostStatusPublished, consts.PostVisibilityUnlisted).
+		Group("post_tags.tag_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.TagID] = row.Count
+	}
+	return counts, nil
+}
+
+// CreateTag 创建标签
+func (m *TagMapperImpl) CreateTag(c *app.RequestContext, t *tag.Tag) error {
+	return db.GetDBFromContext(c).Create(t).Error
+}
+
+// UpdateTag 更新标签
+func (m *TagMapperImpl) UpdateTag(c *app.RequestContext, t *tag.Tag) error {
+	return db.GetDBFromContext(c).Save(t).Error
+}
+
+// DeleteTag 删除标签（软删除，同时解除与文章的关联），名称暂存到扩展字段并替换为占位值，以便重新创建同名标签
+func (m *TagMapperImpl) DeleteTag(c *app.RequestContext, tagID int64) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", tagID).Delete(&tag.PostTag{}).Error; err != nil {
+			return fmt.Errorf("failed to clear post tag references: %w", err)
+		}
+
+		var t tag.Tag
+		if err := tx.Scopes(base.NotDeleted).Where("id = ?", tagID).First(&t).Error; err != nil {
+			return err
+		}
+		released := base.ReleasedColumn{Name: "name", Value: t.Name, Placeholder: fmt.Sprintf(consts.TagTrashedNameFormat, t.ID)}
+		if err := tx.Model(&tag.Tag{}).Scopes(base.NotDeleted).Where("id = ?", tagID).Updates(base.SoftDeleteColumns(t.Ext, released)).Error; err != nil {
+			return fmt.Errorf("failed to delete tag: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// SetPostTags 设置文章标签（覆盖原有关联）
+func (m *TagMapperImpl) SetPostTags(c *app.RequestContext, postID int64, tagIDs []int64) error {
+	return db.GetDBFromContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("post_id = ?", postID).Delete(&tag.PostTag{}).Error; err != nil {
+			return fmt.Errorf("failed to clear post tags: %w", err)
+		}
+
+		if len(tagIDs) == 0 {
+			return nil
+		}
+
+		postTags := make([]*tag.PostTag, 0, len(tagIDs))
+		for _, tagID := range tagIDs {
+			postTags = append(postTags, &tag.PostTag{PostID: postID, TagID: tagID})
+		}
+		if err := tx.Create(&postTags).Error; err != nil {
+			return fmt.Errorf("failed to create post tags: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ListTagsByPostIDs 批量获取文章标签
+func (m *TagMapperImpl) ListTagsByPostIDs(c *app.RequestContext, postIDs []int64) (map[int64][]*tag.Tag, error) {
+	result := make(map[int64][]*tag.Tag, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var postTags []*tag.PostTag
+	if err := db.GetDBFromContext(c).Where("post_id IN ?", postIDs).Find(&postTags).Error; err != nil {
+		return nil, err
+	}
+	if len(postTags) == 0 {
+		return result, nil
+	}
+
+	tagIDs := make([]int64, 0, len(postTags))
+	for _, pt := range postTags {
+		tagIDs = append(tagIDs, pt.TagID)
+	}
+
+	tags, err := m.GetTagsByIDs(c, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tagMap := make(map[int64]*tag.Tag, len(tags))
+	for _, t := range tags {
+		tagMap[t.ID] = t
+	}
+
+	for _, pt := range postTags {
+		if t, ok := tagMap[pt.TagID]; ok {
+			result[pt.PostID] = append(result[pt.PostID], t)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+// Package impl 多语言筛选查询辅助函数
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// translationAlias 语言回退子查询中同组其他版本所在表的别名
+const translationAlias = "t"
+
+// localeFallback 按语言回退筛选：仅保留语言在 locales 中的记录，同一翻译组内存在语言更靠前且满足 visible 条件的版本时隐藏当前记录
+// 即每个翻译组只返回按 locales 顺序最优先的可见版本，未翻译到 locales 中任一语言的翻译组不返回
+// visible 为其他版本须满足的条件（如已发布、未删除），作用于别名为 t 的子查询
+func localeFallback(query *gorm.DB, table string, locales []string, visible func(*gorm.DB) *gorm.DB) *gorm.DB {
+	if len(locales) == 0 {
+		return query
+	}
+
+	query = query.Where(table+".locale IN ?", locales)
+	if len(locales) == 1 {
+		return query
+	}
+
+	// 同组版本的语言优先级高于当前记录，即优先级序号更小
+	inner, outer := localeRank(translationAlias, locales), localeRank(table, locales)
+	preferred := query.Session(&gorm.Session{NewDB: true}).
+		Table(table + " AS " + translationAlias).
+		Select("1").
+		Where(translationAlias + ".translation_group_id = " + table + ".translation_group_id AND " + translationAlias + ".id <> " + table + ".id").
+		Where(clause.Expr{SQL: inner.SQL + " < " + outer.SQL, Vars: append(inner.Vars, outer.Vars...)})
+	return query.Where("NOT EXISTS (?)", visible(preferred))
+}
+
+// localeRank 构建语言优先级序号表达式 CASE locale WHEN ? THEN 0 ... END，序号越小越优先
+func localeRank(table string, locales []string) clause.Expr {
+	var sql strings.Builder
+	sql.WriteString("CASE " + table + ".locale")
+	vars := make([]any, 0, len(locales))
+	for i, l := range locales {
+		// 序号直接写入 SQL，作为参数传入时 PostgreSQL 无法推断类型
+		sql.WriteString(" WHEN ? THEN " + strconv.Itoa(i))
+		vars = append(vars, l)
+	}
+	sql.WriteString(" END")
+	return clause.Expr{SQL: sql.String(), Vars: vars}
+}
@@ -4,11 +4,16 @@
 package impl
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
 
+	"github.com/Done-0/jank/internal/model/base"
 	"github.com/Done-0/jank/internal/model/user"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/cursor"
 	"github.com/Done-0/jank/internal/utils/db"
 	"github.com/Done-0/jank/pkg/serve/mapper"
 )
@@ -24,7 +29,7 @@ func NewUserMapper() mapper.UserMapper {
 // GetUserByEmail 根据邮箱获取用户
 func (m *UserMapperImpl) GetUserByEmail(c *app.RequestContext, email string) (*user.User, error) {
 	var u user.User
-	err := db.GetDBFromContext(c).Where("email = ? AND deleted = ?", email, false).First(&u).Error
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("email = ?", email).First(&u).Error
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +39,7 @@ func (m *UserMapperImpl) GetUserByEmail(c *app.RequestContext, email string) (*u
 // GetUserByID 根据ID获取用户
 func (m *UserMapperImpl) GetUserByID(c *app.RequestContext, userID int64) (*user.User, error) {
 	var u user.User
-	err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", userID, false).First(&u).Error
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", userID).First(&u).Error
 	if err != nil {
 		return nil, err
 	}
@@ -44,24 +49,38 @@ func (m *UserMapperImpl) GetUserByID(c *app.RequestContext, userID int64) (*user
 // GetUserByNickname 根据昵称获取用户
 func (m *UserMapperImpl) GetUserByNickname(c *app.RequestContext, nickname string) (*user.User, error) {
 	var u user.User
-	err := db.GetDBFromContext(c).Where("nickname = ? AND deleted = ?", nickname, false).First(&u).Error
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("nickname = ?", nickname).First(&u).Error
 	if err != nil {
 		return nil, err
 	}
 	return &u, nil
 }
 
+// GetUsersByIDs 批量获取用户
+func (m *UserMapperImpl) GetUsersByIDs(c *app.RequestContext, userIDs []int64) ([]*user.User, error) {
+	var users []*user.User
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+
+	err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id IN ?", userIDs).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 // RegisterUser 注册用户（包含重复检查和事务处理）
 func (m *UserMapperImpl) RegisterUser(c *app.RequestContext, u *user.User) error {
 	_, err := db.RunDBTransaction(c, func() (any, error) {
 		// 检查邮箱是否已被注册
 		var existingUser user.User
-		if err := db.GetDBFromContext(c).Where("email = ? AND deleted = ?", u.Email, false).First(&existingUser).Error; err == nil {
+		if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("email = ?", u.Email).First(&existingUser).Error; err == nil {
 			return nil, err
 		}
 
 		// 检查昵称是否已被使用
-		if err := db.GetDBFromContext(c).Where("nickname = ? AND deleted = ?", u.Nickname, false).First(&existingUser).Error; err == nil {
+		if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("nickname = ?", u.Nickname).First(&existingUser).Error; err == nil {
 			return nil, err
 		}
 
@@ -76,7 +95,7 @@ func (m *UserMapperImpl) RegisterUser(c *app.RequestContext, u *user.User) error
 
 // UpdateUser 更新用户信息
 func (m *UserMapperImpl) UpdateUser(c *app.RequestContext, u *user.User) error {
-	if err := db.GetDBFromContext(c).Where("id = ? AND deleted = ?", u.ID, false).Updates(u).Error; err != nil {
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", u.ID).Updates(u).Error; err != nil {
 		return err
 	}
 	return nil
@@ -87,7 +106,54 @@ func (m *UserMapperImpl) ListUsers(c *app.RequestContext, pageNo, pageSize int64
 	var users []*user.User
 	var total int64
 
-	query := db.GetDBFromContext(c).Model(&user.User{}).Where("deleted = ?", false)
+	query := usersQuery(c, keyword, role)
+
+	// 计算总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 查询记录 - 分页查询
+	offset := (pageNo - 1) * pageSize
+	if err := query.
+		Order("id DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// ListUsersWithCursor 按游标获取用户列表，按 ID 倒序，withTotal 为 false 时不统计总数
+func (m *UserMapperImpl) ListUsersWithCursor(c *app.RequestContext, cur *cursor.Cursor, limit int64, withTotal bool, keyword, role string) (*cursor.Page[*user.User], int64, error) {
+	var users []*user.User
+	var total int64
+
+	query := usersQuery(c, keyword, role)
+	if withTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+
+	query, err := keysetPage(query, []keysetColumn{{SQL: "id", Desc: true}}, cur, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := query.Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return cursor.Paginate(users, limit, cur, func(u *user.User) []int64 {
+		return []int64{u.ID}
+	}), total, nil
+}
+
+// usersQuery 构建用户列表的筛选条件
+func usersQuery(c *app.RequestContext, keyword, role string) *gorm.DB {
+	query := db.GetDBFromContext(c).Model(&user.User{}).Scopes(base.NotDeleted)
 
 	// 关键词搜索
 	if keyword != "" {
@@ -100,28 +166,68 @@ func (m *UserMapperImpl) ListUsers(c *app.RequestContext, pageNo, pageSize int64
 		query = query.Where("role = ?", role)
 	}
 
-	// 计算总数
+	return query
+}
+
+// DeleteUser 将用户移入回收站，邮箱与昵称暂存到扩展字段并替换为占位值，以便重新注册
+func (m *UserMapperImpl) DeleteUser(c *app.RequestContext, userID int64) error {
+	var u user.User
+	if err := db.GetDBFromContext(c).Scopes(base.NotDeleted).Where("id = ?", userID).First(&u).Error; err != nil {
+		return err
+	}
+
+	return db.GetDBFromContext(c).Model(&user.User{}).
+		Scopes(base.NotDeleted).
+		Where("id = ?", userID).
+		Updates(base.SoftDeleteColumns(u.Ext,
+			base.ReleasedColumn{Name: "email", Value: u.Email, Placeholder: fmt.Sprintf(consts.UserTrashedEmailFormat, u.ID)},
+			base.ReleasedColumn{Name: "nickname", Value: u.Nickname, Placeholder: fmt.Sprintf(consts.UserTrashedNicknameFormat, u.ID)},
+		)).Error
+}
+
+// PurgeUser 永久删除用户，仅用于回滚注册失败的用户
+func (m *UserMapperImpl) PurgeUser(c *app.RequestContext, userID int64) error {
+	return db.GetDBFromContext(c).Where("id = ?", userID).Delete(&user.User{}).Error
+}
+
+// GetDeletedUserByID 根据 ID 获取回收站中的用户
+func (m *UserMapperImpl) GetDeletedUserByID(c *app.RequestContext, userID int64) (*user.User, error) {
+	var u user.User
+	if err := db.GetDBFromContext(c).Scopes(base.OnlyDeleted).Where("id = ?", userID).First(&u).Error; err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// ListDeletedUsers 获取回收站中的用户，按删除时间倒序
+func (m *UserMapperImpl) ListDeletedUsers(c *app.RequestContext, pageNo, pageSize int64) ([]*user.User, int64, error) {
+	var users []*user.User
+	var total int64
+
+	query := db.GetDBFromContext(c).Model(&user.User{}).Scopes(base.OnlyDeleted)
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// 查询记录 - 分页查询
 	offset := (pageNo - 1) * pageSize
-	if err := query.
-		Order("id DESC").
-		Offset(int(offset)).
-		Limit(int(pageSize)).
-		Find(&users).Error; err != nil {
+	if err := query.Order("gmt_deleted DESC, id DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&users).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return users, total, nil
 }
 
-// DeleteUser 删除用户
-func (m *UserMapperImpl) DeleteUser(c *app.RequestContext, userID string) error {
-	if err := db.GetDBFromContext(c).Where("id = ?", userID).Delete(&user.User{}).Error; err != nil {
-		return err
+// RestoreUser 从回收站恢复用户，写回原邮箱与昵称
+func (m *UserMapperImpl) RestoreUser(c *app.RequestContext, u *user.User, email, nickname string) error {
+	result := db.GetDBFromContext(c).Model(&user.User{}).
+		Scopes(base.OnlyDeleted).
+		Where("id = ?", u.ID).
+		Updates(base.RestoreColumns(u.Ext, map[string]any{"email": email, "nickname": nickname}))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
 	return nil
 }
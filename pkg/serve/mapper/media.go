@@ -0,0 +1,21 @@
+// Package mapper 提供媒体文件相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/media"
+)
+
+// MediaMapper 媒体文件数据访问接口
+type MediaMapper interface {
+	GetMediaByID(c *app.RequestContext, mediaID int64) (*media.Media, error)                                   // 根据 ID 获取媒体文件
+	GetMediaByHash(c *app.RequestContext, hash string) (*media.Media, error)                                   // 根据内容哈希获取任一用户上传的媒体文件，用于复用存储文件
+	GetUploaderMediaByHash(c *app.RequestContext, uploaderID int64, hash string) (*media.Media, error)         // 根据内容哈希获取指定用户上传的媒体文件
+	ListMedia(c *app.RequestContext, pageNo, pageSize int64, uploaderID *int64) ([]*media.Media, int64, error) // 获取媒体文件列表，uploaderID 为空时获取所有用户上传的文件
+	CountMediaByStorageKey(c *app.RequestContext, storage, storageKey string) (int64, error)                   // 统计引用同一存储文件的媒体记录数量
+	CreateMedia(c *app.RequestContext, m *media.Media) error                                                   // 创建媒体文件记录
+	DeleteMedia(c *app.RequestContext, mediaID int64) error                                                    // 删除媒体文件记录（软删除）
+}
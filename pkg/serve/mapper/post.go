@@ -4,18 +4,43 @@
 package mapper
 
 import (
+	"errors"
+
 	"github.com/cloudwego/hertz/pkg/app"
 
 	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/utils/cursor"
 )
 
+// ErrPostVersionConflict 文章在读取后已被他人修改，版本号与读取时不一致
+var ErrPostVersionConflict = errors.New("post version conflict")
+
 // PostMapper 文章数据访问接口
 type PostMapper interface {
-	GetPostByID(c *app.RequestContext, postID int64) (*post.Post, error)                                                            // 根据 ID 获取文章
-	ListPublishedPosts(c *app.RequestContext, pageNo, pageSize int64, categoryID *int64) ([]*post.Post, int64, error)               // 获取已发布文章列表，categoryID为空时不按分类筛选
-	ListPostsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, categoryID *int64) ([]*post.Post, int64, error) // 根据状态获取文章列表，status为空时获取所有文章，categoryID为空时不按分类筛选
-	ListPublicPosts(c *app.RequestContext, pageNo, pageSize int64) ([]*post.Post, int64, error)                                     // 获取公开文章（已发布+已归档）
-	CreatePost(c *app.RequestContext, post *post.Post) error                                                                        // 创建文章
-	UpdatePost(c *app.RequestContext, post *post.Post) error                                                                        // 更新文章
-	DeletePost(c *app.RequestContext, postID int64) error                                                                           // 删除文章
+	GetPostBySlug(c *app.RequestContext, slug string) (*post.Post, error)                                                                                                                              // 根据 slug 获取文章
+	IsPostSlugTaken(c *app.RequestContext, slug string, excludeID int64) (bool, error)                                                                                                                 // 判断 slug 是否已被其他文章占用（含已删除文章）
+	GetPostByID(c *app.RequestContext, postID int64) (*post.Post, error)                                                                                                                               // 根据 ID 获取文章
+	GetPostsByIDs(c *app.RequestContext, postIDs []int64) ([]*post.Post, error)                                                                                                                        // 批量获取文章
+	GetPostsByIDsWithDeleted(c *app.RequestContext, postIDs []int64) ([]*post.Post, error)                                                                                                             // 批量获取文章（含已删除文章）
+	ListPublishedPosts(c *app.RequestContext, pageNo, pageSize int64, categoryIDs []int64, tagID *int64, locales []string) ([]*post.Post, int64, error)                                                // 获取已发布文章列表（不含仅链接可见文章），置顶文章排在最前，categoryIDs为空时不按分类筛选，tagID为空时不按标签筛选，locales不为空时每个翻译组只返回最优先语言的版本
+	ListPublishedPostsWithCursor(c *app.RequestContext, cur *cursor.Cursor, limit int64, withTotal bool, categoryIDs []int64, tagID *int64, locales []string) (*cursor.Page[*post.Post], int64, error) // 按游标获取已发布文章列表，排序同上，withTotal为false时不统计总数
+	ListPostsByStatus(c *app.RequestContext, pageNo, pageSize int64, status string, categoryIDs []int64, locale string) ([]*post.Post, int64, error)                                                   // 根据状态获取文章列表，status为空时获取所有文章，categoryIDs为空时不按分类筛选，locale为空时不按语言筛选
+	ListPostsByStatusWithCursor(c *app.RequestContext, cur *cursor.Cursor, limit int64, withTotal bool, status string, categoryIDs []int64, locale string) (*cursor.Page[*post.Post], int64, error)    // 按游标根据状态获取文章列表，按ID倒序，withTotal为false时不统计总数
+	ListPublishedPostsByAuthor(c *app.RequestContext, pageNo, pageSize, authorID int64) ([]*post.Post, int64, error)                                                                                   // 获取指定作者的已发布文章列表
+	ListPublicPosts(c *app.RequestContext, pageNo, pageSize int64) ([]*post.Post, int64, error)                                                                                                        // 获取公开文章（已发布+已归档）
+	ListFeaturedPosts(c *app.RequestContext, pageNo, pageSize int64) ([]*post.Post, int64, error)                                                                                                      // 获取已发布的精选文章列表，按精选权重倒序
+	SearchPublishedPosts(c *app.RequestContext, keyword string, pageNo, pageSize int64) ([]*post.Post, int64, error)                                                                                   // 全文检索已发布的公开文章，按相关度排序
+	CountPublishedPosts(c *app.RequestContext) (int64, error)                                                                                                                                          // 统计已发布文章数量
+	ListPublishedPostTimestamps(c *app.RequestContext, offset, limit int64) ([]*post.Post, error)                                                                                                      // 获取已发布文章的 ID 与修改时间，用于生成站点地图
+	ListPublishedPostIDsByCategory(c *app.RequestContext, categoryID, excludeID, limit int64) ([]int64, error)                                                                                         // 获取同分类的已发布文章 ID，按 ID 倒序
+	ListPublishedPostIDsByTags(c *app.RequestContext, tagIDs []int64, excludeID, limit int64) ([]int64, error)                                                                                         // 获取含任一标签的已发布文章 ID，按共有标签数倒序
+	ListPostTranslations(c *app.RequestContext, groupID int64) ([]*post.Post, error)                                                                                                                   // 获取翻译组内的全部文章，按ID正序
+	CreatePost(c *app.RequestContext, post *post.Post) error                                                                                                                                           // 创建文章
+	UpdatePost(c *app.RequestContext, post *post.Post) error                                                                                                                                           // 更新文章，版本号与读取时不一致时返回 ErrPostVersionConflict
+	UpdatePostPin(c *app.RequestContext, postID int64, pinned bool, pinnedUntil *int64, weight int64) error                                                                                            // 设置文章置顶状态，不影响修改时间
+	UpdatePostFeature(c *app.RequestContext, postID int64, featured bool, weight int64) error                                                                                                          // 设置文章精选状态，不影响修改时间
+	DeletePost(c *app.RequestContext, postID int64) error                                                                                                                                              // 将文章移入回收站并释放 slug
+	GetDeletedPostByID(c *app.RequestContext, postID int64) (*post.Post, error)                                                                                                                        // 根据 ID 获取回收站中的文章
+	ListDeletedPosts(c *app.RequestContext, pageNo, pageSize int64, authorID *int64) ([]*post.Post, int64, error)                                                                                      // 获取回收站中的文章，按删除时间倒序，authorID 不为空时仅返回该作者的文章
+	RestorePost(c *app.RequestContext, post *post.Post, slug string) error                                                                                                                             // 从回收站恢复文章，slug 为空时保持原值
 }
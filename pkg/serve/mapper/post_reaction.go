@@ -0,0 +1,20 @@
+// Package mapper 提供文章互动表态相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+)
+
+// PostReactionMapper 文章表态数据访问接口
+type PostReactionMapper interface {
+	CreatePostReaction(c *app.RequestContext, reaction *post.PostReaction) (bool, error)                                            // 创建表态，已存在时返回 false
+	DeletePostReaction(c *app.RequestContext, postID, userID int64, reactionType string) (bool, error)                              // 删除表态，不存在时返回 false
+	IncrReactionCount(c *app.RequestContext, postID int64, reactionType string) error                                               // 表态数量加一
+	DecrReactionCount(c *app.RequestContext, postID int64, reactionType string) error                                               // 表态数量减一，不会小于 0
+	ListReactionCounts(c *app.RequestContext, postID int64) ([]*post.PostReactionCount, error)                                      // 获取文章各类表态数量
+	ListReactedPosts(c *app.RequestContext, userID int64, reactionType string, pageNo, pageSize int64) ([]*post.Post, int64, error) // 获取用户表态过的已发布文章，按最近表态时间倒序
+}
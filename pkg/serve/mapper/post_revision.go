@@ -0,0 +1,18 @@
+// Package mapper 提供文章修订历史相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+)
+
+// PostRevisionMapper 文章修订数据访问接口
+type PostRevisionMapper interface {
+	GetPostRevisionByID(c *app.RequestContext, revisionID int64) (*post.PostRevision, error)                      // 根据 ID 获取修订
+	ListPostRevisions(c *app.RequestContext, postID, pageNo, pageSize int64) ([]*post.PostRevision, int64, error) // 获取文章修订列表，按时间倒序
+	GetLatestPostRevision(c *app.RequestContext, postID int64) (*post.PostRevision, error)                        // 获取文章最新的修订
+	CreatePostRevision(c *app.RequestContext, revision *post.PostRevision) error                                  // 创建修订
+}
@@ -0,0 +1,30 @@
+// Package mapper 提供文章浏览统计相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+)
+
+// PostViews 文章浏览量汇总
+type PostViews struct {
+	PostID int64 // 文章 ID
+	Views  int64 // 浏览量
+}
+
+// ReferrerViews 来源浏览量汇总
+type ReferrerViews struct {
+	Referrer string // 来源域名
+	Views    int64  // 浏览量
+}
+
+// PostStatMapper 文章浏览统计数据访问接口，日期格式均为 2006-01-02，时间范围包含首尾
+type PostStatMapper interface {
+	ListDailyPostStats(c *app.RequestContext, postID int64, startDate, endDate string) ([]*post.PostStat, error)          // 获取文章每日浏览量，按日期升序
+	ListTopPosts(c *app.RequestContext, startDate, endDate string, limit int64) ([]*PostViews, error)                     // 获取时间范围内浏览量最高的文章，不含已删除文章
+	ListReferrers(c *app.RequestContext, postID *int64, startDate, endDate string, limit int64) ([]*ReferrerViews, error) // 获取来源浏览量排行，postID 为空时统计全站
+	GetTotalViewsByPostIDs(c *app.RequestContext, postIDs []int64) (map[int64]int64, error)                               // 批量获取文章累计浏览量
+}
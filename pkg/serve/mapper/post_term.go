@@ -0,0 +1,19 @@
+// Package mapper 提供文章关键词索引相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+)
+
+// PostTermMapper 文章关键词数据访问接口
+type PostTermMapper interface {
+	ReplacePostTerms(c *app.RequestContext, postID int64, terms []*post.PostTerm) error                  // 重建文章关键词并标记文章已建立索引
+	ListPostTerms(c *app.RequestContext, postID int64) ([]*post.PostTerm, error)                         // 获取文章关键词
+	CountIndexedPosts(c *app.RequestContext, excludeID int64) (int64, error)                             // 统计已建立关键词索引的文章数量
+	CountTermDocuments(c *app.RequestContext, terms []string, excludeID int64) (map[string]int64, error) // 统计包含各检索词的文章数量
+	ListMatchingTerms(c *app.RequestContext, terms []string, excludeID int64) ([]*post.PostTerm, error)  // 获取其他已发布文章中与给定检索词相同的关键词
+}
@@ -0,0 +1,28 @@
+// Package mapper 提供文章系列相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/series"
+)
+
+// SeriesMapper 文章系列数据访问接口
+type SeriesMapper interface {
+	GetSeriesByID(c *app.RequestContext, seriesID int64) (*series.Series, error)                      // 根据 ID 获取系列
+	GetSeriesBySlug(c *app.RequestContext, slug string) (*series.Series, error)                       // 根据 slug 获取系列
+	IsSeriesSlugTaken(c *app.RequestContext, slug string, excludeID int64) (bool, error)              // 判断 slug 是否已被其他系列占用
+	ListSeries(c *app.RequestContext, pageNo, pageSize int64) ([]*series.Series, int64, error)        // 获取系列列表
+	CountPublishedPostsBySeriesIDs(c *app.RequestContext, seriesIDs []int64) (map[int64]int64, error) // 统计系列下已发布文章数量
+	CreateSeries(c *app.RequestContext, s *series.Series) error                                       // 创建系列
+	UpdateSeries(c *app.RequestContext, s *series.Series) error                                       // 更新系列
+	DeleteSeries(c *app.RequestContext, seriesID int64) error                                         // 删除系列（软删除，同时解除与文章的关联）
+	GetSeriesPostByPostID(c *app.RequestContext, postID int64) (*series.SeriesPost, error)            // 获取文章所属系列关联
+	ListSeriesPostsByPostIDs(c *app.RequestContext, postIDs []int64) ([]*series.SeriesPost, error)    // 批量获取文章所属系列关联
+	ListSeriesPostIDs(c *app.RequestContext, seriesID int64) ([]int64, error)                         // 获取系列内全部文章 ID，按顺序排列
+	ListSeriesPosts(c *app.RequestContext, seriesID int64, publishedOnly bool) ([]*post.Post, error)  // 获取系列内未删除的文章，按顺序排列，publishedOnly 为 true 时仅返回已发布文章
+	SetSeriesPosts(c *app.RequestContext, seriesID int64, postIDs []int64) error                      // 设置系列文章及顺序（覆盖原有关联）
+}
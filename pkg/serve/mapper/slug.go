@@ -0,0 +1,17 @@
+// Package mapper 提供 slug 历史相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/slug"
+)
+
+// SlugHistoryMapper slug 历史数据访问接口
+type SlugHistoryMapper interface {
+	GetSlugHistory(c *app.RequestContext, entityType, slug string) (*slug.SlugHistory, error)    // 根据实体类型与曾用 slug 获取历史记录
+	SaveSlugHistory(c *app.RequestContext, entityType string, entityID int64, slug string) error // 记录曾用 slug，同名旧记录会被覆盖
+	DeleteSlugHistory(c *app.RequestContext, entityType, slug string) error                      // 删除曾用 slug 记录（slug 被重新占用时调用）
+}
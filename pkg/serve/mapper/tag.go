@@ -0,0 +1,24 @@
+// Package mapper 提供标签相关的数据访问接口
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package mapper
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/tag"
+)
+
+// TagMapper 标签数据访问接口
+type TagMapper interface {
+	GetTagByID(c *app.RequestContext, tagID int64) (*tag.Tag, error)                                   // 根据 ID 获取标签
+	GetTagByName(c *app.RequestContext, name string) (*tag.Tag, error)                                 // 根据名称获取标签
+	GetTagsByIDs(c *app.RequestContext, tagIDs []int64) ([]*tag.Tag, error)                            // 批量获取标签
+	ListTags(c *app.RequestContext, pageNo, pageSize int64, keyword string) ([]*tag.Tag, int64, error) // 获取标签列表，keyword为空时不按名称筛选
+	CountPublishedPostsByTagIDs(c *app.RequestContext, tagIDs []int64) (map[int64]int64, error)        // 统计标签下已发布文章数量
+	CreateTag(c *app.RequestContext, tag *tag.Tag) error                                               // 创建标签
+	UpdateTag(c *app.RequestContext, tag *tag.Tag) error                                               // 更新标签
+	DeleteTag(c *app.RequestContext, tagID int64) error                                                // 删除标签
+	SetPostTags(c *app.RequestContext, postID int64, tagIDs []int64) error                             // 设置文章标签（覆盖原有关联）
+	ListTagsByPostIDs(c *app.RequestContext, postIDs []int64) (map[int64][]*tag.Tag, error)            // 批量获取文章标签
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cloudwego/hertz/pkg/app"
 
 	"github.com/Done-0/jank/internal/model/user"
+	"github.com/Done-0/jank/internal/utils/cursor"
 )
 
 // UserMapper 用户数据访问接口
@@ -15,11 +16,19 @@ type UserMapper interface {
 	GetUserByEmail(c *app.RequestContext, email string) (*user.User, error)       // 根据邮箱获取用户
 	GetUserByID(c *app.RequestContext, userID int64) (*user.User, error)          // 根据 ID 获取用户
 	GetUserByNickname(c *app.RequestContext, nickname string) (*user.User, error) // 根据昵称获取用户
+	GetUsersByIDs(c *app.RequestContext, userIDs []int64) ([]*user.User, error)   // 批量获取用户
 
 	RegisterUser(c *app.RequestContext, user *user.User) error // 注册用户
 	UpdateUser(c *app.RequestContext, user *user.User) error   // 更新用户信息
 
 	// 用户管理操作
-	ListUsers(c *app.RequestContext, pageNo, pageSize int64, keyword, role string) ([]*user.User, int64, error) // 获取用户列表
-	DeleteUser(c *app.RequestContext, userID string) error                                                      // 删除用户
+	ListUsers(c *app.RequestContext, pageNo, pageSize int64, keyword, role string) ([]*user.User, int64, error)                                                // 获取用户列表
+	ListUsersWithCursor(c *app.RequestContext, cur *cursor.Cursor, limit int64, withTotal bool, keyword, role string) (*cursor.Page[*user.User], int64, error) // 按游标获取用户列表，按 ID 倒序，withTotal 为 false 时不统计总数
+	DeleteUser(c *app.RequestContext, userID int64) error                                                                                                      // 将用户移入回收站，释放邮箱与昵称
+	PurgeUser(c *app.RequestContext, userID int64) error                                                                                                       // 永久删除用户，仅用于回滚注册失败的用户
+
+	// 回收站操作
+	GetDeletedUserByID(c *app.RequestContext, userID int64) (*user.User, error)                  // 根据 ID 获取回收站中的用户
+	ListDeletedUsers(c *app.RequestContext, pageNo, pageSize int64) ([]*user.User, int64, error) // 获取回收站中的用户，按删除时间倒序
+	RestoreUser(c *app.RequestContext, user *user.User, email, nickname string) error            // 从回收站恢复用户，写回原邮箱与昵称
 }
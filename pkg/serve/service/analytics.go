@@ -0,0 +1,16 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// AnalyticsService 浏览统计服务接口
+type AnalyticsService interface {
+	RecordView(c *app.RequestContext, post *vo.GetPostResponse)                                            // 记录文章浏览，按访客每日去重
+	GetPostViews(c *app.RequestContext, req *dto.GetPostViewsRequest) (*vo.GetPostViewsResponse, error)    // 获取文章每日浏览量
+	ListTopPosts(c *app.RequestContext, req *dto.ListTopPostsRequest) (*vo.ListTopPostsResponse, error)    // 获取浏览量最高的文章
+	ListReferrers(c *app.RequestContext, req *dto.ListReferrersRequest) (*vo.ListReferrersResponse, error) // 获取来源统计
+}
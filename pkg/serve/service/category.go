@@ -9,9 +9,14 @@ import (
 
 // CategoryService 分类服务接口
 type CategoryService interface {
-	GetCategory(c *app.RequestContext, req *dto.GetCategoryRequest) (*vo.GetCategoryResponse, error)          // 获取单个分类
-	ListCategories(c *app.RequestContext, req *dto.ListCategoriesRequest) (*vo.ListCategoriesResponse, error) // 获取分类列表
-	Create(c *app.RequestContext, req *dto.CreateCategoryRequest) (*vo.CreateCategoryResponse, error)         // 创建分类
-	Update(c *app.RequestContext, req *dto.UpdateCategoryRequest) (*vo.UpdateCategoryResponse, error)         // 更新分类
-	Delete(c *app.RequestContext, req *dto.DeleteCategoryRequest) (*vo.DeleteCategoryResponse, error)         // 删除分类
+	GetCategory(c *app.RequestContext, req *dto.GetCategoryRequest) (*vo.GetCategoryResponse, error)             // 获取单个分类
+	ListCategories(c *app.RequestContext, req *dto.ListCategoriesRequest) (*vo.ListCategoriesResponse, error)    // 获取分类列表
+	Create(c *app.RequestContext, req *dto.CreateCategoryRequest) (*vo.CreateCategoryResponse, error)            // 创建分类
+	Update(c *app.RequestContext, req *dto.UpdateCategoryRequest) (*vo.UpdateCategoryResponse, error)            // 更新分类
+	Delete(c *app.RequestContext, req *dto.DeleteCategoryRequest) (*vo.DeleteCategoryResponse, error)            // 删除分类
+	GetCategoryTree(c *app.RequestContext, req *dto.GetCategoryTreeRequest) (*vo.GetCategoryTreeResponse, error) // 获取带文章数量的分类树
+	Move(c *app.RequestContext, req *dto.MoveCategoryRequest) (*vo.MoveCategoryResponse, error)                  // 移动分类子树到新的父分类下
+	Merge(c *app.RequestContext, req *dto.MergeCategoryRequest) (*vo.MergeCategoryResponse, error)               // 将源分类合并到目标分类
+	ListTrash(c *app.RequestContext, req *dto.ListTrashRequest) (*vo.ListTrashedCategoriesResponse, error)       // 获取回收站中的分类
+	Restore(c *app.RequestContext, req *dto.RestoreCategoryRequest) (*vo.RestoreCategoryResponse, error)         // 从回收站恢复分类
 }
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// CommentService 评论服务接口
+type CommentService interface {
+	ListComments(c *app.RequestContext, req *dto.ListCommentsRequest) (*vo.ListCommentsResponse, error)                 // 获取文章已通过审核的评论列表
+	ListCommentsByStatus(c *app.RequestContext, req *dto.ListCommentsByStatusRequest) (*vo.ListCommentsResponse, error) // 根据状态获取评论列表（审核队列）
+	Create(c *app.RequestContext, req *dto.CreateCommentRequest) (*vo.CreateCommentResponse, error)                     // 创建评论
+	Approve(c *app.RequestContext, req *dto.ModerateCommentRequest) (*vo.ModerateCommentResponse, error)                // 审核通过评论
+	Reject(c *app.RequestContext, req *dto.ModerateCommentRequest) (*vo.ModerateCommentResponse, error)                 // 驳回评论（标记为垃圾评论）
+	Delete(c *app.RequestContext, req *dto.DeleteCommentRequest) (*vo.DeleteCommentResponse, error)                     // 删除评论
+}
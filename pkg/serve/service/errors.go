@@ -0,0 +1,39 @@
+package service
+
+import (
+	"errors"
+)
+
+// 服务层哨兵错误，服务实现以 %w 包装后返回，控制器通过 errors.Is 选择 HTTP 状态码
+var (
+	ErrAuthenticationRequired    = errors.New("authentication required")      // 上下文中缺少当前用户
+	ErrPermissionDenied          = errors.New("insufficient permissions")     // 当前用户无权执行该操作
+	ErrInvalidID                 = errors.New("invalid ID format")            // 请求中的资源 ID 无法解析
+	ErrAlreadyInUse              = errors.New("already in use")               // 邮箱、昵称等唯一字段已被其他记录占用
+	ErrSlugConflict              = errors.New("slug already exists")          // 显式指定的 slug 已被其他文章、分类或系列使用
+	ErrTooManyAttempts           = errors.New("too many attempts")            // 短时间内失败次数过多，暂时拒绝请求
+	ErrInvalidTranslationSource  = errors.New("invalid translation source")   // 翻译来源 ID 格式错误或指向自身
+	ErrTranslationSourceNotFound = errors.New("translation source not found") // 翻译来源文章或分类不存在
+	ErrTranslationExists         = errors.New("translation already exists")   // 翻译组内已有该语言的版本
+)
+
+// 分类相关哨兵错误
+var (
+	ErrCategoryNotFound    = errors.New("category not found")            // 分类或父分类不存在
+	ErrCategoryCycle       = errors.New("category cycle detected")       // 移动或合并后分类树将出现环
+	ErrCategoryHasChildren = errors.New("category has child categories") // 分类仍有子分类，不能删除
+)
+
+// 用户相关哨兵错误
+var (
+	ErrUserNotFound     = errors.New("user not found")         // 用户不存在
+	ErrCannotDeleteSelf = errors.New("cannot delete yourself") // 用户不能删除自己
+)
+
+// 媒体与草稿相关哨兵错误
+var (
+	ErrMediaTooLarge       = errors.New("file too large")          // 上传文件超过大小限制
+	ErrMediaTypeNotAllowed = errors.New("media type not allowed")  // 上传文件类型不在允许列表中
+	ErrAutosaveNotFound    = errors.New("autosave not found")      // 当前用户没有对应的自动保存草稿
+	ErrAutosaveUnavailable = errors.New("autosave is unavailable") // 未配置 Redis，无法自动保存
+)
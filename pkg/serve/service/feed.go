@@ -0,0 +1,17 @@
+package service
+
+import (
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// FeedService 订阅源服务接口，返回订阅源及其最后修改时间
+type FeedService interface {
+	GetRSSFeed(c *app.RequestContext, req *dto.GetFeedRequest) (*vo.RSSFeed, time.Time, error)   // 获取 RSS 2.0 订阅源
+	GetAtomFeed(c *app.RequestContext, req *dto.GetFeedRequest) (*vo.AtomFeed, time.Time, error) // 获取 Atom 订阅源
+	GetJSONFeed(c *app.RequestContext, req *dto.GetFeedRequest) (*vo.JSONFeed, time.Time, error) // 获取 JSON Feed 订阅源
+}
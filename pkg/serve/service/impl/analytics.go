@@ -0,0 +1,298 @@
+// Package impl 浏览统计服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// AnalyticsServiceImpl 浏览统计服务实现
+type AnalyticsServiceImpl struct {
+	postMapper mapper.PostMapper
+	statMapper mapper.PostStatMapper
+	rbacMapper mapper.RBACMapper
+}
+
+// NewAnalyticsService 创建浏览统计服务实例
+func NewAnalyticsService(postMapperImpl mapper.PostMapper, postStatMapperImpl mapper.PostStatMapper, rbacMapperImpl mapper.RBACMapper) service.AnalyticsService {
+	return &AnalyticsServiceImpl{
+		postMapper: postMapperImpl,
+		statMapper: postStatMapperImpl,
+		rbacMapper: rbacMapperImpl,
+	}
+}
+
+// RecordView 记录文章浏览，同一访客（IP + User-Agent）当日只计一次，仅统计已发布文章
+// 浏览先写入 Redis HyperLogLog，由后台任务定期汇总落库；Redis 不可用时不影响文章访问
+func (as *AnalyticsServiceImpl) RecordView(c *app.RequestContext, post *vo.GetPostResponse) {
+	if global.RedisClient == nil || post.Status != consts.PostStatusPublished {
+		return
+	}
+
+	postID, err := strconv.ParseInt(post.ID, 10, 64)
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+	date := time.Now().Format(consts.PostStatsDateLayout)
+	viewsKey := fmt.Sprintf("%s:%s:%d", consts.PostViewsKeyPrefix, date, postID)
+
+	added, err := global.RedisClient.PFAdd(ctx, viewsKey, visitorID(c)).Result()
+	if err != nil {
+		logger.BizLogger(c).Warnf("failed to record view for post %d: %v", postID, err)
+		return
+	}
+	// 基数未变化说明该访客当日已计数
+	if added == 0 {
+		return
+	}
+
+	referrersKey := fmt.Sprintf("%s:%s:%d", consts.PostReferrersKeyPrefix, date, postID)
+	if _, err := global.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Expire(ctx, viewsKey, consts.PostViewKeyTTL)
+		pipe.HIncrBy(ctx, referrersKey, referrerHost(c), 1)
+		pipe.Expire(ctx, referrersKey, consts.PostViewKeyTTL)
+		pipe.SAdd(ctx, consts.PostViewsDirtyKey, fmt.Sprintf("%s:%d", date, postID))
+		return nil
+	}); err != nil {
+		logger.BizLogger(c).Warnf("failed to record referrer for post %d: %v", postID, err)
+	}
+}
+
+// GetPostViews 获取文章每日浏览量，无浏览的日期补 0
+func (as *AnalyticsServiceImpl) GetPostViews(c *app.RequestContext, req *dto.GetPostViewsRequest) (*vo.GetPostViewsResponse, error) {
+	if err := as.checkAnalyticsPermission(c); err != nil {
+		return nil, err
+	}
+
+	postID, err := strconv.ParseInt(req.PostID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	startDate, endDate, err := resolveStatsDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := as.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %d not found: %v", postID, err)
+		return nil, fmt.Errorf("%w: %w", service.ErrPostNotFound, err)
+	}
+
+	stats, err := as.statMapper.ListDailyPostStats(c, postID, startDate, endDate)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list daily views for post %d: %v", postID, err)
+		return nil, fmt.Errorf("failed to list daily views: %w", err)
+	}
+	viewsByDate := make(map[string]int64, len(stats))
+	for _, s := range stats {
+		viewsByDate[s.Date] = s.Views
+	}
+
+	var total int64
+	list := make([]*vo.DailyViews, 0)
+	start, _ := time.ParseInLocation(consts.PostStatsDateLayout, startDate, time.Local)
+	end, _ := time.ParseInLocation(consts.PostStatsDateLayout, endDate, time.Local)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(consts.PostStatsDateLayout)
+		total += viewsByDate[date]
+		list = append(list, &vo.DailyViews{Date: date, Views: viewsByDate[date]})
+	}
+
+	return &vo.GetPostViewsResponse{
+		PostID:    strconv.FormatInt(p.ID, 10),
+		Title:     p.Title,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Total:     total,
+		List:      list,
+	}, nil
+}
+
+// ListTopPosts 获取时间范围内浏览量最高的文章
+func (as *AnalyticsServiceImpl) ListTopPosts(c *app.RequestContext, req *dto.ListTopPostsRequest) (*vo.ListTopPostsResponse, error) {
+	if err := as.checkAnalyticsPermission(c); err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := resolveStatsDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	top, err := as.statMapper.ListTopPosts(c, startDate, endDate, req.Limit)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list top posts: %v", err)
+		return nil, fmt.Errorf("failed to list top posts: %w", err)
+	}
+
+	postIDs := make([]int64, 0, len(top))
+	for _, t := range top {
+		postIDs = append(postIDs, t.PostID)
+	}
+	posts, err := as.postMapper.GetPostsByIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get top posts: %v", err)
+		return nil, fmt.Errorf("failed to get top posts: %w", err)
+	}
+	titles := make(map[int64][2]string, len(posts))
+	for _, p := range posts {
+		titles[p.ID] = [2]string{p.Title, p.Slug}
+	}
+
+	list := make([]*vo.TopPostItem, 0, len(top))
+	for _, t := range top {
+		list = append(list, &vo.TopPostItem{
+			PostID: strconv.FormatInt(t.PostID, 10),
+			Title:  titles[t.PostID][0],
+			Slug:   titles[t.PostID][1],
+			Views:  t.Views,
+		})
+	}
+
+	return &vo.ListTopPostsResponse{
+		StartDate: startDate,
+		EndDate:   endDate,
+		List:      list,
+	}, nil
+}
+
+// ListReferrers 获取来源统计，未指定文章时统计全站
+func (as *AnalyticsServiceImpl) ListReferrers(c *app.RequestContext, req *dto.ListReferrersRequest) (*vo.ListReferrersResponse, error) {
+	if err := as.checkAnalyticsPermission(c); err != nil {
+		return nil, err
+	}
+
+	var postID *int64
+	if req.PostID != "" {
+		parsedPostID, err := strconv.ParseInt(req.PostID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+			return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+		}
+		postID = &parsedPostID
+	}
+
+	startDate, endDate, err := resolveStatsDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	referrers, err := as.statMapper.ListReferrers(c, postID, startDate, endDate, req.Limit)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list referrers: %v", err)
+		return nil, fmt.Errorf("failed to list referrers: %w", err)
+	}
+
+	list := make([]*vo.ReferrerItem, 0, len(referrers))
+	for _, r := range referrers {
+		list = append(list, &vo.ReferrerItem{Referrer: r.Referrer, Views: r.Views})
+	}
+
+	return &vo.ListReferrersResponse{
+		PostID:    req.PostID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		List:      list,
+	}, nil
+}
+
+// checkAnalyticsPermission 校验当前用户是否有权查看浏览统计
+func (as *AnalyticsServiceImpl) checkAnalyticsPermission(c *app.RequestContext) error {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return service.ErrAuthenticationRequired
+	}
+
+	allowed, err := as.rbacMapper.CheckPermission(c, strconv.FormatInt(userID.(int64), 10), consts.AnalyticsResource, consts.AnalyticsAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check analytics permission for user %d: %v", userID.(int64), err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user ID %d attempted to read analytics without permission", userID.(int64))
+		return fmt.Errorf("%w: analytics access required", service.ErrPermissionDenied)
+	}
+
+	return nil
+}
+
+// resolveStatsDateRange 解析统计时间范围，结束日期默认为今天，开始日期默认为结束日期前 29 天
+func resolveStatsDateRange(startDate, endDate string) (string, string, error) {
+	end := time.Now()
+	if endDate != "" {
+		parsed, err := time.ParseInLocation(consts.PostStatsDateLayout, endDate, time.Local)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid end date: %w", err)
+		}
+		end = parsed
+	}
+
+	start := end.AddDate(0, 0, -(consts.PostStatsDefaultDays - 1))
+	if startDate != "" {
+		parsed, err := time.ParseInLocation(consts.PostStatsDateLayout, startDate, time.Local)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid start date: %w", err)
+		}
+		start = parsed
+	}
+
+	startDate, endDate = start.Format(consts.PostStatsDateLayout), end.Format(consts.PostStatsDateLayout)
+	if startDate > endDate {
+		return "", "", fmt.Errorf("start date %s is after end date %s", startDate, endDate)
+	}
+	if start.AddDate(0, 0, consts.PostStatsMaxDays).Before(end) {
+		return "", "", fmt.Errorf("date range exceeds %d days", consts.PostStatsMaxDays)
+	}
+
+	return startDate, endDate, nil
+}
+
+// visitorID 根据客户端 IP 与 User-Agent 生成访客标识，不保存原始 IP
+func visitorID(c *app.RequestContext) string {
+	sum := sha256.Sum256([]byte(c.ClientIP() + "|" + string(c.UserAgent())))
+	return hex.EncodeToString(sum[:16])
+}
+
+// referrerHost 提取来源域名，无来源或来源无法解析时视为直接访问
+func referrerHost(c *app.RequestContext) string {
+	referer := string(c.GetHeader("Referer"))
+	if referer == "" {
+		return consts.PostReferrerDirect
+	}
+
+	u, err := url.Parse(referer)
+	if err != nil || u.Hostname() == "" {
+		return consts.PostReferrerDirect
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if len(host) > consts.PostReferrerMaxLength {
+		host = host[:consts.PostReferrerMaxLength]
+	}
+	return host
+}
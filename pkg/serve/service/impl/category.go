@@ -11,7 +11,12 @@ import (
 	"github.com/cloudwego/hertz/pkg/app"
 
 	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/cursor"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/locale"
 	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/slugify"
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
 	"github.com/Done-0/jank/pkg/serve/mapper"
 	"github.com/Done-0/jank/pkg/serve/service"
@@ -21,78 +26,108 @@ import (
 // CategoryServiceImpl 分类服务实现
 type CategoryServiceImpl struct {
 	categoryMapper mapper.CategoryMapper
+	slugMapper     mapper.SlugHistoryMapper
 }
 
 // NewCategoryService 创建分类服务实例
-func NewCategoryService(categoryMapperImpl mapper.CategoryMapper) service.CategoryService {
+func NewCategoryService(categoryMapperImpl mapper.CategoryMapper, slugHistoryMapperImpl mapper.SlugHistoryMapper) service.CategoryService {
 	return &CategoryServiceImpl{
 		categoryMapper: categoryMapperImpl,
+		slugMapper:     slugHistoryMapperImpl,
 	}
 }
 
-// GetCategory 获取单个分类
+// GetCategory 获取单个分类，支持按 ID 或 slug 查询，曾用 slug 会解析到当前分类
 func (cs *CategoryServiceImpl) GetCategory(c *app.RequestContext, req *dto.GetCategoryRequest) (*vo.GetCategoryResponse, error) {
-	categoryID, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		logger.BizLogger(c).Errorf("invalid category ID format: %s", req.ID)
-		return nil, fmt.Errorf("invalid category ID format: %w", err)
-	}
-
-	category, err := cs.categoryMapper.GetCategoryByID(c, categoryID)
+	category, err := cs.findCategory(c, req)
 	if err != nil {
-		logger.BizLogger(c).Errorf("failed to get category with ID %s: %v", req.ID, err)
-		return nil, fmt.Errorf("failed to get category: %w", err)
+		return nil, err
 	}
 
 	return &vo.GetCategoryResponse{
-		ID:          strconv.FormatInt(category.ID, 10),
-		Name:        category.Name,
-		Description: category.Description,
-		ParentID:    strconv.FormatInt(category.ParentID, 10),
-		Sort:        category.Sort,
-		IsActive:    category.IsActive,
-		CreatedAt:   time.Unix(category.GmtCreated, 0).Format("2006-01-02 15:04:05"),
-		UpdatedAt:   time.Unix(category.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		ID:                 strconv.FormatInt(category.ID, 10),
+		Name:               category.Name,
+		Slug:               category.Slug,
+		Description:        category.Description,
+		ParentID:           strconv.FormatInt(category.ParentID, 10),
+		Sort:               category.Sort,
+		IsActive:           category.IsActive,
+		Locale:             category.Locale,
+		TranslationGroupID: strconv.FormatInt(category.TranslationGroupID, 10),
+		CreatedAt:          time.Unix(category.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		UpdatedAt:          time.Unix(category.GmtModified, 0).Format("2006-01-02 15:04:05"),
 	}, nil
 }
 
-// ListCategories 获取分类列表
+// ListCategories 获取分类列表，未指定页码时使用游标分页
 func (cs *CategoryServiceImpl) ListCategories(c *app.RequestContext, req *dto.ListCategoriesRequest) (*vo.ListCategoriesResponse, error) {
 	var parentID *int64
 	if req.ParentID != "" {
 		pid, err := strconv.ParseInt(req.ParentID, 10, 64)
 		if err != nil {
 			logger.BizLogger(c).Errorf("invalid parent ID format: %s", req.ParentID)
-			return nil, fmt.Errorf("invalid parent ID format: %w", err)
+			return nil, fmt.Errorf("%w: parent ID: %w", service.ErrInvalidID, err)
 		}
 		parentID = &pid
 	}
 
-	categories, total, err := cs.categoryMapper.ListCategories(c, req.PageNo, req.PageSize, parentID, req.IsActive)
+	locales, err := locale.FallbackChain(req.Lang)
 	if err != nil {
-		logger.BizLogger(c).Errorf("failed to list categories: %v", err)
-		return nil, fmt.Errorf("failed to list categories: %w", err)
+		logger.BizLogger(c).Errorf("invalid lang '%s': %v", req.Lang, err)
+		return nil, err
+	}
+
+	var categories []*category.Category
+	var total int64
+	var nextCursor, prevCursor string
+	if req.PageNo == 0 {
+		cur, err := cursor.Decode(req.Cursor)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to decode cursor: %v", err)
+			return nil, err
+		}
+		page, count, err := cs.categoryMapper.ListCategoriesWithCursor(c, cur, req.PageSize, req.WithTotal, parentID, req.IsActive, locales)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list categories: %v", err)
+			return nil, fmt.Errorf("failed to list categories: %w", err)
+		}
+		categories, total = page.List, count
+		if !req.WithTotal {
+			total = cursor.TotalSkipped
+		}
+		nextCursor, prevCursor = cursor.Encode(page.Next), cursor.Encode(page.Prev)
+	} else {
+		categories, total, err = cs.categoryMapper.ListCategories(c, req.PageNo, req.PageSize, parentID, req.IsActive, locales)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list categories: %v", err)
+			return nil, fmt.Errorf("failed to list categories: %w", err)
+		}
 	}
 
 	var categoryItems []*vo.CategoryItem
 	for _, cat := range categories {
 		categoryItems = append(categoryItems, &vo.CategoryItem{
-			ID:          strconv.FormatInt(cat.ID, 10),
-			Name:        cat.Name,
-			Description: cat.Description,
-			ParentID:    strconv.FormatInt(cat.ParentID, 10),
-			Sort:        cat.Sort,
-			IsActive:    cat.IsActive,
-			CreatedAt:   time.Unix(cat.GmtCreated, 0).Format("2006-01-02 15:04:05"),
-			UpdatedAt:   time.Unix(cat.GmtModified, 0).Format("2006-01-02 15:04:05"),
+			ID:                 strconv.FormatInt(cat.ID, 10),
+			Name:               cat.Name,
+			Slug:               cat.Slug,
+			Description:        cat.Description,
+			ParentID:           strconv.FormatInt(cat.ParentID, 10),
+			Sort:               cat.Sort,
+			IsActive:           cat.IsActive,
+			Locale:             cat.Locale,
+			TranslationGroupID: strconv.FormatInt(cat.TranslationGroupID, 10),
+			CreatedAt:          time.Unix(cat.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt:          time.Unix(cat.GmtModified, 0).Format("2006-01-02 15:04:05"),
 		})
 	}
 
 	return &vo.ListCategoriesResponse{
-		Total:    total,
-		PageNo:   req.PageNo,
-		PageSize: req.PageSize,
-		List:     categoryItems,
+		Total:      total,
+		PageNo:     req.PageNo,
+		PageSize:   req.PageSize,
+		List:       categoryItems,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
 	}, nil
 }
 
@@ -103,39 +138,62 @@ func (cs *CategoryServiceImpl) Create(c *app.RequestContext, req *dto.CreateCate
 		pid, err := strconv.ParseInt(req.ParentID, 10, 64)
 		if err != nil {
 			logger.BizLogger(c).Errorf("invalid parent ID format: %s", req.ParentID)
-			return nil, fmt.Errorf("invalid parent ID format: %w", err)
+			return nil, fmt.Errorf("%w: parent ID: %w", service.ErrInvalidID, err)
 		}
 		parentID = pid
 	}
+	if err := cs.checkCategoryParent(c, 0, parentID); err != nil {
+		return nil, err
+	}
 
 	sort := req.Sort
 	if sort == 0 {
 		sort = 100 // 默认排序权重
 	}
 
+	categorySlug, err := cs.resolveCategorySlug(c, req.Slug, req.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	category := &category.Category{
 		Name:        req.Name,
+		Slug:        categorySlug,
 		Description: req.Description,
 		ParentID:    parentID,
 		Sort:        sort,
 		IsActive:    req.IsActive,
 	}
+	if err := cs.applyCategoryTranslation(c, category, req.Locale, req.TranslationOf); err != nil {
+		return nil, err
+	}
 
-	if err := cs.categoryMapper.CreateCategory(c, category); err != nil {
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := cs.categoryMapper.CreateCategory(c, category); err != nil {
+			return nil, err
+		}
+		// 新 slug 若曾被其他分类使用过，则不再跳转到旧分类
+		return nil, cs.slugMapper.DeleteSlugHistory(c, consts.SlugEntityCategory, category.Slug)
+	})
+	if err != nil {
 		logger.BizLogger(c).Errorf("failed to create category '%s': %v", req.Name, err)
 		return nil, fmt.Errorf("failed to create category: %w", err)
 	}
 
 	logger.BizLogger(c).Infof("category created successfully with ID: %d", category.ID)
+	invalidateSEOCache(c)
 
 	return &vo.CreateCategoryResponse{
-		ID:          strconv.FormatInt(category.ID, 10),
-		Name:        category.Name,
-		Description: category.Description,
-		ParentID:    strconv.FormatInt(category.ParentID, 10),
-		Sort:        category.Sort,
-		IsActive:    category.IsActive,
-		Message:     "Category created successfully",
+		ID:                 strconv.FormatInt(category.ID, 10),
+		Name:               category.Name,
+		Slug:               category.Slug,
+		Description:        category.Description,
+		ParentID:           strconv.FormatInt(category.ParentID, 10),
+		Sort:               category.Sort,
+		IsActive:           category.IsActive,
+		Locale:             category.Locale,
+		TranslationGroupID: strconv.FormatInt(category.TranslationGroupID, 10),
+		Message:            "Category created successfully",
 	}, nil
 }
 
@@ -144,55 +202,119 @@ func (cs *CategoryServiceImpl) Update(c *app.RequestContext, req *dto.UpdateCate
 	categoryID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		logger.BizLogger(c).Errorf("invalid category ID format: %s", req.ID)
-		return nil, fmt.Errorf("invalid category ID format: %w", err)
-	}
-
-	// 获取现有分类
-	existingCategory, err := cs.categoryMapper.GetCategoryByID(c, categoryID)
-	if err != nil {
-		logger.BizLogger(c).Errorf("failed to get category with ID %s: %v", req.ID, err)
-		return nil, fmt.Errorf("failed to get category: %w", err)
+		return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
 	}
 
-	if req.Name != "" {
-		existingCategory.Name = req.Name
-	}
-	existingCategory.Description = req.Description
+	var parentID *int64
 	if req.ParentID != "" {
-		parentID, err := strconv.ParseInt(req.ParentID, 10, 64)
+		pid, err := strconv.ParseInt(req.ParentID, 10, 64)
 		if err != nil {
 			logger.BizLogger(c).Errorf("invalid parent ID format: %s", req.ParentID)
-			return nil, fmt.Errorf("invalid parent ID format: %w", err)
+			return nil, fmt.Errorf("%w: parent ID: %w", service.ErrInvalidID, err)
 		}
-		existingCategory.ParentID = parentID
+		parentID = &pid
 	}
-	existingCategory.Sort = req.Sort
-	existingCategory.IsActive = req.IsActive
 
-	if err := cs.categoryMapper.UpdateCategory(c, existingCategory); err != nil {
-		logger.BizLogger(c).Errorf("failed to update category with ID %s: %v", req.ID, err)
-		return nil, fmt.Errorf("failed to update category: %w", err)
+	// 与 Move、Merge 相同，锁定全部分类行后再读取、校验并写入，避免并发调整父分类形成环或覆盖其他请求的移动
+	existingCategory, err := db.RunDBTransaction(c, func() (*category.Category, error) {
+		if err := cs.categoryMapper.LockAllCategories(c); err != nil {
+			logger.BizLogger(c).Errorf("failed to lock categories: %v", err)
+			return nil, fmt.Errorf("failed to lock categories: %w", err)
+		}
+
+		// 获取现有分类
+		existingCategory, err := cs.categoryMapper.GetCategoryByID(c, categoryID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get category with ID %s: %v", req.ID, err)
+			return nil, fmt.Errorf("failed to get category: %w", err)
+		}
+
+		if req.Name != "" {
+			existingCategory.Name = req.Name
+		}
+		oldSlug := existingCategory.Slug
+		if req.Slug != "" && req.Slug != oldSlug {
+			newSlug, err := cs.resolveCategorySlug(c, req.Slug, existingCategory.Name, existingCategory.ID)
+			if err != nil {
+				return nil, err
+			}
+			existingCategory.Slug = newSlug
+		}
+		existingCategory.Description = req.Description
+		if parentID != nil {
+			if *parentID != existingCategory.ParentID {
+				if err := cs.checkCategoryParent(c, existingCategory.ID, *parentID); err != nil {
+					return nil, err
+				}
+			}
+			existingCategory.ParentID = *parentID
+		}
+		existingCategory.Sort = req.Sort
+		existingCategory.IsActive = req.IsActive
+		if err := cs.applyCategoryTranslation(c, existingCategory, req.Locale, req.TranslationOf); err != nil {
+			return nil, err
+		}
+
+		if err := cs.saveCategory(c, existingCategory, oldSlug); err != nil {
+			logger.BizLogger(c).Errorf("failed to update category with ID %s: %v", req.ID, err)
+			return nil, fmt.Errorf("failed to update category: %w", err)
+		}
+		return existingCategory, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	logger.BizLogger(c).Infof("category updated successfully with ID: %d", existingCategory.ID)
+	invalidateSEOCache(c)
 
 	return &vo.UpdateCategoryResponse{
-		ID:          strconv.FormatInt(existingCategory.ID, 10),
-		Name:        existingCategory.Name,
-		Description: existingCategory.Description,
-		ParentID:    strconv.FormatInt(existingCategory.ParentID, 10),
-		Sort:        existingCategory.Sort,
-		IsActive:    existingCategory.IsActive,
-		Message:     "Category updated successfully",
+		ID:                 strconv.FormatInt(existingCategory.ID, 10),
+		Name:               existingCategory.Name,
+		Slug:               existingCategory.Slug,
+		Description:        existingCategory.Description,
+		ParentID:           strconv.FormatInt(existingCategory.ParentID, 10),
+		Sort:               existingCategory.Sort,
+		IsActive:           existingCategory.IsActive,
+		Locale:             existingCategory.Locale,
+		TranslationGroupID: strconv.FormatInt(existingCategory.TranslationGroupID, 10),
+		Message:            "Category updated successfully",
 	}, nil
 }
 
-// Delete 删除分类
+// saveCategory 保存分类，slug 变更时新 slug 不再跳转到其他分类，旧 slug 跳转到当前分类
+func (cs *CategoryServiceImpl) saveCategory(c *app.RequestContext, cat *category.Category, oldSlug string) error {
+	if err := cs.categoryMapper.UpdateCategory(c, cat); err != nil {
+		return err
+	}
+	if cat.Slug == oldSlug {
+		return nil
+	}
+	if err := cs.slugMapper.DeleteSlugHistory(c, consts.SlugEntityCategory, cat.Slug); err != nil {
+		return err
+	}
+	if oldSlug == "" {
+		return nil
+	}
+	return cs.slugMapper.SaveSlugHistory(c, consts.SlugEntityCategory, cat.ID, oldSlug)
+}
+
+// Delete 删除分类，仍有子分类时须先移动或合并子分类
 func (cs *CategoryServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteCategoryRequest) (*vo.DeleteCategoryResponse, error) {
 	categoryID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		logger.BizLogger(c).Errorf("invalid category ID format: %s", req.ID)
-		return nil, fmt.Errorf("invalid category ID format: %w", err)
+		return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
+	}
+
+	children, err := cs.categoryMapper.CountChildCategories(c, categoryID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count child categories of %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to count child categories: %w", err)
+	}
+	if children > 0 {
+		logger.BizLogger(c).Warnf("category %s still has %d child categories", req.ID, children)
+		return nil, fmt.Errorf("%w: move or merge them first", service.ErrCategoryHasChildren)
 	}
 
 	if err := cs.categoryMapper.DeleteCategory(c, categoryID); err != nil {
@@ -201,8 +323,75 @@ func (cs *CategoryServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteCate
 	}
 
 	logger.BizLogger(c).Infof("category deleted successfully with ID: %d", categoryID)
+	invalidateSEOCache(c)
 
 	return &vo.DeleteCategoryResponse{
 		Message: "Category deleted successfully",
 	}, nil
 }
+
+// findCategory 按 ID 或 slug 查询分类，slug 未命中时查找曾用 slug 记录
+func (cs *CategoryServiceImpl) findCategory(c *app.RequestContext, req *dto.GetCategoryRequest) (*category.Category, error) {
+	if req.ID != "" {
+		categoryID, err := strconv.ParseInt(req.ID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid category ID format: %s", req.ID)
+			return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
+		}
+
+		category, err := cs.categoryMapper.GetCategoryByID(c, categoryID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get category with ID %s: %v", req.ID, err)
+			return nil, fmt.Errorf("failed to get category: %w", err)
+		}
+		return category, nil
+	}
+
+	if category, err := cs.categoryMapper.GetCategoryBySlug(c, req.Slug); err == nil {
+		return category, nil
+	}
+
+	history, err := cs.slugMapper.GetSlugHistory(c, consts.SlugEntityCategory, req.Slug)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get category with slug %s: %v", req.Slug, err)
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	category, err := cs.categoryMapper.GetCategoryByID(c, history.EntityID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get category with ID %d for slug %s: %v", history.EntityID, req.Slug, err)
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	return category, nil
+}
+
+// resolveCategorySlug 确定分类 slug：显式指定时校验唯一性，否则根据名称生成并自动追加序号去重
+func (cs *CategoryServiceImpl) resolveCategorySlug(c *app.RequestContext, explicit, name string, excludeID int64) (string, error) {
+	taken := func(s string) (bool, error) {
+		return cs.categoryMapper.IsCategorySlugTaken(c, s, excludeID)
+	}
+
+	if explicit != "" {
+		exists, err := taken(explicit)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to check category slug '%s': %v", explicit, err)
+			return "", fmt.Errorf("failed to check category slug: %w", err)
+		}
+		if exists {
+			logger.BizLogger(c).Errorf("category slug '%s' already exists", explicit)
+			return "", fmt.Errorf("%w: %s", service.ErrSlugConflict, explicit)
+		}
+		return explicit, nil
+	}
+
+	base := slugify.Make(name)
+	if base == "" {
+		base = consts.SlugEntityCategory
+	}
+	categorySlug, err := slugify.Unique(base, taken)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to generate slug for category '%s': %v", name, err)
+		return "", fmt.Errorf("failed to generate category slug: %w", err)
+	}
+	return categorySlug, nil
+}
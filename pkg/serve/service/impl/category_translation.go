@@ -0,0 +1,80 @@
+// Package impl 分类多语言服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/locale"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/snowflake"
+	"github.com/Done-0/jank/pkg/serve/service"
+)
+
+// applyCategoryTranslation 设置分类的语言与翻译组，须在写入分类前调用，规则同 applyPostTranslation
+func (cs *CategoryServiceImpl) applyCategoryTranslation(c *app.RequestContext, cat *category.Category, rawLocale, translationOf string) error {
+	categoryLocale := cat.Locale
+	if rawLocale != "" || categoryLocale == "" {
+		resolved, err := locale.Resolve(rawLocale)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid locale '%s' for category %d: %v", rawLocale, cat.ID, err)
+			return err
+		}
+		categoryLocale = resolved
+	}
+
+	groupID := cat.TranslationGroupID
+	switch translationOf {
+	case "":
+	case consts.LocaleDetachTranslation:
+		groupID = 0
+	default:
+		sourceID, err := strconv.ParseInt(translationOf, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid translation source ID format: %s", translationOf)
+			return fmt.Errorf("%w: %w", service.ErrInvalidTranslationSource, err)
+		}
+		if sourceID == cat.ID {
+			return fmt.Errorf("%w: a category cannot be a translation of itself", service.ErrInvalidTranslationSource)
+		}
+		source, err := cs.categoryMapper.GetCategoryByID(c, sourceID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("translation source category %d not found: %v", sourceID, err)
+			return fmt.Errorf("%w: category %s", service.ErrTranslationSourceNotFound, translationOf)
+		}
+		groupID = source.TranslationGroupID
+	}
+
+	if groupID == 0 {
+		// 新建翻译组，组内只有当前分类，无需检查语言冲突
+		newGroupID, err := snowflake.GenerateID()
+		if err != nil {
+			return fmt.Errorf("failed to generate translation group ID: %w", err)
+		}
+		cat.Locale, cat.TranslationGroupID = categoryLocale, newGroupID
+		return nil
+	}
+
+	if groupID != cat.TranslationGroupID || categoryLocale != cat.Locale {
+		translations, err := cs.categoryMapper.ListCategoryTranslations(c, groupID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list translations of group %d: %v", groupID, err)
+			return fmt.Errorf("failed to list category translations: %w", err)
+		}
+		for _, t := range translations {
+			if t.ID != cat.ID && t.Locale == categoryLocale {
+				logger.BizLogger(c).Warnf("translation group %d already has category %d in locale %s", groupID, t.ID, categoryLocale)
+				return fmt.Errorf("%w for locale %s: category %d", service.ErrTranslationExists, categoryLocale, t.ID)
+			}
+		}
+	}
+
+	cat.Locale, cat.TranslationGroupID = categoryLocale, groupID
+	return nil
+}
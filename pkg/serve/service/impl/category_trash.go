@@ -0,0 +1,113 @@
+// Package impl 分类回收站服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/trash"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/slugify"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// ListTrash 获取回收站中的分类
+func (cs *CategoryServiceImpl) ListTrash(c *app.RequestContext, req *dto.ListTrashRequest) (*vo.ListTrashedCategoriesResponse, error) {
+	categories, total, err := cs.categoryMapper.ListDeletedCategories(c, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list trashed categories: %v", err)
+		return nil, fmt.Errorf("failed to list trashed categories: %w", err)
+	}
+
+	list := make([]*vo.TrashedCategoryItem, 0, len(categories))
+	for _, cat := range categories {
+		slug := base.TrashedValue(cat.Ext, "slug")
+		if slug == "" {
+			slug = cat.Slug
+		}
+		list = append(list, &vo.TrashedCategoryItem{
+			ID:        strconv.FormatInt(cat.ID, 10),
+			Name:      cat.Name,
+			Slug:      slug,
+			ParentID:  strconv.FormatInt(cat.ParentID, 10),
+			DeletedAt: time.Unix(trash.DeletedAt(&cat.Base), 0).Format("2006-01-02 15:04:05"),
+			PurgeAt:   formatPurgeAt(&cat.Base),
+		})
+	}
+
+	return &vo.ListTrashedCategoriesResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     list,
+	}, nil
+}
+
+// Restore 从回收站恢复分类，原 slug 已被占用时追加序号去重，原父分类已不存在时恢复为顶级分类
+func (cs *CategoryServiceImpl) Restore(c *app.RequestContext, req *dto.RestoreCategoryRequest) (*vo.RestoreCategoryResponse, error) {
+	categoryID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid category ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
+	}
+
+	cat, err := cs.categoryMapper.GetDeletedCategoryByID(c, categoryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w in trash: %s", service.ErrCategoryNotFound, req.ID)
+		}
+		logger.BizLogger(c).Errorf("failed to get trashed category with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get trashed category: %w", err)
+	}
+
+	slug := base.TrashedValue(cat.Ext, "slug")
+	if slug != "" {
+		slug, err = slugify.Unique(slug, func(s string) (bool, error) {
+			return cs.categoryMapper.IsCategorySlugTaken(c, s, cat.ID)
+		})
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to resolve slug for category %s: %v", req.ID, err)
+			return nil, fmt.Errorf("failed to resolve category slug: %w", err)
+		}
+	}
+
+	parentID := cat.ParentID
+	if parentID != 0 {
+		if _, err := cs.categoryMapper.GetCategoryByID(c, parentID); err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				logger.BizLogger(c).Errorf("failed to get parent category %d: %v", parentID, err)
+				return nil, fmt.Errorf("failed to get parent category: %w", err)
+			}
+			logger.BizLogger(c).Warnf("parent category %d of %s no longer exists, restoring as top-level", parentID, req.ID)
+			parentID = 0
+		}
+	}
+
+	if err := cs.categoryMapper.RestoreCategory(c, cat, slug, parentID); err != nil {
+		logger.BizLogger(c).Errorf("failed to restore category with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to restore category: %w", err)
+	}
+	if slug == "" {
+		slug = cat.Slug
+	}
+
+	logger.BizLogger(c).Infof("category restored from trash with ID: %s", req.ID)
+	invalidateSEOCache(c)
+
+	return &vo.RestoreCategoryResponse{
+		ID:       req.ID,
+		Slug:     slug,
+		ParentID: strconv.FormatInt(parentID, 10),
+		Message:  "Category restored successfully",
+	}, nil
+}
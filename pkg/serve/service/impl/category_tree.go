@@ -0,0 +1,289 @@
+// Package impl 分类树服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/category"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// GetCategoryTree 获取分类树，每个节点附带直接文章数量与含子孙分类的文章数量
+// 父分类已删除的分类视为顶级分类
+func (cs *CategoryServiceImpl) GetCategoryTree(c *app.RequestContext, req *dto.GetCategoryTreeRequest) (*vo.GetCategoryTreeResponse, error) {
+	categories, err := cs.categoryMapper.ListAllCategories(c)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list categories: %v", err)
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	categoryIDs := make([]int64, 0, len(categories))
+	for _, cat := range categories {
+		categoryIDs = append(categoryIDs, cat.ID)
+	}
+	counts, err := cs.categoryMapper.CountPublishedPostsByCategoryIDs(c, categoryIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count posts of categories: %v", err)
+		return nil, fmt.Errorf("failed to count category posts: %w", err)
+	}
+
+	nodes := make(map[int64]*vo.CategoryTreeNode, len(categories))
+	for _, cat := range categories {
+		if !cat.IsActive && !req.IncludeInactive {
+			continue
+		}
+		nodes[cat.ID] = &vo.CategoryTreeNode{
+			ID:          strconv.FormatInt(cat.ID, 10),
+			Name:        cat.Name,
+			Slug:        cat.Slug,
+			Description: cat.Description,
+			ParentID:    strconv.FormatInt(cat.ParentID, 10),
+			Sort:        cat.Sort,
+			IsActive:    cat.IsActive,
+			PostCount:   counts[cat.ID],
+			Children:    []*vo.CategoryTreeNode{},
+		}
+	}
+
+	exists := make(map[int64]struct{}, len(categories))
+	for _, cat := range categories {
+		exists[cat.ID] = struct{}{}
+	}
+
+	// categories 已按排序权重排好，依序挂载即可保持同级顺序
+	roots := make([]*vo.CategoryTreeNode, 0)
+	for _, cat := range categories {
+		node, ok := nodes[cat.ID]
+		if !ok {
+			continue
+		}
+		if _, hasParent := exists[cat.ParentID]; !hasParent {
+			roots = append(roots, node)
+			continue
+		}
+		// 父分类未启用时整棵子树隐藏
+		if parent, ok := nodes[cat.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	visited := make(map[*vo.CategoryTreeNode]struct{}, len(nodes))
+	for _, root := range roots {
+		sumCategoryPosts(root, visited)
+	}
+
+	return &vo.GetCategoryTreeResponse{
+		List: roots,
+	}, nil
+}
+
+// Move 移动分类子树，子孙分类随之移动；新父分类不能是分类自身或其子孙分类
+func (cs *CategoryServiceImpl) Move(c *app.RequestContext, req *dto.MoveCategoryRequest) (*vo.MoveCategoryResponse, error) {
+	categoryID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid category ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
+	}
+
+	var parentID int64
+	if req.ParentID != "" {
+		if parentID, err = strconv.ParseInt(req.ParentID, 10, 64); err != nil {
+			logger.BizLogger(c).Errorf("invalid parent ID format: %s", req.ParentID)
+			return nil, fmt.Errorf("%w: parent ID: %w", service.ErrInvalidID, err)
+		}
+	}
+
+	// 环检测读取整棵分类树，锁定全部分类行后再校验与更新，避免并发移动交错形成环
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := cs.categoryMapper.LockAllCategories(c); err != nil {
+			logger.BizLogger(c).Errorf("failed to lock categories: %v", err)
+			return nil, fmt.Errorf("failed to lock categories: %w", err)
+		}
+
+		existingCategory, err := cs.categoryMapper.GetCategoryByID(c, categoryID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("category with ID %s not found: %v", req.ID, err)
+			return nil, fmt.Errorf("%w: %w", service.ErrCategoryNotFound, err)
+		}
+
+		if err := cs.checkCategoryParent(c, categoryID, parentID); err != nil {
+			return nil, err
+		}
+
+		existingCategory.ParentID = parentID
+		if err := cs.categoryMapper.UpdateCategory(c, existingCategory); err != nil {
+			logger.BizLogger(c).Errorf("failed to move category %s: %v", req.ID, err)
+			return nil, fmt.Errorf("failed to move category: %w", err)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.BizLogger(c).Infof("category %d moved under parent %d", categoryID, parentID)
+	invalidateSEOCache(c)
+
+	return &vo.MoveCategoryResponse{
+		ID:       strconv.FormatInt(categoryID, 10),
+		ParentID: strconv.FormatInt(parentID, 10),
+		Message:  "Category moved successfully",
+	}, nil
+}
+
+// Merge 将源分类合并到目标分类：源分类的文章与直接子分类并入目标分类，源分类删除，其 slug 跳转到目标分类
+func (cs *CategoryServiceImpl) Merge(c *app.RequestContext, req *dto.MergeCategoryRequest) (*vo.MergeCategoryResponse, error) {
+	sourceID, err := strconv.ParseInt(req.SourceID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid source category ID format: %s", req.SourceID)
+		return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
+	}
+	targetID, err := strconv.ParseInt(req.TargetID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid target category ID format: %s", req.TargetID)
+		return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
+	}
+	if sourceID == targetID {
+		return nil, fmt.Errorf("%w: cannot merge a category into itself", service.ErrCategoryCycle)
+	}
+
+	// 环检测读取整棵分类树，与 Move 相同在锁定全部分类行的事务中完成校验与合并
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := cs.categoryMapper.LockAllCategories(c); err != nil {
+			logger.BizLogger(c).Errorf("failed to lock categories: %v", err)
+			return nil, fmt.Errorf("failed to lock categories: %w", err)
+		}
+
+		source, err := cs.categoryMapper.GetCategoryByID(c, sourceID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("source category with ID %s not found: %v", req.SourceID, err)
+			return nil, fmt.Errorf("%w: %w", service.ErrCategoryNotFound, err)
+		}
+		if _, err := cs.categoryMapper.GetCategoryByID(c, targetID); err != nil {
+			logger.BizLogger(c).Errorf("target category with ID %s not found: %v", req.TargetID, err)
+			return nil, fmt.Errorf("%w: %w", service.ErrCategoryNotFound, err)
+		}
+
+		// 源分类的子分类将改挂到目标分类下，目标分类位于源分类子树中时会形成环
+		categories, err := cs.categoryMapper.ListAllCategories(c)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list categories: %v", err)
+			return nil, fmt.Errorf("failed to list categories: %w", err)
+		}
+		for _, id := range categoryDescendantIDs(categories, sourceID) {
+			if id == targetID {
+				return nil, fmt.Errorf("%w: cannot merge category %d into its descendant %d", service.ErrCategoryCycle, sourceID, targetID)
+			}
+		}
+
+		if err := cs.categoryMapper.MergeCategory(c, sourceID, targetID); err != nil {
+			logger.BizLogger(c).Errorf("failed to merge category %d into %d: %v", sourceID, targetID, err)
+			return nil, fmt.Errorf("failed to merge category: %w", err)
+		}
+		if source.Slug == "" {
+			return nil, nil
+		}
+		if err := cs.slugMapper.SaveSlugHistory(c, consts.SlugEntityCategory, targetID, source.Slug); err != nil {
+			logger.BizLogger(c).Errorf("failed to merge category %d into %d: %v", sourceID, targetID, err)
+			return nil, fmt.Errorf("failed to merge category: %w", err)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.BizLogger(c).Infof("category %d merged into %d", sourceID, targetID)
+	invalidateSEOCache(c)
+	invalidateRelatedCache(c)
+
+	return &vo.MergeCategoryResponse{
+		SourceID: strconv.FormatInt(sourceID, 10),
+		TargetID: strconv.FormatInt(targetID, 10),
+		Message:  "Category merged successfully",
+	}, nil
+}
+
+// checkCategoryParent 校验父分类存在，且不是分类自身或其子孙分类，parentID 为 0 表示顶级分类
+// categoryID 为 0 表示新建分类，仅校验父分类存在
+func (cs *CategoryServiceImpl) checkCategoryParent(c *app.RequestContext, categoryID, parentID int64) error {
+	if parentID == 0 {
+		return nil
+	}
+	if parentID == categoryID {
+		return fmt.Errorf("%w: category %d cannot be its own parent", service.ErrCategoryCycle, categoryID)
+	}
+
+	categories, err := cs.categoryMapper.ListAllCategories(c)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list categories: %v", err)
+		return fmt.Errorf("failed to list categories: %w", err)
+	}
+	parents := make(map[int64]int64, len(categories))
+	for _, cat := range categories {
+		parents[cat.ID] = cat.ParentID
+	}
+	if _, ok := parents[parentID]; !ok {
+		return fmt.Errorf("parent %w: %d", service.ErrCategoryNotFound, parentID)
+	}
+
+	// 沿新父分类向上查找祖先，遇到分类自身说明新父分类是其子孙分类
+	seen := make(map[int64]struct{}, len(parents))
+	for id := parentID; id != 0; id = parents[id] {
+		if id == categoryID {
+			return fmt.Errorf("%w: category %d is a descendant of %d", service.ErrCategoryCycle, parentID, categoryID)
+		}
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("%w: ancestors of category %d form a cycle", service.ErrCategoryCycle, parentID)
+		}
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// categoryDescendantIDs 返回分类及其全部子孙分类的 ID，rootID 排在首位
+func categoryDescendantIDs(categories []*category.Category, rootID int64) []int64 {
+	children := make(map[int64][]int64, len(categories))
+	for _, cat := range categories {
+		children[cat.ParentID] = append(children[cat.ParentID], cat.ID)
+	}
+
+	ids := []int64{rootID}
+	seen := map[int64]struct{}{rootID: {}}
+	for i := 0; i < len(ids); i++ {
+		for _, childID := range children[ids[i]] {
+			if _, ok := seen[childID]; ok {
+				continue
+			}
+			seen[childID] = struct{}{}
+			ids = append(ids, childID)
+		}
+	}
+	return ids
+}
+
+// sumCategoryPosts 自底向上累加子树文章数量
+func sumCategoryPosts(node *vo.CategoryTreeNode, visited map[*vo.CategoryTreeNode]struct{}) int64 {
+	if _, ok := visited[node]; ok {
+		return 0
+	}
+	visited[node] = struct{}{}
+
+	total := node.PostCount
+	for _, child := range node.Children {
+		total += sumCategoryPosts(child, visited)
+	}
+	node.TotalPostCount = total
+	return total
+}
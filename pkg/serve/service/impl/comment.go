@@ -0,0 +1,297 @@
+// Package impl 评论服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/comment"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// CommentServiceImpl 评论服务实现
+type CommentServiceImpl struct {
+	commentMapper mapper.CommentMapper
+	postMapper    mapper.PostMapper
+	userMapper    mapper.UserMapper
+	rbacMapper    mapper.RBACMapper
+}
+
+// NewCommentService 创建评论服务实例
+func NewCommentService(commentMapperImpl mapper.CommentMapper, postMapperImpl mapper.PostMapper, userMapperImpl mapper.UserMapper, rbacMapperImpl mapper.RBACMapper) service.CommentService {
+	return &CommentServiceImpl{
+		commentMapper: commentMapperImpl,
+		postMapper:    postMapperImpl,
+		userMapper:    userMapperImpl,
+		rbacMapper:    rbacMapperImpl,
+	}
+}
+
+// ListComments 获取文章已通过审核的评论列表
+func (cs *CommentServiceImpl) ListComments(c *app.RequestContext, req *dto.ListCommentsRequest) (*vo.ListCommentsResponse, error) {
+	postID, err := strconv.ParseInt(req.PostID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	comments, total, err := cs.commentMapper.ListApprovedComments(c, req.PageNo, req.PageSize, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list comments for post %d: %v", postID, err)
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	commentItems := make([]*vo.CommentItem, 0, len(comments))
+	for _, cm := range comments {
+		commentItems = append(commentItems, cs.buildCommentItem(c, cm, false))
+	}
+
+	return &vo.ListCommentsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     commentItems,
+	}, nil
+}
+
+// ListCommentsByStatus 根据状态获取评论列表（审核队列）
+func (cs *CommentServiceImpl) ListCommentsByStatus(c *app.RequestContext, req *dto.ListCommentsByStatusRequest) (*vo.ListCommentsResponse, error) {
+	if err := cs.checkModeratePermission(c); err != nil {
+		return nil, err
+	}
+
+	var postID *int64
+	if req.PostID != "" {
+		parsedPostID, err := strconv.ParseInt(req.PostID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+			return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+		}
+		postID = &parsedPostID
+	}
+
+	comments, total, err := cs.commentMapper.ListCommentsByStatus(c, req.PageNo, req.PageSize, req.Status, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list comments by status: %v", err)
+		return nil, fmt.Errorf("failed to list comments by status: %w", err)
+	}
+
+	commentItems := make([]*vo.CommentItem, 0, len(comments))
+	for _, cm := range comments {
+		commentItems = append(commentItems, cs.buildCommentItem(c, cm, true))
+	}
+
+	return &vo.ListCommentsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     commentItems,
+	}, nil
+}
+
+// Create 创建评论，新评论进入待审核队列
+func (cs *CommentServiceImpl) Create(c *app.RequestContext, req *dto.CreateCommentRequest) (*vo.CreateCommentResponse, error) {
+	postID, err := strconv.ParseInt(req.PostID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	p, err := cs.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %d does not exist: %v", postID, err)
+		return nil, fmt.Errorf("post with ID %d does not exist", postID)
+	}
+	if p.Status != consts.PostStatusPublished {
+		logger.BizLogger(c).Warnf("attempted to comment on unpublished post %d", postID)
+		return nil, fmt.Errorf("post with ID %d is not open for comments", postID)
+	}
+
+	var parentID int64
+	if req.ParentID != "" {
+		parsedParentID, err := strconv.ParseInt(req.ParentID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid parent comment ID format: %s", req.ParentID)
+			return nil, fmt.Errorf("%w: parent comment ID: %w", service.ErrInvalidID, err)
+		}
+
+		parent, err := cs.commentMapper.GetCommentByID(c, parsedParentID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("parent comment with ID %d does not exist: %v", parsedParentID, err)
+			return nil, fmt.Errorf("parent comment with ID %d does not exist", parsedParentID)
+		}
+		if parent.PostID != postID || parent.Status != consts.CommentStatusApproved {
+			logger.BizLogger(c).Warnf("parent comment %d cannot be replied to on post %d", parsedParentID, postID)
+			return nil, fmt.Errorf("parent comment with ID %d cannot be replied to", parsedParentID)
+		}
+
+		parentID = parsedParentID
+	}
+
+	cm := &comment.Comment{
+		PostID:    postID,
+		ParentID:  parentID,
+		Content:   req.Content,
+		Status:    consts.CommentStatusPending,
+		IP:        c.ClientIP(),
+		UserAgent: string(c.UserAgent()),
+	}
+
+	// 已登录用户关联账号，未登录用户必须提供昵称和邮箱
+	if userID, exists := c.Get(consts.JWTSubjectClaim); exists {
+		uid := userID.(int64)
+		cm.UserID = &uid
+	} else {
+		if req.AuthorName == "" || req.AuthorEmail == "" {
+			logger.BizLogger(c).Warnf("anonymous comment on post %d missing author name or email", postID)
+			return nil, fmt.Errorf("author name and email are required for anonymous comments")
+		}
+		cm.AuthorName = req.AuthorName
+		cm.AuthorEmail = req.AuthorEmail
+	}
+
+	if err := cs.commentMapper.CreateComment(c, cm); err != nil {
+		logger.BizLogger(c).Errorf("failed to create comment on post %d: %v", postID, err)
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("comment created successfully with ID: %d", cm.ID)
+
+	return &vo.CreateCommentResponse{
+		ID:      strconv.FormatInt(cm.ID, 10),
+		Status:  cm.Status,
+		Message: "Comment submitted and awaiting moderation",
+	}, nil
+}
+
+// Approve 审核通过评论
+func (cs *CommentServiceImpl) Approve(c *app.RequestContext, req *dto.ModerateCommentRequest) (*vo.ModerateCommentResponse, error) {
+	return cs.moderate(c, req.ID, consts.CommentStatusApproved)
+}
+
+// Reject 驳回评论（标记为垃圾评论）
+func (cs *CommentServiceImpl) Reject(c *app.RequestContext, req *dto.ModerateCommentRequest) (*vo.ModerateCommentResponse, error) {
+	return cs.moderate(c, req.ID, consts.CommentStatusSpam)
+}
+
+// Delete 删除评论及其所有回复
+func (cs *CommentServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteCommentRequest) (*vo.DeleteCommentResponse, error) {
+	if err := cs.checkModeratePermission(c); err != nil {
+		return nil, err
+	}
+
+	commentID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid comment ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: comment ID: %w", service.ErrInvalidID, err)
+	}
+
+	if _, err := cs.commentMapper.GetCommentByID(c, commentID); err != nil {
+		logger.BizLogger(c).Errorf("comment with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+
+	if err := cs.commentMapper.DeleteComment(c, commentID); err != nil {
+		logger.BizLogger(c).Errorf("failed to delete comment with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("comment deleted successfully with ID: %s", req.ID)
+
+	return &vo.DeleteCommentResponse{
+		Message: "Comment deleted successfully",
+	}, nil
+}
+
+// moderate 更新评论审核状态
+func (cs *CommentServiceImpl) moderate(c *app.RequestContext, id, status string) (*vo.ModerateCommentResponse, error) {
+	if err := cs.checkModeratePermission(c); err != nil {
+		return nil, err
+	}
+
+	commentID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid comment ID format: %s", id)
+		return nil, fmt.Errorf("%w: comment ID: %w", service.ErrInvalidID, err)
+	}
+
+	if _, err := cs.commentMapper.GetCommentByID(c, commentID); err != nil {
+		logger.BizLogger(c).Errorf("comment with ID %s not found: %v", id, err)
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+
+	if err := cs.commentMapper.UpdateCommentStatus(c, commentID, status); err != nil {
+		logger.BizLogger(c).Errorf("failed to set status '%s' on comment %s: %v", status, id, err)
+		return nil, fmt.Errorf("failed to moderate comment: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("comment %s moderated with status: %s", id, status)
+
+	return &vo.ModerateCommentResponse{
+		ID:      id,
+		Status:  status,
+		Message: "Comment moderated successfully",
+	}, nil
+}
+
+// checkModeratePermission 校验当前用户是否拥有评论审核权限
+func (cs *CommentServiceImpl) checkModeratePermission(c *app.RequestContext) error {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return service.ErrAuthenticationRequired
+	}
+
+	allowed, err := cs.rbacMapper.CheckPermission(c, strconv.FormatInt(userID.(int64), 10), consts.CommentModerateResource, consts.CommentModerateAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check moderation permission for user %d: %v", userID.(int64), err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user ID %d attempted to moderate comments without permission", userID.(int64))
+		return fmt.Errorf("%w: you do not have permission to moderate comments", service.ErrPermissionDenied)
+	}
+
+	return nil
+}
+
+// buildCommentItem 构建评论列表项，withPrivate 为 true 时返回邮箱和 IP
+func (cs *CommentServiceImpl) buildCommentItem(c *app.RequestContext, cm *comment.Comment, withPrivate bool) *vo.CommentItem {
+	item := &vo.CommentItem{
+		ID:         strconv.FormatInt(cm.ID, 10),
+		PostID:     strconv.FormatInt(cm.PostID, 10),
+		ParentID:   strconv.FormatInt(cm.ParentID, 10),
+		AuthorName: cm.AuthorName,
+		Content:    cm.Content,
+		Status:     cm.Status,
+		CreatedAt:  time.Unix(cm.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+	}
+
+	if withPrivate {
+		item.AuthorEmail = cm.AuthorEmail
+		item.IP = cm.IP
+	}
+
+	if cm.UserID != nil {
+		item.UserID = strconv.FormatInt(*cm.UserID, 10)
+		if u, err := cs.userMapper.GetUserByID(c, *cm.UserID); err == nil {
+			item.AuthorName = u.Nickname
+			item.AuthorAvatar = u.Avatar
+			if withPrivate {
+				item.AuthorEmail = u.Email
+			}
+		}
+	}
+
+	return item
+}
@@ -0,0 +1,295 @@
+// Package impl 订阅源服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/user"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// FeedServiceImpl 订阅源服务实现
+type FeedServiceImpl struct {
+	postMapper     mapper.PostMapper
+	categoryMapper mapper.CategoryMapper
+	tagMapper      mapper.TagMapper
+	userMapper     mapper.UserMapper
+}
+
+// feedEntry 订阅源条目公共数据
+type feedEntry struct {
+	post         *post.Post
+	link         string
+	author       *user.User
+	categoryName string
+	tagNames     []string
+}
+
+// feedData 订阅源公共数据，由各格式分别序列化
+type feedData struct {
+	title        string
+	description  string
+	homeURL      string
+	selfURL      string
+	lastModified time.Time
+	entries      []*feedEntry
+}
+
+// NewFeedService 创建订阅源服务实例
+func NewFeedService(postMapperImpl mapper.PostMapper, categoryMapperImpl mapper.CategoryMapper, tagMapperImpl mapper.TagMapper, userMapperImpl mapper.UserMapper) service.FeedService {
+	return &FeedServiceImpl{
+		postMapper:     postMapperImpl,
+		categoryMapper: categoryMapperImpl,
+		tagMapper:      tagMapperImpl,
+		userMapper:     userMapperImpl,
+	}
+}
+
+// GetRSSFeed 获取 RSS 2.0 订阅源
+func (fs *FeedServiceImpl) GetRSSFeed(c *app.RequestContext, req *dto.GetFeedRequest) (*vo.RSSFeed, time.Time, error) {
+	data, err := fs.buildFeed(c, req)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	items := make([]*vo.RSSItem, 0, len(data.entries))
+	for _, e := range data.entries {
+		item := &vo.RSSItem{
+			Title:       e.post.Title,
+			Link:        e.link,
+			GUID:        &vo.RSSGUID{IsPermaLink: true, Value: e.link},
+			Description: e.post.Description,
+			Content:     &vo.CDATA{Value: e.post.HTML},
+			Category:    e.categoryName,
+			PubDate:     time.Unix(e.post.GmtCreated, 0).UTC().Format(time.RFC1123Z),
+		}
+		if e.author != nil {
+			item.Creator = e.author.Nickname
+		}
+		items = append(items, item)
+	}
+
+	channel := &vo.RSSChannel{
+		Title:       data.title,
+		Link:        data.homeURL,
+		Description: data.description,
+		AtomLink:    &vo.AtomLink{Href: data.selfURL, Rel: "self", Type: "application/rss+xml"},
+		Items:       items,
+	}
+	if !data.lastModified.IsZero() {
+		channel.LastBuildDate = data.lastModified.Format(time.RFC1123Z)
+	}
+
+	return &vo.RSSFeed{
+		Version:   "2.0",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		DCNS:      "http://purl.org/dc/elements/1.1/",
+		Channel:   channel,
+	}, data.lastModified, nil
+}
+
+// GetAtomFeed 获取 Atom 订阅源
+func (fs *FeedServiceImpl) GetAtomFeed(c *app.RequestContext, req *dto.GetFeedRequest) (*vo.AtomFeed, time.Time, error) {
+	data, err := fs.buildFeed(c, req)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	entries := make([]*vo.AtomEntry, 0, len(data.entries))
+	for _, e := range data.entries {
+		entry := &vo.AtomEntry{
+			Title:     e.post.Title,
+			ID:        e.link,
+			Links:     []*vo.AtomLink{{Href: e.link, Rel: "alternate", Type: "text/html"}},
+			Published: time.Unix(e.post.GmtCreated, 0).UTC().Format(time.RFC3339),
+			Updated:   time.Unix(e.post.GmtModified, 0).UTC().Format(time.RFC3339),
+			Summary:   e.post.Description,
+			Content:   &vo.AtomContent{Type: "html", Value: e.post.HTML},
+		}
+		if e.author != nil {
+			entry.Author = &vo.AtomAuthor{Name: e.author.Nickname}
+		}
+		if e.categoryName != "" {
+			entry.Category = &vo.AtomCategory{Term: e.categoryName}
+		}
+		entries = append(entries, entry)
+	}
+
+	// Atom 要求 feed 必须包含 updated，无文章时使用 Unix 纪元保证输出稳定
+	updated := data.lastModified
+	if updated.IsZero() {
+		updated = time.Unix(0, 0).UTC()
+	}
+
+	return &vo.AtomFeed{
+		Title:    data.title,
+		Subtitle: data.description,
+		ID:       data.selfURL,
+		Updated:  updated.Format(time.RFC3339),
+		Links: []*vo.AtomLink{
+			{Href: data.selfURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: data.homeURL, Rel: "alternate", Type: "text/html"},
+		},
+		Entries: entries,
+	}, data.lastModified, nil
+}
+
+// GetJSONFeed 获取 JSON Feed 订阅源
+func (fs *FeedServiceImpl) GetJSONFeed(c *app.RequestContext, req *dto.GetFeedRequest) (*vo.JSONFeed, time.Time, error) {
+	data, err := fs.buildFeed(c, req)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	items := make([]*vo.JSONFeedItem, 0, len(data.entries))
+	for _, e := range data.entries {
+		item := &vo.JSONFeedItem{
+			ID:            e.link,
+			URL:           e.link,
+			Title:         e.post.Title,
+			ContentHTML:   e.post.HTML,
+			Summary:       e.post.Description,
+			Image:         e.post.Image,
+			DatePublished: time.Unix(e.post.GmtCreated, 0).UTC().Format(time.RFC3339),
+			DateModified:  time.Unix(e.post.GmtModified, 0).UTC().Format(time.RFC3339),
+		}
+		if e.author != nil {
+			item.Authors = []*vo.JSONFeedAuthor{{Name: e.author.Nickname, Avatar: e.author.Avatar}}
+		}
+		if e.categoryName != "" {
+			item.Tags = append(item.Tags, e.categoryName)
+		}
+		item.Tags = append(item.Tags, e.tagNames...)
+		items = append(items, item)
+	}
+
+	return &vo.JSONFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       data.title,
+		HomePageURL: data.homeURL,
+		FeedURL:     data.selfURL,
+		Description: data.description,
+		Items:       items,
+	}, data.lastModified, nil
+}
+
+// buildFeed 查询最新已发布文章并组装订阅源公共数据
+func (fs *FeedServiceImpl) buildFeed(c *app.RequestContext, req *dto.GetFeedRequest) (*feedData, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	baseURL := siteBaseURL(c, cfgs)
+	data := &feedData{
+		title:       cfgs.AppConfig.AppName,
+		description: fmt.Sprintf("Latest posts from %s", cfgs.AppConfig.AppName),
+		homeURL:     baseURL + "/",
+		selfURL:     baseURL + string(c.Request.URI().RequestURI()),
+	}
+
+	if req.CategoryID != nil {
+		category, err := fs.categoryMapper.GetCategoryByID(c, *req.CategoryID)
+		if err != nil || !category.IsActive {
+			logger.BizLogger(c).Errorf("category not found or inactive: %d", *req.CategoryID)
+			return nil, fmt.Errorf("%w: %d", service.ErrCategoryNotFound, *req.CategoryID)
+		}
+		data.title = fmt.Sprintf("%s - %s", cfgs.AppConfig.AppName, category.Name)
+		if category.Description != "" {
+			data.description = category.Description
+		}
+	}
+
+	var categoryIDs []int64
+	if req.CategoryID != nil {
+		categoryIDs = []int64{*req.CategoryID}
+	}
+	posts, _, err := fs.postMapper.ListPublishedPosts(c, 1, consts.FeedItemLimit, categoryIDs, nil, nil)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list published posts for feed: %v", err)
+		return nil, fmt.Errorf("failed to list published posts: %w", err)
+	}
+
+	postIDs := make([]int64, 0, len(posts))
+	authorIDs := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		postIDs = append(postIDs, p.ID)
+		authorIDs = append(authorIDs, p.AuthorID)
+	}
+
+	postTags, err := fs.tagMapper.ListTagsByPostIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list post tags for feed: %v", err)
+		return nil, fmt.Errorf("failed to list post tags: %w", err)
+	}
+
+	authors, err := fs.userMapper.GetUsersByIDs(c, authorIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list post authors for feed: %v", err)
+		return nil, fmt.Errorf("failed to list post authors: %w", err)
+	}
+	authorMap := make(map[int64]*user.User, len(authors))
+	for _, u := range authors {
+		authorMap[u.ID] = u
+	}
+
+	categoryNames := make(map[int64]string)
+	for _, p := range posts {
+		// lastBuildDate 取最新的文章修改时间
+		if modified := time.Unix(p.GmtModified, 0).UTC(); modified.After(data.lastModified) {
+			data.lastModified = modified
+		}
+
+		entry := &feedEntry{
+			post:   redactProtectedPost(p),
+			link:   baseURL + fmt.Sprintf(consts.PostPagePath, p.ID),
+			author: authorMap[p.AuthorID],
+		}
+		if p.CategoryID != nil {
+			name, ok := categoryNames[*p.CategoryID]
+			if !ok {
+				if category, err := fs.categoryMapper.GetCategoryByID(c, *p.CategoryID); err == nil && category.IsActive {
+					name = category.Name
+				}
+				categoryNames[*p.CategoryID] = name
+			}
+			entry.categoryName = name
+		}
+		_, entry.tagNames = tagFields(postTags[p.ID])
+
+		data.entries = append(data.entries, entry)
+	}
+
+	return data, nil
+}
+
+// siteBaseURL 获取站点根地址，优先使用配置的 SITE_URL，否则根据请求推导（优先使用反向代理传递的协议）
+func siteBaseURL(c *app.RequestContext, cfgs *configs.Config) string {
+	if siteURL := strings.TrimRight(cfgs.SEOConfig.SiteURL, "/"); siteURL != "" {
+		return siteURL
+	}
+
+	scheme := string(c.Request.Header.Peek(consts.HeaderXForwardedProto))
+	if scheme == "" {
+		scheme = string(c.Request.URI().Scheme())
+	}
+	if scheme == "" {
+		scheme = "http"
+	}
+	return strings.ToLower(scheme) + "://" + string(c.Host())
+}
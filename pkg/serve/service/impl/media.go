@@ -0,0 +1,326 @@
+// Package impl 媒体文件服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/model/media"
+	"github.com/Done-0/jank/internal/storage"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// MediaServiceImpl 媒体文件服务实现
+type MediaServiceImpl struct {
+	mediaMapper mapper.MediaMapper
+	rbacMapper  mapper.RBACMapper
+}
+
+// NewMediaService 创建媒体文件服务实例
+func NewMediaService(mediaMapperImpl mapper.MediaMapper, rbacMapperImpl mapper.RBACMapper) service.MediaService {
+	return &MediaServiceImpl{
+		mediaMapper: mediaMapperImpl,
+		rbacMapper:  rbacMapperImpl,
+	}
+}
+
+// Upload 上传媒体文件，按文件内容识别类型并校验大小，相同内容的文件只存储一份，每个上传者各自持有一条记录
+func (ms *MediaServiceImpl) Upload(c *app.RequestContext) (*vo.UploadMediaResponse, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+	uploaderID := userID.(int64)
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	mediaCfg := cfgs.MediaConfig
+
+	fileHeader, err := c.FormFile(consts.MediaFormField)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get upload file: %v", err)
+		return nil, fmt.Errorf("failed to get upload file: %w", err)
+	}
+
+	maxSizeMB := mediaCfg.MaxSize
+	if maxSizeMB <= 0 {
+		maxSizeMB = consts.MediaDefaultMaxSize
+	}
+	maxSize := maxSizeMB << 20
+	if fileHeader.Size > maxSize {
+		logger.BizLogger(c).Errorf("upload file '%s' too large: %d bytes", fileHeader.Filename, fileHeader.Size)
+		return nil, fmt.Errorf("%w: %d bytes exceeds %d MB", service.ErrMediaTooLarge, fileHeader.Size, maxSizeMB)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to open upload file '%s': %v", fileHeader.Filename, err)
+		return nil, fmt.Errorf("failed to open upload file: %w", err)
+	}
+	defer file.Close()
+
+	// 多读一个字节，防止表单声明的大小与实际内容不一致
+	data, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to read upload file '%s': %v", fileHeader.Filename, err)
+		return nil, fmt.Errorf("failed to read upload file: %w", err)
+	}
+	if int64(len(data)) > maxSize {
+		logger.BizLogger(c).Errorf("upload file '%s' too large", fileHeader.Filename)
+		return nil, fmt.Errorf("%w: exceeds %d MB", service.ErrMediaTooLarge, maxSizeMB)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("upload file is empty")
+	}
+
+	// 以文件内容识别类型，不信任客户端声明的 Content-Type 与扩展名
+	mimeType, _, err := mime.ParseMediaType(http.DetectContentType(data[:min(len(data), consts.MediaSniffLength)]))
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to detect media type of '%s': %v", fileHeader.Filename, err)
+		return nil, fmt.Errorf("failed to detect media type: %w", err)
+	}
+	allowedTypes := mediaCfg.AllowedTypes
+	if len(allowedTypes) == 0 {
+		allowedTypes = consts.MediaDefaultAllowedTypes
+	}
+	if !slices.Contains(allowedTypes, mimeType) {
+		logger.BizLogger(c).Errorf("media type %s of '%s' is not allowed", mimeType, fileHeader.Filename)
+		return nil, fmt.Errorf("%w: %s", service.ErrMediaTypeNotAllowed, mimeType)
+	}
+
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	// 同一用户重复上传相同内容时直接返回其已有记录
+	existing, err := ms.mediaMapper.GetUploaderMediaByHash(c, uploaderID, hash)
+	if err == nil {
+		logger.BizLogger(c).Infof("media with hash %s already uploaded by user %d with ID: %d", hash, uploaderID, existing.ID)
+		return &vo.UploadMediaResponse{
+			MediaItem:    mediaItem(existing),
+			Deduplicated: true,
+			Message:      "Media already exists",
+		}, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.BizLogger(c).Errorf("failed to get media by hash %s: %v", hash, err)
+		return nil, fmt.Errorf("failed to get media: %w", err)
+	}
+
+	fileName := filepath.Base(fileHeader.Filename)
+	if runes := []rune(fileName); len(runes) > 255 {
+		fileName = string(runes[:255])
+	}
+
+	record := &media.Media{
+		UploaderID: uploaderID,
+		FileName:   fileName,
+		MimeType:   mimeType,
+		Size:       int64(len(data)),
+		Hash:       hash,
+	}
+
+	// 其他用户已上传相同内容时只复用存储文件，仍为当前用户创建独立记录，存储文件在不再被任何记录引用时才删除
+	shared, err := ms.mediaMapper.GetMediaByHash(c, hash)
+	switch {
+	case err == nil:
+		record.Storage, record.StorageKey, record.URL = shared.Storage, shared.StorageKey, shared.URL
+		if err := ms.mediaMapper.CreateMedia(c, record); err != nil {
+			logger.BizLogger(c).Errorf("failed to create media record for '%s': %v", fileHeader.Filename, err)
+			return nil, fmt.Errorf("failed to create media: %w", err)
+		}
+
+		logger.BizLogger(c).Infof("media uploaded with ID: %d, reusing stored object %s", record.ID, record.StorageKey)
+
+		return &vo.UploadMediaResponse{
+			MediaItem:    mediaItem(record),
+			Deduplicated: true,
+			Message:      "Media uploaded successfully",
+		}, nil
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		logger.BizLogger(c).Errorf("failed to get media by hash %s: %v", hash, err)
+		return nil, fmt.Errorf("failed to get media: %w", err)
+	}
+
+	storageType := mediaCfg.Storage
+	if storageType == "" {
+		storageType = storage.TypeLocal
+	}
+	store, err := storage.New(storageType, &mediaCfg)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to initialize %s storage: %v", storageType, err)
+		return nil, fmt.Errorf("failed to initialize storage: %w", err)
+	}
+
+	// 以内容哈希作为 key，同一文件重复写入不会产生多份副本
+	key := fmt.Sprintf("%s/%s/%s%s", consts.MediaKeyPrefix, hash[:2], hash, consts.MediaTypeExtensions[mimeType])
+	if err := store.Put(context.Background(), key, data, mimeType); err != nil {
+		logger.BizLogger(c).Errorf("failed to store media '%s': %v", fileHeader.Filename, err)
+		return nil, fmt.Errorf("failed to store media: %w", err)
+	}
+
+	record.Storage, record.StorageKey, record.URL = storageType, key, store.URL(key)
+	if err := ms.mediaMapper.CreateMedia(c, record); err != nil {
+		logger.BizLogger(c).Errorf("failed to create media record for '%s': %v", fileHeader.Filename, err)
+		ms.removeUnreferencedObject(c, store, storageType, key)
+		return nil, fmt.Errorf("failed to create media: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("media uploaded successfully with ID: %d", record.ID)
+
+	return &vo.UploadMediaResponse{
+		MediaItem: mediaItem(record),
+		Message:   "Media uploaded successfully",
+	}, nil
+}
+
+// ListMedia 获取媒体文件列表，拥有媒体越权管理权限的用户可查看所有文件，其他用户仅能查看自己上传的文件
+func (ms *MediaServiceImpl) ListMedia(c *app.RequestContext, req *dto.ListMediaRequest) (*vo.ListMediaResponse, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+	currentUserID := userID.(int64)
+
+	canOverride, err := ms.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.MediaOverrideResource, consts.MediaOverrideAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check media override permission for user %d: %v", currentUserID, err)
+		return nil, fmt.Errorf("failed to check permission: %w", err)
+	}
+
+	var uploaderID *int64
+	if !canOverride {
+		uploaderID = &currentUserID
+	}
+
+	list, total, err := ms.mediaMapper.ListMedia(c, req.PageNo, req.PageSize, uploaderID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list media: %v", err)
+		return nil, fmt.Errorf("failed to list media: %w", err)
+	}
+
+	items := make([]*vo.MediaItem, 0, len(list))
+	for _, md := range list {
+		items = append(items, mediaItem(md))
+	}
+
+	return &vo.ListMediaResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     items,
+	}, nil
+}
+
+// Delete 删除媒体文件，仅上传者或拥有媒体越权管理权限的用户可删除
+func (ms *MediaServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteMediaRequest) (*vo.DeleteMediaResponse, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+	currentUserID := userID.(int64)
+
+	mediaID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid media ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: media ID: %w", service.ErrInvalidID, err)
+	}
+
+	md, err := ms.mediaMapper.GetMediaByID(c, mediaID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("media with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("media not found: %w", err)
+	}
+
+	if md.UploaderID != currentUserID {
+		allowed, err := ms.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.MediaOverrideResource, consts.MediaOverrideAction)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to check media override permission for user %d: %v", currentUserID, err)
+			return nil, fmt.Errorf("failed to check permission: %w", err)
+		}
+		if !allowed {
+			logger.BizLogger(c).Errorf("user %d is not allowed to delete media %d uploaded by %d", currentUserID, md.ID, md.UploaderID)
+			return nil, fmt.Errorf("%w: only the uploader can delete this media", service.ErrPermissionDenied)
+		}
+	}
+
+	if err := ms.mediaMapper.DeleteMedia(c, md.ID); err != nil {
+		logger.BizLogger(c).Errorf("failed to delete media with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to delete media: %w", err)
+	}
+
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	// 记录已删除，存储文件删除失败只记录日志
+	if store, err := storage.New(md.Storage, &cfgs.MediaConfig); err != nil {
+		logger.BizLogger(c).Warnf("failed to initialize %s storage for media %d: %v", md.Storage, md.ID, err)
+	} else {
+		ms.removeUnreferencedObject(c, store, md.Storage, md.StorageKey)
+	}
+
+	logger.BizLogger(c).Infof("media deleted successfully with ID: %s", req.ID)
+
+	return &vo.DeleteMediaResponse{
+		Message: "Media deleted successfully",
+	}, nil
+}
+
+// removeUnreferencedObject 存储文件不再被任何媒体记录引用时删除
+func (ms *MediaServiceImpl) removeUnreferencedObject(c *app.RequestContext, store storage.Storage, storageType, key string) {
+	count, err := ms.mediaMapper.CountMediaByStorageKey(c, storageType, key)
+	if err != nil {
+		logger.BizLogger(c).Warnf("failed to count references of media object %s: %v", key, err)
+		return
+	}
+	if count > 0 {
+		return
+	}
+
+	if err := store.Delete(context.Background(), key); err != nil {
+		logger.BizLogger(c).Warnf("failed to delete media object %s: %v", key, err)
+	}
+}
+
+// mediaItem 转换媒体文件列表项
+func mediaItem(md *media.Media) *vo.MediaItem {
+	return &vo.MediaItem{
+		ID:         strconv.FormatInt(md.ID, 10),
+		FileName:   md.FileName,
+		MimeType:   md.MimeType,
+		Size:       md.Size,
+		Hash:       md.Hash,
+		URL:        md.URL,
+		UploaderID: strconv.FormatInt(md.UploaderID, 10),
+		CreatedAt:  time.Unix(md.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+	}
+}
@@ -4,50 +4,92 @@
 package impl
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
 
 	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/model/user"
 	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/cursor"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/locale"
 	"github.com/Done-0/jank/internal/utils/logger"
 	"github.com/Done-0/jank/internal/utils/markdown"
+	"github.com/Done-0/jank/internal/utils/search"
+	"github.com/Done-0/jank/internal/utils/slugify"
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
 	"github.com/Done-0/jank/pkg/serve/mapper"
 	"github.com/Done-0/jank/pkg/serve/service"
 	"github.com/Done-0/jank/pkg/vo"
 )
 
+// searchSnippetRadius 检索摘要片段在命中位置前后保留的字符数
+const searchSnippetRadius = 60
+
 // PostServiceImpl 文章服务实现
 type PostServiceImpl struct {
 	postMapper     mapper.PostMapper
 	categoryMapper mapper.CategoryMapper
+	tagMapper      mapper.TagMapper
+	userMapper     mapper.UserMapper
+	rbacMapper     mapper.RBACMapper
+	slugMapper     mapper.SlugHistoryMapper
+	revisionMapper mapper.PostRevisionMapper
+	statMapper     mapper.PostStatMapper
+	reactionMapper mapper.PostReactionMapper
+	termMapper     mapper.PostTermMapper
+	seriesMapper   mapper.SeriesMapper
 }
 
 // NewPostService 创建文章服务实例
-func NewPostService(postMapperImpl mapper.PostMapper, categoryMapperImpl mapper.CategoryMapper) service.PostService {
+func NewPostService(postMapperImpl mapper.PostMapper, categoryMapperImpl mapper.CategoryMapper, tagMapperImpl mapper.TagMapper, userMapperImpl mapper.UserMapper, rbacMapperImpl mapper.RBACMapper, slugHistoryMapperImpl mapper.SlugHistoryMapper, postRevisionMapperImpl mapper.PostRevisionMapper, postStatMapperImpl mapper.PostStatMapper, postReactionMapperImpl mapper.PostReactionMapper, postTermMapperImpl mapper.PostTermMapper, seriesMapperImpl mapper.SeriesMapper) service.PostService {
 	return &PostServiceImpl{
 		postMapper:     postMapperImpl,
 		categoryMapper: categoryMapperImpl,
+		tagMapper:      tagMapperImpl,
+		userMapper:     userMapperImpl,
+		rbacMapper:     rbacMapperImpl,
+		slugMapper:     slugHistoryMapperImpl,
+		revisionMapper: postRevisionMapperImpl,
+		statMapper:     postStatMapperImpl,
+		reactionMapper: postReactionMapperImpl,
+		termMapper:     postTermMapperImpl,
+		seriesMapper:   seriesMapperImpl,
 	}
 }
 
-// GetPost 获取单篇文章
+// GetPost 获取单篇文章，支持按 ID 或 slug 查询，曾用 slug 会解析到当前文章
+// 未公开的文章仅作者与拥有文章越权管理权限的用户可读，其他人视为文章不存在，需通过预览链接阅读
+// 密码保护文章未解锁时仅返回元数据，不返回摘要、正文与目录
 func (ps *PostServiceImpl) GetPost(c *app.RequestContext, req *dto.GetPostRequest) (*vo.GetPostResponse, error) {
-	postID, err := strconv.ParseInt(req.ID, 10, 64)
+	post, err := ps.findPost(c, req)
 	if err != nil {
-		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
-		return nil, fmt.Errorf("invalid post ID format: %w", err)
+		return nil, err
+	}
+	if !ps.canReadPost(c, post) {
+		logger.BizLogger(c).Warnf("post %d with status %s is not readable by current user", post.ID, post.Status)
+		return nil, service.ErrPostNotFound
 	}
 
-	post, err := ps.postMapper.GetPostByID(c, postID)
-	if err != nil {
-		logger.BizLogger(c).Errorf("failed to get post with ID %s: %v", req.ID, err)
-		return nil, fmt.Errorf("failed to get post: %w", err)
+	if req.Lang != "" {
+		if post, err = ps.preferredTranslation(c, post, req.Lang); err != nil {
+			return nil, err
+		}
 	}
 
+	return ps.buildPostResponse(c, post, ps.isPostLocked(c, post))
+}
+
+// buildPostResponse 构建单篇文章详情，locked 为 true 时隐藏摘要、正文与目录
+func (ps *PostServiceImpl) buildPostResponse(c *app.RequestContext, post *post.Post, locked bool) (*vo.GetPostResponse, error) {
 	var categoryIDStr, categoryName string
 	if post.CategoryID != nil {
 		if category, err := ps.categoryMapper.GetCategoryByID(c, *post.CategoryID); err == nil && category.IsActive {
@@ -56,50 +98,210 @@ func (ps *PostServiceImpl) GetPost(c *app.RequestContext, req *dto.GetPostReques
 		}
 	}
 
+	postTags, err := ps.tagMapper.ListTagsByPostIDs(c, []int64{post.ID})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get tags for post %d: %v", post.ID, err)
+		return nil, fmt.Errorf("failed to get post tags: %w", err)
+	}
+	tagIDs, tagNames := tagFields(postTags[post.ID])
+
+	var author *user.User
+	if post.AuthorID != 0 {
+		author, _ = ps.userMapper.GetUserByID(c, post.AuthorID)
+	}
+	authorIDStr, authorNickname, authorAvatar := authorFields(author)
+	pinned, pinnedUntil := pinFields(post)
+
+	reactions, err := ps.postReactions(c, post.ID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get reactions for post %d: %v", post.ID, err)
+		return nil, fmt.Errorf("failed to get post reactions: %w", err)
+	}
+
+	seriesNav, err := ps.postSeriesNav(c, post)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get series navigation for post %d: %v", post.ID, err)
+		return nil, fmt.Errorf("failed to get post series: %w", err)
+	}
+
+	translations, err := ps.postTranslations(c, post)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get translations for post %d: %v", post.ID, err)
+		return nil, fmt.Errorf("failed to get post translations: %w", err)
+	}
+
+	content := post
+	if locked {
+		content = redactProtectedPost(post)
+	}
+
 	return &vo.GetPostResponse{
-		ID:           strconv.FormatInt(post.ID, 10),
-		Title:        post.Title,
-		Description:  post.Description,
-		Image:        post.Image,
-		Status:       post.Status,
-		CategoryID:   categoryIDStr,
-		CategoryName: categoryName,
-		Markdown:     post.Markdown,
-		HTML:         post.HTML,
-		CreatedAt:    time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
-		UpdatedAt:    time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		ID:                 strconv.FormatInt(post.ID, 10),
+		Title:              post.Title,
+		Slug:               post.Slug,
+		Description:        content.Description,
+		Image:              post.Image,
+		Status:             post.Status,
+		PublishAt:          formatPublishAt(post.PublishAt),
+		CategoryID:         categoryIDStr,
+		CategoryName:       categoryName,
+		TagIDs:             tagIDs,
+		TagNames:           tagNames,
+		AuthorID:           authorIDStr,
+		AuthorNickname:     authorNickname,
+		AuthorAvatar:       authorAvatar,
+		Pinned:             pinned,
+		PinnedUntil:        pinnedUntil,
+		Featured:           post.Featured,
+		Visibility:         post.Visibility,
+		Locked:             locked,
+		Markdown:           content.Markdown,
+		HTML:               content.HTML,
+		TOC:                postTOC(content.TOC),
+		Series:             seriesNav,
+		Reactions:          reactions,
+		Version:            post.Version,
+		Locale:             post.Locale,
+		TranslationGroupID: strconv.FormatInt(post.TranslationGroupID, 10),
+		Translations:       translations,
+		CreatedAt:          time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		UpdatedAt:          time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
 	}, nil
 }
 
-// ListPublishedPosts 获取已发布文章列表
+// ListPublishedPosts 获取已发布文章列表，未指定页码时使用游标分页
 func (ps *PostServiceImpl) ListPublishedPosts(c *app.RequestContext, req *dto.ListPublishedPostsRequest) (*vo.ListPostsResponse, error) {
-	posts, total, err := ps.postMapper.ListPublishedPosts(c, req.PageNo, req.PageSize, req.CategoryID)
+	categoryIDs, err := ps.resolveCategoryFilter(c, req.CategoryID, req.IncludeDescendants)
 	if err != nil {
-		logger.BizLogger(c).Errorf("failed to list posts: %v", err)
-		return nil, fmt.Errorf("failed to list posts: %w", err)
+		return nil, err
 	}
 
-	postItems := make([]*vo.PostItem, 0, len(posts))
-	for _, post := range posts {
-		var categoryIDStr, categoryName string
-		if post.CategoryID != nil {
-			if category, err := ps.categoryMapper.GetCategoryByID(c, *post.CategoryID); err == nil && category.IsActive {
-				categoryIDStr = strconv.FormatInt(*post.CategoryID, 10)
-				categoryName = category.Name
-			}
+	locales, err := locale.FallbackChain(req.Lang)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid lang '%s': %v", req.Lang, err)
+		return nil, err
+	}
+
+	var posts []*post.Post
+	var total int64
+	var nextCursor, prevCursor string
+	if req.PageNo == 0 {
+		cur, err := cursor.Decode(req.Cursor)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to decode cursor: %v", err)
+			return nil, err
 		}
+		page, count, err := ps.postMapper.ListPublishedPostsWithCursor(c, cur, req.PageSize, req.WithTotal, categoryIDs, req.TagID, locales)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list posts: %v", err)
+			return nil, fmt.Errorf("failed to list posts: %w", err)
+		}
+		posts, total = page.List, count
+		if !req.WithTotal {
+			total = cursor.TotalSkipped
+		}
+		nextCursor, prevCursor = cursor.Encode(page.Next), cursor.Encode(page.Prev)
+	} else {
+		posts, total, err = ps.postMapper.ListPublishedPosts(c, req.PageNo, req.PageSize, categoryIDs, req.TagID, locales)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list posts: %v", err)
+			return nil, fmt.Errorf("failed to list posts: %w", err)
+		}
+	}
 
-		postItems = append(postItems, &vo.PostItem{
-			ID:           strconv.FormatInt(post.ID, 10),
-			Title:        post.Title,
-			Description:  post.Description,
-			Image:        post.Image,
-			Status:       post.Status,
-			CategoryID:   categoryIDStr,
-			CategoryName: categoryName,
-			CreatedAt:    time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
-			UpdatedAt:    time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
-		})
+	postItems, err := ps.buildPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, fmt.Errorf("failed to build post items: %w", err)
+	}
+
+	return &vo.ListPostsResponse{
+		Total:      total,
+		PageNo:     req.PageNo,
+		PageSize:   req.PageSize,
+		List:       postItems,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}, nil
+}
+
+// ListPostsByStatus 根据状态获取文章列表，未指定页码时使用游标分页
+func (ps *PostServiceImpl) ListPostsByStatus(c *app.RequestContext, req *dto.ListPostsByStatusRequest) (*vo.ListPostsResponse, error) {
+	categoryIDs, err := ps.resolveCategoryFilter(c, req.CategoryID, req.IncludeDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	var lang string
+	if req.Lang != "" {
+		var ok bool
+		if lang, ok = locale.Normalize(req.Lang); !ok {
+			logger.BizLogger(c).Errorf("invalid lang '%s'", req.Lang)
+			return nil, fmt.Errorf("%w: %s", locale.ErrInvalidLocale, req.Lang)
+		}
+	}
+
+	var posts []*post.Post
+	var total int64
+	var nextCursor, prevCursor string
+	if req.PageNo == 0 {
+		cur, err := cursor.Decode(req.Cursor)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to decode cursor: %v", err)
+			return nil, err
+		}
+		page, count, err := ps.postMapper.ListPostsByStatusWithCursor(c, cur, req.PageSize, req.WithTotal, req.Status, categoryIDs, lang)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list posts by status: %v", err)
+			return nil, fmt.Errorf("failed to list posts by status: %w", err)
+		}
+		posts, total = page.List, count
+		if !req.WithTotal {
+			total = cursor.TotalSkipped
+		}
+		nextCursor, prevCursor = cursor.Encode(page.Next), cursor.Encode(page.Prev)
+	} else {
+		posts, total, err = ps.postMapper.ListPostsByStatus(c, req.PageNo, req.PageSize, req.Status, categoryIDs, lang)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list posts by status: %v", err)
+			return nil, fmt.Errorf("failed to list posts by status: %w", err)
+		}
+	}
+
+	postItems, err := ps.buildPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, fmt.Errorf("failed to build post items: %w", err)
+	}
+
+	return &vo.ListPostsResponse{
+		Total:      total,
+		PageNo:     req.PageNo,
+		PageSize:   req.PageSize,
+		List:       postItems,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}, nil
+}
+
+// ListPostsByAuthor 获取指定作者的已发布文章列表
+func (ps *PostServiceImpl) ListPostsByAuthor(c *app.RequestContext, req *dto.ListPostsByAuthorRequest) (*vo.ListPostsResponse, error) {
+	authorID, err := strconv.ParseInt(req.AuthorID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid author ID format: %s", req.AuthorID)
+		return nil, fmt.Errorf("%w: author ID: %w", service.ErrInvalidID, err)
+	}
+
+	posts, total, err := ps.postMapper.ListPublishedPostsByAuthor(c, req.PageNo, req.PageSize, authorID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list posts by author %d: %v", authorID, err)
+		return nil, fmt.Errorf("failed to list posts by author: %w", err)
+	}
+
+	postItems, err := ps.buildPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, fmt.Errorf("failed to build post items: %w", err)
 	}
 
 	return &vo.ListPostsResponse{
@@ -110,60 +312,82 @@ func (ps *PostServiceImpl) ListPublishedPosts(c *app.RequestContext, req *dto.Li
 	}, nil
 }
 
-// ListPostsByStatus 根据状态获取文章列表
-func (ps *PostServiceImpl) ListPostsByStatus(c *app.RequestContext, req *dto.ListPostsByStatusRequest) (*vo.ListPostsResponse, error) {
-	posts, total, err := ps.postMapper.ListPostsByStatus(c, req.PageNo, req.PageSize, req.Status, req.CategoryID)
+// SearchPosts 全文检索已发布文章，返回按相关度排序的结果及高亮片段
+func (ps *PostServiceImpl) SearchPosts(c *app.RequestContext, req *dto.SearchPostsRequest) (*vo.SearchPostsResponse, error) {
+	keyword := strings.TrimSpace(req.Q)
+	terms := search.Terms(keyword)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("search keyword is empty")
+	}
+
+	posts, total, err := ps.postMapper.SearchPublishedPosts(c, strings.Join(terms, " "), req.PageNo, req.PageSize)
 	if err != nil {
-		logger.BizLogger(c).Errorf("failed to list posts by status: %v", err)
-		return nil, fmt.Errorf("failed to list posts by status: %w", err)
+		logger.BizLogger(c).Errorf("failed to search posts with keyword '%s': %v", keyword, err)
+		return nil, fmt.Errorf("failed to search posts: %w", err)
 	}
 
-	postItems := make([]*vo.PostItem, 0, len(posts))
-	for _, post := range posts {
-		var categoryIDStr, categoryName string
-		if post.CategoryID != nil {
-			if category, err := ps.categoryMapper.GetCategoryByID(c, *post.CategoryID); err == nil && category.IsActive {
-				categoryIDStr = strconv.FormatInt(*post.CategoryID, 10)
-				categoryName = category.Name
-			}
-		}
+	postItems, err := ps.buildPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, fmt.Errorf("failed to build post items: %w", err)
+	}
 
-		postItems = append(postItems, &vo.PostItem{
-			ID:           strconv.FormatInt(post.ID, 10),
-			Title:        post.Title,
-			Description:  post.Description,
-			Image:        post.Image,
-			Status:       post.Status,
-			CategoryID:   categoryIDStr,
-			CategoryName: categoryName,
-			CreatedAt:    time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
-			UpdatedAt:    time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
+	list := make([]*vo.SearchPostItem, 0, len(posts))
+	for i, p := range posts {
+		list = append(list, &vo.SearchPostItem{
+			PostItem:       postItems[i],
+			TitleHighlight: search.Highlight(p.Title, terms),
+			Snippet:        search.Snippet(search.PlainText(redactProtectedPost(p).HTML), terms, searchSnippetRadius),
 		})
 	}
 
-	return &vo.ListPostsResponse{
+	return &vo.SearchPostsResponse{
 		Total:    total,
 		PageNo:   req.PageNo,
 		PageSize: req.PageSize,
-		List:     postItems,
+		List:     list,
 	}, nil
 }
 
 // Create 创建文章
 func (ps *PostServiceImpl) Create(c *app.RequestContext, req *dto.CreatePostRequest) (*vo.CreatePostResponse, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+
 	status := req.Status
 	if status == "" {
 		status = consts.PostStatusDraft
 	}
 
-	var htmlContent string
-	if req.Markdown != "" {
-		html, err := markdown.RenderMarkdown([]byte(req.Markdown))
-		if err != nil {
-			logger.BizLogger(c).Errorf("failed to render markdown for post '%s': %v", req.Title, err)
-			return nil, fmt.Errorf("failed to render markdown: %w", err)
+	var publishAt *int64
+	if status == consts.PostStatusScheduled {
+		var err error
+		if publishAt, err = resolvePublishAt(req.PublishAt, nil); err != nil {
+			logger.BizLogger(c).Errorf("invalid publish time for post '%s': %v", req.Title, err)
+			return nil, err
+		}
+	}
+
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = consts.PostVisibilityPublic
+	}
+	var passwordHash string
+	if visibility == consts.PostVisibilityPassword {
+		var err error
+		if passwordHash, err = hashPostPassword(req.Password); err != nil {
+			logger.BizLogger(c).Errorf("failed to hash password for post '%s': %v", req.Title, err)
+			return nil, err
 		}
-		htmlContent = html
+	}
+
+	rendered, err := ps.renderContent(c, req.Markdown, userID.(int64))
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to render markdown for post '%s': %v", req.Title, err)
+		return nil, fmt.Errorf("failed to render markdown: %w", err)
 	}
 
 	var categoryID *int64
@@ -171,34 +395,72 @@ func (ps *PostServiceImpl) Create(c *app.RequestContext, req *dto.CreatePostRequ
 		parsedCategoryID, err := strconv.ParseInt(req.CategoryID, 10, 64)
 		if err != nil {
 			logger.BizLogger(c).Errorf("invalid category ID format: %s", req.CategoryID)
-			return nil, fmt.Errorf("invalid category ID format: %w", err)
+			return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
 		}
 
 		_, err = ps.categoryMapper.GetCategoryByID(c, parsedCategoryID)
 		if err != nil {
 			logger.BizLogger(c).Errorf("category with ID %d does not exist: %v", parsedCategoryID, err)
-			return nil, fmt.Errorf("category with ID %d does not exist", parsedCategoryID)
+			return nil, fmt.Errorf("%w: %d", service.ErrCategoryNotFound, parsedCategoryID)
 		}
 
 		categoryID = &parsedCategoryID
 	}
 
+	tags, err := ps.resolveTags(c, req.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	postSlug, err := ps.resolvePostSlug(c, req.Slug, req.Title, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	post := &post.Post{
-		Title:       req.Title,
-		Description: req.Description,
-		Image:       req.Image,
-		Status:      status,
-		CategoryID:  categoryID,
-		Markdown:    req.Markdown,
-		HTML:        htmlContent,
+		Title:        req.Title,
+		Slug:         postSlug,
+		Description:  req.Description,
+		Image:        req.Image,
+		Status:       status,
+		PublishAt:    publishAt,
+		Visibility:   visibility,
+		PasswordHash: passwordHash,
+		CategoryID:   categoryID,
+		AuthorID:     userID.(int64),
+		Markdown:     req.Markdown,
 	}
+	applyRendered(post, rendered)
 
-	if err := ps.postMapper.CreatePost(c, post); err != nil {
+	if err := ps.applyPostTranslation(c, post, req.Locale, req.TranslationOf); err != nil {
+		return nil, err
+	}
+
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := ps.postMapper.CreatePost(c, post); err != nil {
+			return nil, err
+		}
+		// 新 slug 若曾被其他文章使用过，则不再跳转到旧文章
+		if err := ps.slugMapper.DeleteSlugHistory(c, consts.SlugEntityPost, post.Slug); err != nil {
+			return nil, err
+		}
+		if _, err := ps.recordRevision(c, nil, post, post.AuthorID); err != nil {
+			return nil, err
+		}
+		if err := ps.indexPostTerms(c, post); err != nil {
+			return nil, err
+		}
+		return nil, ps.tagMapper.SetPostTags(c, post.ID, tagModelIDs(tags))
+	})
+	if err != nil {
 		logger.BizLogger(c).Errorf("failed to create post '%s': %v", req.Title, err)
 		return nil, fmt.Errorf("failed to create post: %w", err)
 	}
 
 	logger.BizLogger(c).Infof("post created successfully with ID: %d", post.ID)
+	invalidateSEOCache(c)
+	invalidateRelatedCache(c)
+	clearAutosave(c, 0)
 
 	var categoryIDStr, categoryName string
 	if post.CategoryID != nil {
@@ -208,16 +470,26 @@ func (ps *PostServiceImpl) Create(c *app.RequestContext, req *dto.CreatePostRequ
 		}
 	}
 
+	tagIDs, tagNames := tagFields(tags)
+
 	return &vo.CreatePostResponse{
-		ID:           strconv.FormatInt(post.ID, 10),
-		Title:        post.Title,
-		Description:  post.Description,
-		Image:        post.Image,
-		Status:       post.Status,
-		CategoryID:   categoryIDStr,
-		CategoryName: categoryName,
-		Markdown:     post.Markdown,
-		Message:      "Post created successfully",
+		ID:                 strconv.FormatInt(post.ID, 10),
+		Title:              post.Title,
+		Slug:               post.Slug,
+		Description:        post.Description,
+		Image:              post.Image,
+		Status:             post.Status,
+		PublishAt:          formatPublishAt(post.PublishAt),
+		Visibility:         post.Visibility,
+		CategoryID:         categoryIDStr,
+		CategoryName:       categoryName,
+		TagIDs:             tagIDs,
+		TagNames:           tagNames,
+		Markdown:           post.Markdown,
+		Version:            post.Version,
+		Locale:             post.Locale,
+		TranslationGroupID: strconv.FormatInt(post.TranslationGroupID, 10),
+		Message:            "Post created successfully",
 	}, nil
 }
 
@@ -226,7 +498,7 @@ func (ps *PostServiceImpl) Update(c *app.RequestContext, req *dto.UpdatePostRequ
 	postID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
-		return nil, fmt.Errorf("invalid post ID format: %w", err)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
 	}
 
 	existingPost, err := ps.postMapper.GetPostByID(c, postID)
@@ -235,6 +507,18 @@ func (ps *PostServiceImpl) Update(c *app.RequestContext, req *dto.UpdatePostRequ
 		return nil, fmt.Errorf("failed to get existing post: %w", err)
 	}
 
+	if err := ps.checkPostOwnership(c, existingPost); err != nil {
+		return nil, err
+	}
+
+	if err := ps.checkPostVersion(c, existingPost, req.Version); err != nil {
+		return nil, err
+	}
+
+	// 保留更新前的内容，用于历史文章首次编辑时补存基线修订
+	previous := *existingPost
+	userID, _ := c.Get(consts.JWTSubjectClaim)
+
 	// 更新字段（只更新非空字段）
 	if req.Title != "" {
 		existingPost.Title = req.Title
@@ -245,40 +529,139 @@ func (ps *PostServiceImpl) Update(c *app.RequestContext, req *dto.UpdatePostRequ
 	if req.Image != "" {
 		existingPost.Image = req.Image
 	}
+	statusChanged := req.Status != "" && req.Status != existingPost.Status
 	if req.Status != "" {
 		existingPost.Status = req.Status
 	}
+	if existingPost.Status == consts.PostStatusScheduled {
+		// 切换为定时发布或修改发布时间时校验发布时间
+		if statusChanged || req.PublishAt != "" {
+			publishAt, err := resolvePublishAt(req.PublishAt, existingPost.PublishAt)
+			if err != nil {
+				logger.BizLogger(c).Errorf("invalid publish time for post ID %s: %v", req.ID, err)
+				return nil, err
+			}
+			existingPost.PublishAt = publishAt
+		}
+	} else if statusChanged {
+		existingPost.PublishAt = nil
+	}
+	if req.Visibility != "" {
+		existingPost.Visibility = req.Visibility
+	}
+	if existingPost.Visibility == consts.PostVisibilityPassword {
+		// 切换为密码保护时必须设置密码，已是密码保护时传入密码则修改密码
+		if req.Password != "" {
+			passwordHash, err := hashPostPassword(req.Password)
+			if err != nil {
+				logger.BizLogger(c).Errorf("failed to hash password for post ID %s: %v", req.ID, err)
+				return nil, err
+			}
+			existingPost.PasswordHash = passwordHash
+		} else if existingPost.PasswordHash == "" {
+			return nil, fmt.Errorf("password is required for password-protected posts")
+		}
+	} else {
+		existingPost.PasswordHash = ""
+	}
+	oldSlug := existingPost.Slug
+	if req.Slug != "" && req.Slug != oldSlug {
+		newSlug, err := ps.resolvePostSlug(c, req.Slug, existingPost.Title, existingPost.ID)
+		if err != nil {
+			return nil, err
+		}
+		existingPost.Slug = newSlug
+	}
 	if req.Markdown != "" {
 		existingPost.Markdown = req.Markdown
-		html, err := markdown.RenderMarkdown([]byte(req.Markdown))
+		rendered, err := ps.renderContent(c, req.Markdown, existingPost.AuthorID)
 		if err != nil {
 			logger.BizLogger(c).Errorf("failed to render markdown for post ID %s: %v", req.ID, err)
 			return nil, fmt.Errorf("failed to render markdown: %w", err)
 		}
-		existingPost.HTML = html
+		applyRendered(existingPost, rendered)
 	}
 	if req.CategoryID != "" {
 		parsedCategoryID, err := strconv.ParseInt(req.CategoryID, 10, 64)
 		if err != nil {
 			logger.BizLogger(c).Errorf("invalid category ID format: %s", req.CategoryID)
-			return nil, fmt.Errorf("invalid category ID format: %w", err)
+			return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
 		}
 
 		_, err = ps.categoryMapper.GetCategoryByID(c, parsedCategoryID)
 		if err != nil {
 			logger.BizLogger(c).Errorf("category with ID %d does not exist: %v", parsedCategoryID, err)
-			return nil, fmt.Errorf("category with ID %d does not exist", parsedCategoryID)
+			return nil, fmt.Errorf("%w: %d", service.ErrCategoryNotFound, parsedCategoryID)
 		}
 
 		existingPost.CategoryID = &parsedCategoryID
 	}
+	if err := ps.applyPostTranslation(c, existingPost, req.Locale, req.TranslationOf); err != nil {
+		return nil, err
+	}
+
+	// 未传 tag_ids 时保留原有标签
+	var tags []*tag.Tag
+	if req.TagIDs != nil {
+		tags, err = ps.resolveTags(c, req.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		postTags, err := ps.tagMapper.ListTagsByPostIDs(c, []int64{existingPost.ID})
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get tags for post %s: %v", req.ID, err)
+			return nil, fmt.Errorf("failed to get post tags: %w", err)
+		}
+		tags = postTags[existingPost.ID]
+	}
 
-	if err := ps.postMapper.UpdatePost(c, existingPost); err != nil {
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := ps.postMapper.UpdatePost(c, existingPost); err != nil {
+			return nil, err
+		}
+		if _, err := ps.recordRevision(c, &previous, existingPost, userID.(int64)); err != nil {
+			return nil, err
+		}
+		if existingPost.Title != previous.Title || existingPost.Markdown != previous.Markdown {
+			if err := ps.indexPostTerms(c, existingPost); err != nil {
+				return nil, err
+			}
+		}
+		if existingPost.Slug != oldSlug {
+			if err := ps.slugMapper.DeleteSlugHistory(c, consts.SlugEntityPost, existingPost.Slug); err != nil {
+				return nil, err
+			}
+			if oldSlug != "" {
+				if err := ps.slugMapper.SaveSlugHistory(c, consts.SlugEntityPost, existingPost.ID, oldSlug); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if req.TagIDs == nil {
+			return nil, nil
+		}
+		return nil, ps.tagMapper.SetPostTags(c, existingPost.ID, tagModelIDs(tags))
+	})
+	if err != nil {
+		// 读取文章后、写入前被他人修改，以最新内容返回冲突
+		if errors.Is(err, mapper.ErrPostVersionConflict) {
+			current, getErr := ps.postMapper.GetPostByID(c, postID)
+			if getErr != nil {
+				logger.BizLogger(c).Errorf("failed to reload conflicting post with ID %s: %v", req.ID, getErr)
+				return nil, fmt.Errorf("%w: %w", service.ErrPostNotFound, getErr)
+			}
+			logger.BizLogger(c).Warnf("post %s was modified concurrently, current version %d", req.ID, current.Version)
+			return nil, ps.postVersionConflict(c, current, previous.Version)
+		}
 		logger.BizLogger(c).Errorf("failed to update post with ID %s: %v", req.ID, err)
 		return nil, fmt.Errorf("failed to update post: %w", err)
 	}
 
 	logger.BizLogger(c).Infof("post updated successfully with ID: %s", req.ID)
+	invalidateSEOCache(c)
+	invalidateRelatedCache(c)
+	clearAutosave(c, existingPost.ID)
 
 	var categoryIDStr, categoryName string
 	if existingPost.CategoryID != nil {
@@ -288,16 +671,26 @@ func (ps *PostServiceImpl) Update(c *app.RequestContext, req *dto.UpdatePostRequ
 		}
 	}
 
+	tagIDs, tagNames := tagFields(tags)
+
 	return &vo.UpdatePostResponse{
-		ID:           strconv.FormatInt(existingPost.ID, 10),
-		Title:        existingPost.Title,
-		Description:  existingPost.Description,
-		Image:        existingPost.Image,
-		Status:       existingPost.Status,
-		CategoryID:   categoryIDStr,
-		CategoryName: categoryName,
-		Markdown:     existingPost.Markdown,
-		Message:      "Post updated successfully",
+		ID:                 strconv.FormatInt(existingPost.ID, 10),
+		Title:              existingPost.Title,
+		Slug:               existingPost.Slug,
+		Description:        existingPost.Description,
+		Image:              existingPost.Image,
+		Status:             existingPost.Status,
+		PublishAt:          formatPublishAt(existingPost.PublishAt),
+		Visibility:         existingPost.Visibility,
+		CategoryID:         categoryIDStr,
+		CategoryName:       categoryName,
+		TagIDs:             tagIDs,
+		TagNames:           tagNames,
+		Markdown:           existingPost.Markdown,
+		Version:            existingPost.Version,
+		Locale:             existingPost.Locale,
+		TranslationGroupID: strconv.FormatInt(existingPost.TranslationGroupID, 10),
+		Message:            "Post updated successfully",
 	}, nil
 }
 
@@ -306,13 +699,17 @@ func (ps *PostServiceImpl) Delete(c *app.RequestContext, req *dto.DeletePostRequ
 	postID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
-		return nil, fmt.Errorf("invalid post ID format: %w", err)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
 	}
 
-	_, err = ps.postMapper.GetPostByID(c, postID)
+	existingPost, err := ps.postMapper.GetPostByID(c, postID)
 	if err != nil {
 		logger.BizLogger(c).Errorf("post with ID %s not found: %v", req.ID, err)
-		return nil, fmt.Errorf("post not found: %w", err)
+		return nil, fmt.Errorf("%w: %w", service.ErrPostNotFound, err)
+	}
+
+	if err := ps.checkPostOwnership(c, existingPost); err != nil {
+		return nil, err
 	}
 
 	if err := ps.postMapper.DeletePost(c, postID); err != nil {
@@ -321,8 +718,328 @@ func (ps *PostServiceImpl) Delete(c *app.RequestContext, req *dto.DeletePostRequ
 	}
 
 	logger.BizLogger(c).Infof("post deleted successfully with ID: %s", req.ID)
+	invalidateSEOCache(c)
+	invalidateRelatedCache(c)
 
 	return &vo.DeletePostResponse{
 		Message: "Post deleted successfully",
 	}, nil
 }
+
+// findPost 按 ID 或 slug 查询文章，slug 未命中时查找曾用 slug 记录
+func (ps *PostServiceImpl) findPost(c *app.RequestContext, req *dto.GetPostRequest) (*post.Post, error) {
+	if req.ID != "" {
+		postID, err := strconv.ParseInt(req.ID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
+			return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+		}
+
+		post, err := ps.postMapper.GetPostByID(c, postID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get post with ID %s: %v", req.ID, err)
+			return nil, postLookupError(err)
+		}
+		return post, nil
+	}
+
+	if post, err := ps.postMapper.GetPostBySlug(c, req.Slug); err == nil {
+		return post, nil
+	}
+
+	history, err := ps.slugMapper.GetSlugHistory(c, consts.SlugEntityPost, req.Slug)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get post with slug %s: %v", req.Slug, err)
+		return nil, postLookupError(err)
+	}
+
+	post, err := ps.postMapper.GetPostByID(c, history.EntityID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get post with ID %d for slug %s: %v", history.EntityID, req.Slug, err)
+		return nil, postLookupError(err)
+	}
+	return post, nil
+}
+
+// postLookupError 将文章查询错误中的记录不存在转换为 service.ErrPostNotFound，其他错误原样包装
+func postLookupError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return service.ErrPostNotFound
+	}
+	return fmt.Errorf("failed to get post: %w", err)
+}
+
+// resolvePostSlug 确定文章 slug：显式指定时校验唯一性，否则根据标题生成并自动追加序号去重
+func (ps *PostServiceImpl) resolvePostSlug(c *app.RequestContext, explicit, title string, excludeID int64) (string, error) {
+	taken := func(s string) (bool, error) {
+		return ps.postMapper.IsPostSlugTaken(c, s, excludeID)
+	}
+
+	if explicit != "" {
+		exists, err := taken(explicit)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to check post slug '%s': %v", explicit, err)
+			return "", fmt.Errorf("failed to check post slug: %w", err)
+		}
+		if exists {
+			logger.BizLogger(c).Errorf("post slug '%s' already exists", explicit)
+			return "", fmt.Errorf("%w: %s", service.ErrSlugConflict, explicit)
+		}
+		return explicit, nil
+	}
+
+	base := slugify.Make(title)
+	if base == "" {
+		base = consts.SlugEntityPost
+	}
+	postSlug, err := slugify.Unique(base, taken)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to generate slug for post '%s': %v", title, err)
+		return "", fmt.Errorf("failed to generate post slug: %w", err)
+	}
+	return postSlug, nil
+}
+
+// checkPostOwnership 校验当前用户是否为文章作者，非作者需拥有文章越权管理权限
+func (ps *PostServiceImpl) checkPostOwnership(c *app.RequestContext, p *post.Post) error {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return service.ErrAuthenticationRequired
+	}
+
+	currentUserID := userID.(int64)
+	if p.AuthorID == currentUserID {
+		return nil
+	}
+
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.PostOverrideResource, consts.PostOverrideAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check post override permission for user %d: %v", currentUserID, err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user ID %d attempted to modify post %d owned by user %d", currentUserID, p.ID, p.AuthorID)
+		return fmt.Errorf("%w: only the author can modify this post", service.ErrPermissionDenied)
+	}
+
+	return nil
+}
+
+// renderContent 渲染文章 Markdown，按作者角色是否拥有受信任 HTML 权限选择清洗策略
+func (ps *PostServiceImpl) renderContent(c *app.RequestContext, content string, authorID int64) (*markdown.Result, error) {
+	trusted := false
+	if authorID != 0 {
+		var err error
+		trusted, err = ps.rbacMapper.CheckPermission(c, strconv.FormatInt(authorID, 10), consts.HTMLTrustedResource, consts.HTMLTrustedAction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check html permission for user %d: %w", authorID, err)
+		}
+	}
+
+	return markdown.Render([]byte(content), trusted)
+}
+
+// resolveTags 解析标签 ID 列表并校验标签是否存在
+func (ps *PostServiceImpl) resolveTags(c *app.RequestContext, rawTagIDs []string) ([]*tag.Tag, error) {
+	tagIDs := make([]int64, 0, len(rawTagIDs))
+	seen := make(map[int64]struct{}, len(rawTagIDs))
+	for _, rawTagID := range rawTagIDs {
+		tagID, err := strconv.ParseInt(rawTagID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid tag ID format: %s", rawTagID)
+			return nil, fmt.Errorf("%w: tag ID: %w", service.ErrInvalidID, err)
+		}
+		if _, ok := seen[tagID]; ok {
+			continue
+		}
+		seen[tagID] = struct{}{}
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	tags, err := ps.tagMapper.GetTagsByIDs(c, tagIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get tags %v: %v", tagIDs, err)
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	if len(tags) != len(tagIDs) {
+		logger.BizLogger(c).Errorf("some of tags %v do not exist", tagIDs)
+		return nil, fmt.Errorf("some of the specified tags do not exist")
+	}
+
+	return tags, nil
+}
+
+// resolveCategoryFilter 解析文章列表的分类筛选条件，includeDescendants 为 true 时包含全部子孙分类
+func (ps *PostServiceImpl) resolveCategoryFilter(c *app.RequestContext, categoryID *int64, includeDescendants bool) ([]int64, error) {
+	if categoryID == nil {
+		return nil, nil
+	}
+	if !includeDescendants {
+		return []int64{*categoryID}, nil
+	}
+
+	categories, err := ps.categoryMapper.ListAllCategories(c)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list categories: %v", err)
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	return categoryDescendantIDs(categories, *categoryID), nil
+}
+
+// buildPostItems 构建文章列表项，批量填充分类、标签和作者信息，密码保护文章不返回摘要
+func (ps *PostServiceImpl) buildPostItems(c *app.RequestContext, posts []*post.Post) ([]*vo.PostItem, error) {
+	postIDs := make([]int64, 0, len(posts))
+	authorIDs := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		postIDs = append(postIDs, p.ID)
+		authorIDs = append(authorIDs, p.AuthorID)
+	}
+
+	postTags, err := ps.tagMapper.ListTagsByPostIDs(c, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post tags: %w", err)
+	}
+
+	authors, err := ps.userMapper.GetUsersByIDs(c, authorIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post authors: %w", err)
+	}
+	authorMap := make(map[int64]*user.User, len(authors))
+	for _, u := range authors {
+		authorMap[u.ID] = u
+	}
+
+	views, err := ps.statMapper.GetTotalViewsByPostIDs(c, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post views: %w", err)
+	}
+
+	postItems := make([]*vo.PostItem, 0, len(posts))
+	for _, post := range posts {
+		var categoryIDStr, categoryName string
+		if post.CategoryID != nil {
+			if category, err := ps.categoryMapper.GetCategoryByID(c, *post.CategoryID); err == nil && category.IsActive {
+				categoryIDStr = strconv.FormatInt(*post.CategoryID, 10)
+				categoryName = category.Name
+			}
+		}
+
+		tagIDs, tagNames := tagFields(postTags[post.ID])
+		authorIDStr, authorNickname, authorAvatar := authorFields(authorMap[post.AuthorID])
+		pinned, pinnedUntil := pinFields(post)
+
+		postItems = append(postItems, &vo.PostItem{
+			ID:                 strconv.FormatInt(post.ID, 10),
+			Title:              post.Title,
+			Slug:               post.Slug,
+			Description:        redactProtectedPost(post).Description,
+			Image:              post.Image,
+			Status:             post.Status,
+			PublishAt:          formatPublishAt(post.PublishAt),
+			CategoryID:         categoryIDStr,
+			CategoryName:       categoryName,
+			TagIDs:             tagIDs,
+			TagNames:           tagNames,
+			AuthorID:           authorIDStr,
+			AuthorNickname:     authorNickname,
+			AuthorAvatar:       authorAvatar,
+			Pinned:             pinned,
+			PinnedUntil:        pinnedUntil,
+			Featured:           post.Featured,
+			Visibility:         post.Visibility,
+			ViewCount:          views[post.ID],
+			Locale:             post.Locale,
+			TranslationGroupID: strconv.FormatInt(post.TranslationGroupID, 10),
+			CreatedAt:          time.Unix(post.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt:          time.Unix(post.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return postItems, nil
+}
+
+// resolvePublishAt 解析定时发布时间，未传入时沿用当前值，发布时间必须晚于当前时间
+func resolvePublishAt(raw string, current *int64) (*int64, error) {
+	publishAt := current
+	if raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid publish_at format: %w", err)
+		}
+		unix := t.Unix()
+		publishAt = &unix
+	}
+
+	if publishAt == nil {
+		return nil, fmt.Errorf("publish_at is required for scheduled posts")
+	}
+	if *publishAt <= time.Now().Unix() {
+		return nil, fmt.Errorf("publish_at must be in the future")
+	}
+	return publishAt, nil
+}
+
+// applyRendered 写入渲染结果，目录序列化为 JSON 与文章一同保存；无目录时保存为 "[]"，避免 Updates 忽略空值导致旧目录残留
+func applyRendered(p *post.Post, rendered *markdown.Result) {
+	p.HTML = rendered.HTML
+	p.RenderVersion = rendered.Version
+	toc, _ := json.Marshal(rendered.TOC)
+	p.TOC = string(toc)
+}
+
+// postTOC 解析文章保存的目录，未生成目录时返回空列表
+func postTOC(raw string) []*vo.TOCItem {
+	toc := make([]*vo.TOCItem, 0)
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &toc)
+	}
+	return toc
+}
+
+// formatPublishAt 格式化定时发布时间，未设置时返回空字符串
+func formatPublishAt(publishAt *int64) string {
+	if publishAt == nil {
+		return ""
+	}
+	return time.Unix(*publishAt, 0).Format("2006-01-02 15:04:05")
+}
+
+// pinFields 提取置顶状态与到期时间，已过期的置顶视为未置顶
+func pinFields(p *post.Post) (bool, string) {
+	if !p.Pinned || (p.PinnedUntil != nil && *p.PinnedUntil <= time.Now().Unix()) {
+		return false, ""
+	}
+	if p.PinnedUntil == nil {
+		return true, ""
+	}
+	return true, time.Unix(*p.PinnedUntil, 0).Format("2006-01-02 15:04:05")
+}
+
+// tagFields 提取标签 ID 和名称列表
+func tagFields(tags []*tag.Tag) ([]string, []string) {
+	tagIDs := make([]string, 0, len(tags))
+	tagNames := make([]string, 0, len(tags))
+	for _, t := range tags {
+		tagIDs = append(tagIDs, strconv.FormatInt(t.ID, 10))
+		tagNames = append(tagNames, t.Name)
+	}
+	return tagIDs, tagNames
+}
+
+// tagModelIDs 提取标签模型 ID 列表
+func tagModelIDs(tags []*tag.Tag) []int64 {
+	tagIDs := make([]int64, 0, len(tags))
+	for _, t := range tags {
+		tagIDs = append(tagIDs, t.ID)
+	}
+	return tagIDs
+}
+
+// authorFields 提取作者 ID、昵称和头像，作者不存在时返回空值
+func authorFields(u *user.User) (string, string, string) {
+	if u == nil {
+		return "", "", ""
+	}
+	return strconv.FormatInt(u.ID, 10), u.Nickname, u.Avatar
+}
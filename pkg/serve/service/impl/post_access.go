@@ -0,0 +1,257 @@
+// Package impl 文章访问控制服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// Unlock 校验密码保护文章的访问密码，通过后下发短期有效的签名 Cookie
+func (ps *PostServiceImpl) Unlock(c *app.RequestContext, req *dto.UnlockPostRequest) (*vo.UnlockPostResponse, error) {
+	postID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	p, err := ps.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("%w: %w", service.ErrPostNotFound, err)
+	}
+	if p.Visibility != consts.PostVisibilityPassword {
+		return nil, fmt.Errorf("post is not password protected")
+	}
+
+	attemptKey := postUnlockAttemptKey(p.ID, c.ClientIP())
+	if unlockAttemptsExceeded(c, attemptKey) {
+		logger.BizLogger(c).Warnf("too many unlock attempts for post %d from %s", p.ID, c.ClientIP())
+		return nil, fmt.Errorf("%w: try again in %v", service.ErrTooManyAttempts, consts.PostUnlockAttemptWindow)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(p.PasswordHash), []byte(req.Password)); err != nil {
+		logger.BizLogger(c).Warnf("incorrect password for post %d", p.ID)
+		recordUnlockFailure(c, attemptKey)
+		return nil, fmt.Errorf("incorrect post password")
+	}
+	clearUnlockFailures(c, attemptKey)
+
+	expiresAt := time.Now().Add(consts.PostUnlockTTL).Unix()
+	token, err := signPostUnlock(p, expiresAt)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to sign unlock token for post %d: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to sign unlock token: %w", err)
+	}
+
+	c.SetCookie(postUnlockCookieName(p.ID), token, int(consts.PostUnlockTTL.Seconds()), "/", "", protocol.CookieSameSiteLaxMode, isHTTPSRequest(c), true)
+
+	return &vo.UnlockPostResponse{
+		ID:        strconv.FormatInt(p.ID, 10),
+		ExpiresAt: time.Unix(expiresAt, 0).Format("2006-01-02 15:04:05"),
+		Message:   "Post unlocked successfully",
+	}, nil
+}
+
+// unlockAttemptsExceeded 判断同一 IP 对文章的解锁失败次数是否已达上限，未配置 Redis 或读取失败时不做限制
+func unlockAttemptsExceeded(c *app.RequestContext, key string) bool {
+	if global.RedisClient == nil {
+		return false
+	}
+	failures, err := global.RedisClient.Get(context.Background(), key).Int64()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			logger.BizLogger(c).Warnf("failed to get unlock attempts: %v", err)
+		}
+		return false
+	}
+	return failures >= consts.PostUnlockMaxAttempts
+}
+
+// recordUnlockFailure 累加解锁失败次数，首次失败时开始计算统计窗口
+func recordUnlockFailure(c *app.RequestContext, key string) {
+	if global.RedisClient == nil {
+		return
+	}
+	ctx := context.Background()
+	failures, err := global.RedisClient.Incr(ctx, key).Result()
+	if err != nil {
+		logger.BizLogger(c).Warnf("failed to record unlock failure: %v", err)
+		return
+	}
+	if failures == 1 {
+		if err := global.RedisClient.Expire(ctx, key, consts.PostUnlockAttemptWindow).Err(); err != nil {
+			logger.BizLogger(c).Warnf("failed to set unlock attempts expiration: %v", err)
+		}
+	}
+}
+
+// clearUnlockFailures 解锁成功后清除失败次数
+func clearUnlockFailures(c *app.RequestContext, key string) {
+	if global.RedisClient == nil {
+		return
+	}
+	if err := global.RedisClient.Del(context.Background(), key).Err(); err != nil {
+		logger.BizLogger(c).Warnf("failed to clear unlock attempts: %v", err)
+	}
+}
+
+// canReadPost 判断当前请求能否阅读文章：已发布与已归档的文章对所有人可见，
+// 草稿、私有与定时发布的文章仅作者与拥有文章越权管理权限的用户可读
+func (ps *PostServiceImpl) canReadPost(c *app.RequestContext, p *post.Post) bool {
+	if p.Status == consts.PostStatusPublished || p.Status == consts.PostStatusArchived {
+		return true
+	}
+
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		return false
+	}
+	currentUserID := userID.(int64)
+	if p.AuthorID == currentUserID {
+		return true
+	}
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.PostOverrideResource, consts.PostOverrideAction)
+	if err != nil {
+		logger.BizLogger(c).Warnf("failed to check post override permission for user %d: %v", currentUserID, err)
+	}
+	return allowed
+}
+
+// isPostLocked 判断当前请求是否无权阅读密码保护文章的内容
+// 作者、拥有文章越权管理权限的用户与持有有效解锁凭证的读者可以阅读
+func (ps *PostServiceImpl) isPostLocked(c *app.RequestContext, p *post.Post) bool {
+	if p.Visibility != consts.PostVisibilityPassword {
+		return false
+	}
+
+	if userID, exists := c.Get(consts.JWTSubjectClaim); exists {
+		currentUserID := userID.(int64)
+		if p.AuthorID == currentUserID {
+			return false
+		}
+		allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.PostOverrideResource, consts.PostOverrideAction)
+		if err != nil {
+			logger.BizLogger(c).Warnf("failed to check post override permission for user %d: %v", currentUserID, err)
+		}
+		if allowed {
+			return false
+		}
+	}
+
+	return !verifyPostUnlock(p, string(c.Cookie(postUnlockCookieName(p.ID))))
+}
+
+// hashPostPassword 生成文章访问密码的 bcrypt 哈希
+func hashPostPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash post password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// redactProtectedPost 返回隐藏摘要与正文的文章副本，用于列表、订阅源等不校验解锁状态的场景，非密码保护文章原样返回
+func redactProtectedPost(p *post.Post) *post.Post {
+	if p.Visibility != consts.PostVisibilityPassword {
+		return p
+	}
+	redacted := *p
+	redacted.Description = ""
+	redacted.Markdown = ""
+	redacted.HTML = ""
+	redacted.TOC = ""
+	return &redacted
+}
+
+// postUnlockAttemptKey 返回按文章与客户端 IP 统计解锁失败次数的缓存键
+func postUnlockAttemptKey(postID int64, clientIP string) string {
+	return fmt.Sprintf("%s:%d:%s", consts.PostUnlockAttemptKeyPrefix, postID, clientIP)
+}
+
+// postUnlockCookieName 返回文章解锁凭证的 Cookie 名称
+func postUnlockCookieName(postID int64) string {
+	return consts.PostUnlockCookiePrefix + strconv.FormatInt(postID, 10)
+}
+
+// signPostUnlock 生成解锁凭证，格式为 "过期时间.签名"
+// 签名覆盖文章 ID、过期时间与密码哈希，修改密码后已下发的凭证随即失效
+func signPostUnlock(p *post.Post, expiresAt int64) (string, error) {
+	mac, err := postUnlockMAC(p, expiresAt)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(expiresAt, 10) + "." + hex.EncodeToString(mac), nil
+}
+
+// verifyPostUnlock 校验解锁凭证的签名与有效期
+func verifyPostUnlock(p *post.Post, token string) bool {
+	rawExpiresAt, rawMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	expiresAt, err := strconv.ParseInt(rawExpiresAt, 10, 64)
+	if err != nil || expiresAt <= time.Now().Unix() {
+		return false
+	}
+	got, err := hex.DecodeString(rawMAC)
+	if err != nil {
+		return false
+	}
+	want, err := postUnlockMAC(p, expiresAt)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, want)
+}
+
+// postUnlockMAC 计算解锁凭证签名
+func postUnlockMAC(p *post.Post, expiresAt int64) ([]byte, error) {
+	return secretMAC(fmt.Sprintf("unlock|%d|%d|%s", p.ID, expiresAt, p.PasswordHash))
+}
+
+// secretMAC 使用 JWT 签名密钥计算 HMAC-SHA256 签名
+func secretMAC(message string) ([]byte, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	if cfgs.AppConfig.JWT.Secret == "" {
+		return nil, fmt.Errorf("jwt secret is not configured")
+	}
+
+	h := hmac.New(sha256.New, []byte(cfgs.AppConfig.JWT.Secret))
+	h.Write([]byte(message))
+	return h.Sum(nil), nil
+}
+
+// isHTTPSRequest 判断请求是否经由 HTTPS 到达，兼容反向代理转发的协议头
+func isHTTPSRequest(c *app.RequestContext) bool {
+	scheme := string(c.Request.Header.Peek(consts.HeaderXForwardedProto))
+	if scheme == "" {
+		scheme = string(c.Request.URI().Scheme())
+	}
+	return strings.EqualFold(scheme, "https")
+}
@@ -0,0 +1,308 @@
+// Package impl 文章草稿自动保存与编辑冲突检测服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// postAutosaveRecord 自动保存的草稿在 Redis 中的记录
+type postAutosaveRecord struct {
+	BaseVersion int64    `json:"base_version"` // 草稿所基于的文章版本号
+	Title       string   `json:"title"`        // 文章标题
+	Description string   `json:"description"`  // 文章描述/摘要
+	Image       string   `json:"image"`        // 文章封面图片
+	CategoryID  string   `json:"category_id"`  // 分类 ID
+	TagIDs      []string `json:"tag_ids"`      // 标签 ID 列表
+	Markdown    string   `json:"markdown"`     // Markdown 内容
+	SavedAt     int64    `json:"saved_at"`     // 保存时间（Unix 秒）
+}
+
+// Autosave 自动保存当前用户的文章草稿，草稿保留 consts.PostAutosaveTTL，重复保存会覆盖并重新计时
+// 草稿所基于的版本落后于文章当前版本时仍会保存，并在响应中标记 stale
+func (ps *PostServiceImpl) Autosave(c *app.RequestContext, req *dto.AutosavePostRequest) (*vo.AutosavePostResponse, error) {
+	if global.RedisClient == nil {
+		return nil, fmt.Errorf("%w: redis is not configured", service.ErrAutosaveUnavailable)
+	}
+
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+
+	var postID, currentVersion int64
+	if req.PostID != "" {
+		existingPost, err := ps.getOwnedPost(c, req.PostID)
+		if err != nil {
+			return nil, err
+		}
+		postID, currentVersion = existingPost.ID, existingPost.Version
+	}
+
+	now := time.Now()
+	record := &postAutosaveRecord{
+		BaseVersion: req.BaseVersion,
+		Title:       req.Title,
+		Description: req.Description,
+		Image:       req.Image,
+		CategoryID:  req.CategoryID,
+		TagIDs:      req.TagIDs,
+		Markdown:    req.Markdown,
+		SavedAt:     now.Unix(),
+	}
+	content, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal autosave: %w", err)
+	}
+	if err := global.RedisClient.Set(context.Background(), postAutosaveKey(userID.(int64), postID), content, consts.PostAutosaveTTL).Err(); err != nil {
+		logger.BizLogger(c).Errorf("failed to save autosave of post %d for user %d: %v", postID, userID.(int64), err)
+		return nil, fmt.Errorf("failed to save autosave: %w", err)
+	}
+
+	return &vo.AutosavePostResponse{
+		PostID:         req.PostID,
+		BaseVersion:    record.BaseVersion,
+		CurrentVersion: currentVersion,
+		Stale:          isAutosaveStale(record.BaseVersion, currentVersion),
+		SavedAt:        now.Format("2006-01-02 15:04:05"),
+		ExpiresAt:      now.Add(consts.PostAutosaveTTL).Format("2006-01-02 15:04:05"),
+		Message:        "Draft saved successfully",
+	}, nil
+}
+
+// GetAutosave 获取当前用户自动保存的文章草稿，文章已删除时仍可取回草稿内容
+func (ps *PostServiceImpl) GetAutosave(c *app.RequestContext, req *dto.GetPostAutosaveRequest) (*vo.GetPostAutosaveResponse, error) {
+	if global.RedisClient == nil {
+		return nil, fmt.Errorf("%w: redis is not configured", service.ErrAutosaveUnavailable)
+	}
+
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+
+	postID, err := parseAutosavePostID(req.PostID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, err
+	}
+
+	var currentVersion int64
+	if postID != 0 {
+		existingPost, err := ps.postMapper.GetPostByID(c, postID)
+		switch {
+		case err == nil:
+			currentVersion = existingPost.Version
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			logger.BizLogger(c).Errorf("failed to get post with ID %s: %v", req.PostID, err)
+			return nil, fmt.Errorf("failed to get post: %w", err)
+		}
+	}
+
+	ctx := context.Background()
+	key := postAutosaveKey(userID.(int64), postID)
+	content, err := global.RedisClient.Get(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, service.ErrAutosaveNotFound
+		}
+		logger.BizLogger(c).Errorf("failed to get autosave of post %d for user %d: %v", postID, userID.(int64), err)
+		return nil, fmt.Errorf("failed to get autosave: %w", err)
+	}
+	ttl, err := global.RedisClient.TTL(ctx, key).Result()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get autosave ttl of post %d for user %d: %v", postID, userID.(int64), err)
+		return nil, fmt.Errorf("failed to get autosave: %w", err)
+	}
+
+	record := new(postAutosaveRecord)
+	if err := json.Unmarshal([]byte(content), record); err != nil {
+		logger.BizLogger(c).Errorf("failed to unmarshal autosave of post %d for user %d: %v", postID, userID.(int64), err)
+		return nil, fmt.Errorf("failed to unmarshal autosave: %w", err)
+	}
+
+	return &vo.GetPostAutosaveResponse{
+		PostID: req.PostID,
+		Draft: &vo.PostAutosaveDraft{
+			Title:       record.Title,
+			Description: record.Description,
+			Image:       record.Image,
+			CategoryID:  record.CategoryID,
+			TagIDs:      record.TagIDs,
+			Markdown:    record.Markdown,
+		},
+		BaseVersion:    record.BaseVersion,
+		CurrentVersion: currentVersion,
+		Stale:          isAutosaveStale(record.BaseVersion, currentVersion),
+		SavedAt:        time.Unix(record.SavedAt, 0).Format("2006-01-02 15:04:05"),
+		ExpiresAt:      time.Now().Add(ttl).Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// DiscardAutosave 丢弃当前用户自动保存的文章草稿，草稿不存在时视为成功
+func (ps *PostServiceImpl) DiscardAutosave(c *app.RequestContext, req *dto.DiscardPostAutosaveRequest) (*vo.DiscardPostAutosaveResponse, error) {
+	if global.RedisClient == nil {
+		return nil, fmt.Errorf("%w: redis is not configured", service.ErrAutosaveUnavailable)
+	}
+
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+	postID, err := parseAutosavePostID(req.PostID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, err
+	}
+
+	if err := global.RedisClient.Del(context.Background(), postAutosaveKey(userID.(int64), postID)).Err(); err != nil {
+		logger.BizLogger(c).Errorf("failed to discard autosave of post %d for user %d: %v", postID, userID.(int64), err)
+		return nil, fmt.Errorf("failed to discard autosave: %w", err)
+	}
+
+	return &vo.DiscardPostAutosaveResponse{
+		PostID:  req.PostID,
+		Message: "Draft discarded successfully",
+	}, nil
+}
+
+// clearAutosave 文章保存成功后清除当前用户对应的自动保存草稿，postID 为 0 时清除新文章的草稿，失败只记录日志
+func clearAutosave(c *app.RequestContext, postID int64) {
+	if global.RedisClient == nil {
+		return
+	}
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		return
+	}
+	if err := global.RedisClient.Del(context.Background(), postAutosaveKey(userID.(int64), postID)).Err(); err != nil {
+		logger.BizLogger(c).Warnf("failed to clear autosave of post %d for user %d: %v", postID, userID.(int64), err)
+	}
+}
+
+// checkPostVersion 校验编辑所基于的版本与文章当前版本一致
+// 优先使用请求中的版本号，未提供时使用 If-Match 请求头，均未提供时不校验
+func (ps *PostServiceImpl) checkPostVersion(c *app.RequestContext, p *post.Post, version int64) error {
+	if version == 0 {
+		ifMatch := strings.TrimSpace(string(c.GetHeader(consts.HeaderIfMatch)))
+		if ifMatch == "" || ifMatch == "*" {
+			return nil
+		}
+		for _, etag := range strings.Split(ifMatch, ",") {
+			v, err := parsePostETag(etag)
+			if err != nil {
+				return err
+			}
+			if v == p.Version {
+				return nil
+			}
+			if version == 0 {
+				version = v
+			}
+		}
+	}
+	if version == p.Version {
+		return nil
+	}
+
+	logger.BizLogger(c).Warnf("stale edit of post %d: expected version %d, current version %d", p.ID, version, p.Version)
+	return ps.postVersionConflict(c, p, version)
+}
+
+// postVersionConflict 构造携带服务端当前文章副本的编辑冲突错误
+func (ps *PostServiceImpl) postVersionConflict(c *app.RequestContext, p *post.Post, expected int64) error {
+	var categoryID string
+	if p.CategoryID != nil {
+		categoryID = strconv.FormatInt(*p.CategoryID, 10)
+	}
+
+	tagIDs := []string{}
+	postTags, err := ps.tagMapper.ListTagsByPostIDs(c, []int64{p.ID})
+	if err != nil {
+		logger.BizLogger(c).Warnf("failed to get tags for conflicting post %d: %v", p.ID, err)
+	} else {
+		tagIDs, _ = tagFields(postTags[p.ID])
+	}
+
+	return &service.PostVersionConflictError{
+		Conflict: &vo.PostVersionConflict{
+			ExpectedVersion: expected,
+			CurrentVersion:  p.Version,
+			Current: &vo.PostServerCopy{
+				ID:          strconv.FormatInt(p.ID, 10),
+				Version:     p.Version,
+				Title:       p.Title,
+				Slug:        p.Slug,
+				Description: p.Description,
+				Image:       p.Image,
+				Status:      p.Status,
+				PublishAt:   formatPublishAt(p.PublishAt),
+				Visibility:  p.Visibility,
+				CategoryID:  categoryID,
+				TagIDs:      tagIDs,
+				Markdown:    p.Markdown,
+				UpdatedAt:   time.Unix(p.GmtModified, 0).Format("2006-01-02 15:04:05"),
+			},
+		},
+	}
+}
+
+// parsePostETag 解析 If-Match 中的文章 ETag，弱校验标签不能用于条件更新
+func parsePostETag(etag string) (int64, error) {
+	etag = strings.TrimSpace(etag)
+	if strings.HasPrefix(etag, "W/") {
+		return 0, fmt.Errorf("%w: weak entity tags are not allowed", service.ErrInvalidIfMatch)
+	}
+	var version int64
+	if _, err := fmt.Sscanf(etag, consts.PostETagFormat, &version); err != nil || version < 1 || fmt.Sprintf(consts.PostETagFormat, version) != etag {
+		return 0, fmt.Errorf("%w: %s", service.ErrInvalidIfMatch, etag)
+	}
+	return version, nil
+}
+
+// isAutosaveStale 判断草稿所基于的版本是否已落后于文章当前版本，新文章的草稿不会过期
+func isAutosaveStale(baseVersion, currentVersion int64) bool {
+	return baseVersion > 0 && currentVersion > 0 && baseVersion != currentVersion
+}
+
+// parseAutosavePostID 解析草稿所属的文章 ID，为空表示新文章，返回 0
+func parseAutosavePostID(raw string) (int64, error) {
+	if raw == "" {
+		return 0, nil
+	}
+	postID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+	return postID, nil
+}
+
+// postAutosaveKey 返回用户文章草稿的缓存键，postID 为 0 表示新文章，使用 consts.PostAutosaveNewDraftID
+func postAutosaveKey(userID, postID int64) string {
+	if postID == 0 {
+		return fmt.Sprintf("%s:%d:%s", consts.PostAutosaveKeyPrefix, userID, consts.PostAutosaveNewDraftID)
+	}
+	return fmt.Sprintf("%s:%d:%d", consts.PostAutosaveKeyPrefix, userID, postID)
+}
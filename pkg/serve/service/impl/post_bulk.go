@@ -0,0 +1,215 @@
+// Package impl 文章批量操作服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// Bulk 批量操作文章：先逐篇校验文章存在、当前用户有权管理且操作适用，再在同一事务内逐篇执行
+// 默认每篇文章在独立的保存点内执行，单篇失败只回滚该篇；all_or_nothing 时任一文章失败则整体回滚
+// dry_run 时只做校验，返回每篇文章的预期结果
+func (ps *PostServiceImpl) Bulk(c *app.RequestContext, req *dto.BulkPostsRequest) (*vo.BulkPostsResponse, error) {
+	if _, exists := c.Get(consts.JWTSubjectClaim); !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+
+	var categoryID int64
+	if req.Operation == consts.PostBulkOpCategory {
+		var err error
+		if categoryID, err = strconv.ParseInt(req.CategoryID, 10, 64); err != nil {
+			logger.BizLogger(c).Errorf("invalid category ID format: %s", req.CategoryID)
+			return nil, fmt.Errorf("%w: category ID: %w", service.ErrInvalidID, err)
+		}
+		if _, err := ps.categoryMapper.GetCategoryByID(c, categoryID); err != nil {
+			logger.BizLogger(c).Errorf("category with ID %d does not exist: %v", categoryID, err)
+			return nil, fmt.Errorf("%w: %d", service.ErrCategoryNotFound, categoryID)
+		}
+	}
+
+	results := make([]*vo.BulkPostResult, len(req.IDs))
+	postIDs := make([]int64, len(req.IDs))
+	seen := make(map[int64]struct{}, len(req.IDs))
+	for i, raw := range req.IDs {
+		results[i] = &vo.BulkPostResult{ID: raw, Success: true}
+		postID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			results[i].Success, results[i].Error = false, "invalid post ID format"
+			continue
+		}
+		if _, ok := seen[postID]; ok {
+			results[i].Success, results[i].Error = false, "duplicate post ID"
+			continue
+		}
+		seen[postID] = struct{}{}
+		postIDs[i] = postID
+	}
+
+	posts, err := ps.postMapper.GetPostsByIDsWithDeleted(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get posts for bulk %s: %v", req.Operation, err)
+		return nil, fmt.Errorf("failed to get posts: %w", err)
+	}
+	postMap := make(map[int64]*post.Post, len(posts))
+	for _, p := range posts {
+		postMap[p.ID] = p
+	}
+
+	// 逐篇校验，targets 中校验未通过的位置为 nil
+	targets := make([]*post.Post, len(req.IDs))
+	for i, result := range results {
+		if !result.Success {
+			continue
+		}
+		p := postMap[postIDs[i]]
+		if err := ps.checkBulkTarget(c, req.Operation, p); err != nil {
+			result.Success, result.Error = false, err.Error()
+			continue
+		}
+		targets[i] = p
+	}
+
+	response := &vo.BulkPostsResponse{
+		Operation: req.Operation,
+		DryRun:    req.DryRun,
+		Results:   results,
+	}
+	valid := countBulkSucceeded(results)
+	switch {
+	case req.DryRun:
+		return finishBulkResponse(response, "Dry run completed, no changes were made"), nil
+	case valid == 0:
+		return finishBulkResponse(response, "No posts were changed"), nil
+	case req.AllOrNothing && valid < len(results):
+		rollbackBulkResults(results, "not applied: other posts failed validation")
+		return finishBulkResponse(response, "Bulk operation aborted, no changes were made"), nil
+	}
+
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		tx := db.GetDBFromContext(c)
+		for i, p := range targets {
+			if p == nil {
+				continue
+			}
+			savepoint := "bulk_post_" + strconv.Itoa(i)
+			if !req.AllOrNothing {
+				if err := tx.SavePoint(savepoint).Error; err != nil {
+					return nil, err
+				}
+			}
+			if err := ps.applyBulkOperation(c, req.Operation, req.Status, categoryID, p); err != nil {
+				logger.BizLogger(c).Errorf("failed to %s post %d in bulk: %v", req.Operation, p.ID, err)
+				results[i].Success, results[i].Error = false, err.Error()
+				if req.AllOrNothing {
+					return nil, fmt.Errorf("post %d: %w", p.ID, err)
+				}
+				if err := tx.RollbackTo(savepoint).Error; err != nil {
+					return nil, err
+				}
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		if req.AllOrNothing {
+			rollbackBulkResults(results, "rolled back: other posts failed")
+			logger.BizLogger(c).Warnf("bulk %s on %d posts rolled back: %v", req.Operation, len(results), err)
+			return finishBulkResponse(response, "Bulk operation rolled back, no changes were made"), nil
+		}
+		logger.BizLogger(c).Errorf("failed to run bulk %s: %v", req.Operation, err)
+		return nil, fmt.Errorf("failed to run bulk operation: %w", err)
+	}
+
+	response.Committed = true
+	finishBulkResponse(response, "Bulk operation completed")
+	logger.BizLogger(c).Infof("bulk %s completed: %d succeeded, %d failed", req.Operation, response.Succeeded, response.Failed)
+	if response.Succeeded > 0 {
+		invalidateSEOCache(c)
+		invalidateRelatedCache(c)
+	}
+
+	return response, nil
+}
+
+// checkBulkTarget 校验文章能否执行批量操作：恢复操作要求文章已删除，其余操作要求文章未删除
+func (ps *PostServiceImpl) checkBulkTarget(c *app.RequestContext, operation string, p *post.Post) error {
+	if p == nil {
+		return service.ErrPostNotFound
+	}
+	if operation == consts.PostBulkOpRestore {
+		if !p.Deleted {
+			return fmt.Errorf("post is not deleted")
+		}
+	} else if p.Deleted {
+		return service.ErrPostNotFound
+	}
+	return ps.checkPostOwnership(c, p)
+}
+
+// applyBulkOperation 对单篇文章执行批量操作，须在事务内调用
+func (ps *PostServiceImpl) applyBulkOperation(c *app.RequestContext, operation, status string, categoryID int64, p *post.Post) error {
+	switch operation {
+	case consts.PostBulkOpStatus:
+		// 批量修改状态不支持定时发布，原有的定时发布时间一并清空
+		p.Status = status
+		p.PublishAt = nil
+		return ps.postMapper.UpdatePost(c, p)
+	case consts.PostBulkOpCategory:
+		p.CategoryID = &categoryID
+		return ps.postMapper.UpdatePost(c, p)
+	case consts.PostBulkOpDelete:
+		return ps.postMapper.DeletePost(c, p.ID)
+	case consts.PostBulkOpRestore:
+		return ps.restoreTrashedPost(c, p)
+	case consts.PostBulkOpRerender:
+		rendered, err := ps.renderContent(c, p.Markdown, p.AuthorID)
+		if err != nil {
+			return fmt.Errorf("failed to render markdown: %w", err)
+		}
+		applyRendered(p, rendered)
+		return ps.postMapper.UpdatePost(c, p)
+	default:
+		return fmt.Errorf("unsupported bulk operation: %s", operation)
+	}
+}
+
+// rollbackBulkResults 将已成功的结果标记为未生效
+func rollbackBulkResults(results []*vo.BulkPostResult, reason string) {
+	for _, result := range results {
+		if result.Success {
+			result.Success, result.Error = false, reason
+		}
+	}
+}
+
+// countBulkSucceeded 统计成功的结果数量
+func countBulkSucceeded(results []*vo.BulkPostResult) int {
+	succeeded := 0
+	for _, result := range results {
+		if result.Success {
+			succeeded++
+		}
+	}
+	return succeeded
+}
+
+// finishBulkResponse 汇总成功与失败数量并设置结果消息
+func finishBulkResponse(response *vo.BulkPostsResponse, message string) *vo.BulkPostsResponse {
+	response.Succeeded = countBulkSucceeded(response.Results)
+	response.Failed = len(response.Results) - response.Succeeded
+	response.Message = message
+	return response
+}
@@ -0,0 +1,233 @@
+// Package impl 文章置顶与精选服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// 置顶与精选排序列表类型
+const (
+	reorderTypePinned   = "pinned"   // 置顶文章
+	reorderTypeFeatured = "featured" // 精选文章
+)
+
+// ListFeaturedPosts 获取已发布的精选文章列表，按精选权重倒序
+func (ps *PostServiceImpl) ListFeaturedPosts(c *app.RequestContext, req *dto.ListFeaturedPostsRequest) (*vo.ListPostsResponse, error) {
+	posts, total, err := ps.postMapper.ListFeaturedPosts(c, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list featured posts: %v", err)
+		return nil, fmt.Errorf("failed to list featured posts: %w", err)
+	}
+
+	postItems, err := ps.buildPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, fmt.Errorf("failed to build post items: %w", err)
+	}
+
+	return &vo.ListPostsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     postItems,
+	}, nil
+}
+
+// Pin 设置或取消文章置顶，置顶文章在公开列表中排在最前，到期后自动失效
+func (ps *PostServiceImpl) Pin(c *app.RequestContext, req *dto.PinPostRequest) (*vo.PinPostResponse, error) {
+	if err := ps.checkCuratePermission(c); err != nil {
+		return nil, err
+	}
+
+	postID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	if _, err := ps.postMapper.GetPostByID(c, postID); err != nil {
+		logger.BizLogger(c).Errorf("post with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("%w: %w", service.ErrPostNotFound, err)
+	}
+
+	var pinnedUntil *int64
+	var pinnedUntilStr string
+	weight := req.Weight
+	if req.Pinned && req.PinnedUntil != "" {
+		t, err := time.Parse(time.RFC3339, req.PinnedUntil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pinned_until format: %w", err)
+		}
+		if t.Unix() <= time.Now().Unix() {
+			return nil, fmt.Errorf("pinned_until must be in the future")
+		}
+		unix := t.Unix()
+		pinnedUntil = &unix
+		pinnedUntilStr = time.Unix(unix, 0).Format("2006-01-02 15:04:05")
+	}
+	if !req.Pinned {
+		weight = 0
+	}
+
+	if err := ps.postMapper.UpdatePostPin(c, postID, req.Pinned, pinnedUntil, weight); err != nil {
+		logger.BizLogger(c).Errorf("failed to update pin of post %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update post pin: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("post %s pinned set to %t", req.ID, req.Pinned)
+
+	return &vo.PinPostResponse{
+		ID:          req.ID,
+		Pinned:      req.Pinned,
+		PinnedUntil: pinnedUntilStr,
+		Weight:      weight,
+		Message:     "Post pin updated successfully",
+	}, nil
+}
+
+// Feature 设置或取消文章精选
+func (ps *PostServiceImpl) Feature(c *app.RequestContext, req *dto.FeaturePostRequest) (*vo.FeaturePostResponse, error) {
+	if err := ps.checkCuratePermission(c); err != nil {
+		return nil, err
+	}
+
+	postID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	if _, err := ps.postMapper.GetPostByID(c, postID); err != nil {
+		logger.BizLogger(c).Errorf("post with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("%w: %w", service.ErrPostNotFound, err)
+	}
+
+	weight := req.Weight
+	if !req.Featured {
+		weight = 0
+	}
+
+	if err := ps.postMapper.UpdatePostFeature(c, postID, req.Featured, weight); err != nil {
+		logger.BizLogger(c).Errorf("failed to update feature of post %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update post feature: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("post %s featured set to %t", req.ID, req.Featured)
+
+	return &vo.FeaturePostResponse{
+		ID:       req.ID,
+		Featured: req.Featured,
+		Weight:   weight,
+		Message:  "Post feature updated successfully",
+	}, nil
+}
+
+// ReorderPosts 批量调整置顶或精选文章顺序，按列表顺序重新分配权重，排在前面的权重更高
+// 列表中的文章须已置顶或已精选，全部调整在同一事务内完成
+func (ps *PostServiceImpl) ReorderPosts(c *app.RequestContext, req *dto.ReorderPostsRequest) (*vo.ReorderPostsResponse, error) {
+	if err := ps.checkCuratePermission(c); err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]int64, 0, len(req.PostIDs))
+	seen := make(map[int64]struct{}, len(req.PostIDs))
+	for _, raw := range req.PostIDs {
+		postID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid post ID format: %s", raw)
+			return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+		}
+		if _, ok := seen[postID]; ok {
+			return nil, fmt.Errorf("duplicate post ID: %d", postID)
+		}
+		seen[postID] = struct{}{}
+		postIDs = append(postIDs, postID)
+	}
+
+	posts, err := ps.postMapper.GetPostsByIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get posts to reorder: %v", err)
+		return nil, fmt.Errorf("failed to get posts: %w", err)
+	}
+	postMap := make(map[int64]*post.Post, len(posts))
+	for _, p := range posts {
+		postMap[p.ID] = p
+	}
+	for _, postID := range postIDs {
+		p, ok := postMap[postID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %d", service.ErrPostNotFound, postID)
+		}
+		if req.Type == reorderTypePinned && !p.Pinned {
+			return nil, fmt.Errorf("post %d is not pinned", postID)
+		}
+		if req.Type == reorderTypeFeatured && !p.Featured {
+			return nil, fmt.Errorf("post %d is not featured", postID)
+		}
+	}
+
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		for i, postID := range postIDs {
+			weight := int64(len(postIDs) - i)
+			p := postMap[postID]
+			if req.Type == reorderTypePinned {
+				if err := ps.postMapper.UpdatePostPin(c, postID, true, p.PinnedUntil, weight); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if err := ps.postMapper.UpdatePostFeature(c, postID, true, weight); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to reorder %s posts: %v", req.Type, err)
+		return nil, fmt.Errorf("failed to reorder posts: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("%d %s posts reordered successfully", len(postIDs), req.Type)
+
+	return &vo.ReorderPostsResponse{
+		Type:    req.Type,
+		PostIDs: formatIDs(postIDs),
+		Message: "Posts reordered successfully",
+	}, nil
+}
+
+// checkCuratePermission 校验当前用户是否拥有文章推荐管理权限
+func (ps *PostServiceImpl) checkCuratePermission(c *app.RequestContext) error {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return service.ErrAuthenticationRequired
+	}
+
+	currentUserID := userID.(int64)
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.PostCurateResource, consts.PostCurateAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check post curate permission for user %d: %v", currentUserID, err)
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user ID %d attempted to curate posts without permission", currentUserID)
+		return fmt.Errorf("%w: post curation is not allowed", service.ErrPermissionDenied)
+	}
+
+	return nil
+}
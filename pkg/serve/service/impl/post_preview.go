@@ -0,0 +1,293 @@
+// Package impl 文章预览链接服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// postPreviewRecord 预览令牌在 Redis 中的记录
+type postPreviewRecord struct {
+	TokenID   string `json:"token_id"`   // 令牌 ID
+	PostID    int64  `json:"post_id"`    // 文章 ID
+	CreatedBy int64  `json:"created_by"` // 创建者用户 ID
+	CreatedAt int64  `json:"created_at"` // 创建时间（Unix 秒）
+	ExpiresAt int64  `json:"expires_at"` // 过期时间（Unix 秒）
+}
+
+// CreatePreview 为文章创建有效期有限、可撤销的预览链接，持有链接者无需登录即可阅读草稿等不公开的文章
+func (ps *PostServiceImpl) CreatePreview(c *app.RequestContext, req *dto.CreatePostPreviewRequest) (*vo.CreatePostPreviewResponse, error) {
+	if global.RedisClient == nil {
+		return nil, fmt.Errorf("preview links are unavailable: redis is not configured")
+	}
+
+	existingPost, err := ps.getOwnedPost(c, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := c.Get(consts.JWTSubjectClaim)
+
+	ttl := consts.PostPreviewDefaultTTL
+	if req.ExpiresIn > 0 {
+		ttl = time.Duration(req.ExpiresIn) * time.Second
+	}
+
+	ctx := context.Background()
+	indexKey := postPreviewIndexKey(existingPost.ID)
+	now := time.Now()
+	if err := global.RedisClient.ZRemRangeByScore(ctx, indexKey, "-inf", strconv.FormatInt(now.Unix(), 10)).Err(); err != nil {
+		logger.BizLogger(c).Errorf("failed to prune preview tokens of post %d: %v", existingPost.ID, err)
+		return nil, fmt.Errorf("failed to prune preview tokens: %w", err)
+	}
+	count, err := global.RedisClient.ZCard(ctx, indexKey).Result()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count preview tokens of post %d: %v", existingPost.ID, err)
+		return nil, fmt.Errorf("failed to count preview tokens: %w", err)
+	}
+	if count >= consts.PostPreviewMaxTokens {
+		return nil, fmt.Errorf("too many preview links: at most %d active links per post", consts.PostPreviewMaxTokens)
+	}
+
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate preview token: %w", err)
+	}
+	record := &postPreviewRecord{
+		TokenID:   hex.EncodeToString(raw),
+		PostID:    existingPost.ID,
+		CreatedBy: userID.(int64),
+		CreatedAt: now.Unix(),
+		ExpiresAt: now.Add(ttl).Unix(),
+	}
+	item, err := postPreviewItem(record)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to sign preview token for post %d: %v", existingPost.ID, err)
+		return nil, fmt.Errorf("failed to sign preview token: %w", err)
+	}
+
+	content, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal preview token: %w", err)
+	}
+	// 索引保留到最晚可能过期的令牌之后，过期成员在每次读写时清理
+	pipe := global.RedisClient.TxPipeline()
+	pipe.Set(ctx, postPreviewTokenKey(record.TokenID), content, ttl)
+	pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(record.ExpiresAt), Member: record.TokenID})
+	pipe.Expire(ctx, indexKey, consts.PostPreviewMaxTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.BizLogger(c).Errorf("failed to save preview token for post %d: %v", existingPost.ID, err)
+		return nil, fmt.Errorf("failed to save preview token: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("preview link %s created for post %d", record.TokenID, existingPost.ID)
+
+	return &vo.CreatePostPreviewResponse{
+		PostID:  strconv.FormatInt(existingPost.ID, 10),
+		Preview: item,
+		Message: "Preview link created successfully",
+	}, nil
+}
+
+// ListPreviews 获取文章未过期的预览链接
+func (ps *PostServiceImpl) ListPreviews(c *app.RequestContext, req *dto.ListPostPreviewsRequest) (*vo.ListPostPreviewsResponse, error) {
+	if global.RedisClient == nil {
+		return nil, fmt.Errorf("preview links are unavailable: redis is not configured")
+	}
+
+	existingPost, err := ps.getOwnedPost(c, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	indexKey := postPreviewIndexKey(existingPost.ID)
+	if err := global.RedisClient.ZRemRangeByScore(ctx, indexKey, "-inf", strconv.FormatInt(time.Now().Unix(), 10)).Err(); err != nil {
+		logger.BizLogger(c).Errorf("failed to prune preview tokens of post %d: %v", existingPost.ID, err)
+		return nil, fmt.Errorf("failed to prune preview tokens: %w", err)
+	}
+	tokenIDs, err := global.RedisClient.ZRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list preview tokens of post %d: %v", existingPost.ID, err)
+		return nil, fmt.Errorf("failed to list preview tokens: %w", err)
+	}
+
+	list := make([]*vo.PostPreviewItem, 0, len(tokenIDs))
+	if len(tokenIDs) > 0 {
+		keys := make([]string, 0, len(tokenIDs))
+		for _, tokenID := range tokenIDs {
+			keys = append(keys, postPreviewTokenKey(tokenID))
+		}
+		values, err := global.RedisClient.MGet(ctx, keys...).Result()
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get preview tokens of post %d: %v", existingPost.ID, err)
+			return nil, fmt.Errorf("failed to get preview tokens: %w", err)
+		}
+		for _, value := range values {
+			content, ok := value.(string)
+			if !ok {
+				continue
+			}
+			record := new(postPreviewRecord)
+			if err := json.Unmarshal([]byte(content), record); err != nil || record.PostID != existingPost.ID {
+				continue
+			}
+			item, err := postPreviewItem(record)
+			if err != nil {
+				return nil, fmt.Errorf("failed to sign preview token: %w", err)
+			}
+			list = append(list, item)
+		}
+	}
+
+	return &vo.ListPostPreviewsResponse{
+		PostID: strconv.FormatInt(existingPost.ID, 10),
+		List:   list,
+	}, nil
+}
+
+// RevokePreview 撤销文章预览链接，撤销后链接立即失效
+func (ps *PostServiceImpl) RevokePreview(c *app.RequestContext, req *dto.RevokePostPreviewRequest) (*vo.RevokePostPreviewResponse, error) {
+	if global.RedisClient == nil {
+		return nil, fmt.Errorf("preview links are unavailable: redis is not configured")
+	}
+
+	existingPost, err := ps.getOwnedPost(c, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := getPostPreviewRecord(req.TokenID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get preview token %s: %v", req.TokenID, err)
+		return nil, err
+	}
+	if record.PostID != existingPost.ID {
+		return nil, fmt.Errorf("preview link not found")
+	}
+
+	ctx := context.Background()
+	pipe := global.RedisClient.TxPipeline()
+	pipe.Del(ctx, postPreviewTokenKey(record.TokenID))
+	pipe.ZRem(ctx, postPreviewIndexKey(existingPost.ID), record.TokenID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.BizLogger(c).Errorf("failed to revoke preview token %s: %v", record.TokenID, err)
+		return nil, fmt.Errorf("failed to revoke preview token: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("preview link %s of post %d revoked", record.TokenID, existingPost.ID)
+
+	return &vo.RevokePostPreviewResponse{
+		PostID:  strconv.FormatInt(existingPost.ID, 10),
+		TokenID: record.TokenID,
+		Message: "Preview link revoked successfully",
+	}, nil
+}
+
+// Preview 通过预览令牌获取文章，不受文章状态与访问密码限制
+func (ps *PostServiceImpl) Preview(c *app.RequestContext, req *dto.PreviewPostRequest) (*vo.GetPostResponse, error) {
+	if global.RedisClient == nil {
+		return nil, fmt.Errorf("preview links are unavailable: redis is not configured")
+	}
+
+	tokenID, signature, ok := strings.Cut(req.Token, ".")
+	if !ok {
+		return nil, fmt.Errorf("invalid preview token")
+	}
+	record, err := getPostPreviewRecord(tokenID)
+	if err != nil {
+		logger.BizLogger(c).Warnf("failed to get preview token %s: %v", tokenID, err)
+		return nil, err
+	}
+	if record.ExpiresAt <= time.Now().Unix() {
+		return nil, fmt.Errorf("preview link not found")
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid preview token")
+	}
+	want, err := postPreviewMAC(record)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to sign preview token %s: %v", tokenID, err)
+		return nil, fmt.Errorf("failed to verify preview token: %w", err)
+	}
+	if !hmac.Equal(got, want) {
+		logger.BizLogger(c).Warnf("preview token %s has an invalid signature", tokenID)
+		return nil, fmt.Errorf("invalid preview token")
+	}
+
+	p, err := ps.postMapper.GetPostByID(c, record.PostID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %d not found for preview: %v", record.PostID, err)
+		return nil, fmt.Errorf("%w: %w", service.ErrPostNotFound, err)
+	}
+
+	return ps.buildPostResponse(c, p, false)
+}
+
+// getPostPreviewRecord 从 Redis 读取预览令牌记录
+func getPostPreviewRecord(tokenID string) (*postPreviewRecord, error) {
+	content, err := global.RedisClient.Get(context.Background(), postPreviewTokenKey(tokenID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, fmt.Errorf("preview link not found")
+		}
+		return nil, fmt.Errorf("failed to get preview token: %w", err)
+	}
+
+	record := new(postPreviewRecord)
+	if err := json.Unmarshal(content, record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal preview token: %w", err)
+	}
+	return record, nil
+}
+
+// postPreviewItem 构建预览链接项，令牌由记录重新签名得到，无需在 Redis 中保存完整令牌
+func postPreviewItem(record *postPreviewRecord) (*vo.PostPreviewItem, error) {
+	mac, err := postPreviewMAC(record)
+	if err != nil {
+		return nil, err
+	}
+	return &vo.PostPreviewItem{
+		TokenID:   record.TokenID,
+		Token:     record.TokenID + "." + hex.EncodeToString(mac),
+		CreatedBy: strconv.FormatInt(record.CreatedBy, 10),
+		CreatedAt: time.Unix(record.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+		ExpiresAt: time.Unix(record.ExpiresAt, 0).Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// postPreviewMAC 计算预览令牌签名，签名覆盖令牌 ID、文章 ID 与过期时间
+func postPreviewMAC(record *postPreviewRecord) ([]byte, error) {
+	return secretMAC(fmt.Sprintf("preview|%s|%d|%d", record.TokenID, record.PostID, record.ExpiresAt))
+}
+
+// postPreviewTokenKey 返回预览令牌记录的缓存键
+func postPreviewTokenKey(tokenID string) string {
+	return fmt.Sprintf("%s:%s", consts.PostPreviewTokenKeyPrefix, tokenID)
+}
+
+// postPreviewIndexKey 返回文章预览令牌索引的缓存键
+func postPreviewIndexKey(postID int64) string {
+	return fmt.Sprintf("%s:%d", consts.PostPreviewIndexKeyPrefix, postID)
+}
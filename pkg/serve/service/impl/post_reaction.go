@@ -0,0 +1,225 @@
+// Package impl 文章互动表态服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// React 添加文章表态，登录用户按用户 ID 去重，匿名访客按访客指纹在 Redis 中去重
+func (ps *PostServiceImpl) React(c *app.RequestContext, req *dto.ReactPostRequest) (*vo.ReactPostResponse, error) {
+	return ps.toggleReaction(c, req, true)
+}
+
+// Unreact 取消文章表态，未表态时不做任何变更
+func (ps *PostServiceImpl) Unreact(c *app.RequestContext, req *dto.ReactPostRequest) (*vo.ReactPostResponse, error) {
+	return ps.toggleReaction(c, req, false)
+}
+
+// ListReactedPosts 获取当前用户表态过的已发布文章列表
+func (ps *PostServiceImpl) ListReactedPosts(c *app.RequestContext, req *dto.ListReactedPostsRequest) (*vo.ListPostsResponse, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+
+	if req.Type != "" && !slices.Contains(reactionTypes(), req.Type) {
+		logger.BizLogger(c).Warnf("unsupported reaction type: %s", req.Type)
+		return nil, fmt.Errorf("unsupported reaction type: %s", req.Type)
+	}
+
+	posts, total, err := ps.reactionMapper.ListReactedPosts(c, userID.(int64), req.Type, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list reacted posts for user %d: %v", userID.(int64), err)
+		return nil, fmt.Errorf("failed to list reacted posts: %w", err)
+	}
+
+	postItems, err := ps.buildPostItems(c, posts)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, fmt.Errorf("failed to build post items: %w", err)
+	}
+
+	return &vo.ListPostsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     postItems,
+	}, nil
+}
+
+// toggleReaction 添加或取消表态，仅已发布文章可表态
+func (ps *PostServiceImpl) toggleReaction(c *app.RequestContext, req *dto.ReactPostRequest, add bool) (*vo.ReactPostResponse, error) {
+	postID, err := strconv.ParseInt(req.PostID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.PostID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	if !slices.Contains(reactionTypes(), req.Type) {
+		logger.BizLogger(c).Warnf("unsupported reaction type: %s", req.Type)
+		return nil, fmt.Errorf("unsupported reaction type: %s", req.Type)
+	}
+
+	p, err := ps.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %d does not exist: %v", postID, err)
+		return nil, fmt.Errorf("%w: %d", service.ErrPostNotFound, postID)
+	}
+	if p.Status != consts.PostStatusPublished {
+		logger.BizLogger(c).Warnf("attempted to react to unpublished post %d", postID)
+		return nil, fmt.Errorf("post with ID %d is not open for reactions", postID)
+	}
+
+	var changed bool
+	if userID, exists := c.Get(consts.JWTSubjectClaim); exists {
+		changed, err = db.RunDBTransaction(c, func() (bool, error) {
+			return ps.toggleUserReaction(c, postID, userID.(int64), req.Type, add)
+		})
+	} else {
+		changed, err = ps.toggleAnonymousReaction(c, postID, req.Type, req.Fingerprint, add)
+	}
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to update %s reaction on post %d: %v", req.Type, postID, err)
+		return nil, err
+	}
+
+	reactions, err := ps.postReactions(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get reactions for post %d: %v", postID, err)
+		return nil, fmt.Errorf("failed to get post reactions: %w", err)
+	}
+
+	return &vo.ReactPostResponse{
+		PostID:    req.PostID,
+		Type:      req.Type,
+		Changed:   changed,
+		Reactions: reactions,
+	}, nil
+}
+
+// toggleUserReaction 添加或取消登录用户的表态，需在事务中调用以保证表态记录与计数一致
+func (ps *PostServiceImpl) toggleUserReaction(c *app.RequestContext, postID, userID int64, reactionType string, add bool) (bool, error) {
+	if add {
+		created, err := ps.reactionMapper.CreatePostReaction(c, &post.PostReaction{PostID: postID, UserID: userID, Type: reactionType})
+		if err != nil {
+			return false, fmt.Errorf("failed to create reaction: %w", err)
+		}
+		if !created {
+			return false, nil
+		}
+		if err := ps.reactionMapper.IncrReactionCount(c, postID, reactionType); err != nil {
+			return false, fmt.Errorf("failed to increase reaction count: %w", err)
+		}
+		return true, nil
+	}
+
+	deleted, err := ps.reactionMapper.DeletePostReaction(c, postID, userID, reactionType)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete reaction: %w", err)
+	}
+	if !deleted {
+		return false, nil
+	}
+	if err := ps.reactionMapper.DecrReactionCount(c, postID, reactionType); err != nil {
+		return false, fmt.Errorf("failed to decrease reaction count: %w", err)
+	}
+	return true, nil
+}
+
+// toggleAnonymousReaction 添加或取消匿名访客的表态，去重记录保存在 Redis 集合中，计数更新失败时回滚去重记录
+func (ps *PostServiceImpl) toggleAnonymousReaction(c *app.RequestContext, postID int64, reactionType, fingerprint string, add bool) (bool, error) {
+	// 无法去重时不接受匿名表态，避免计数被刷
+	if global.RedisClient == nil {
+		return false, fmt.Errorf("anonymous reactions are unavailable")
+	}
+
+	ctx := context.Background()
+	key := fmt.Sprintf("%s:%d:%s", consts.PostReactionAnonymousKeyPrefix, postID, reactionType)
+	member := anonymousReactorID(c, fingerprint)
+
+	if add {
+		added, err := global.RedisClient.SAdd(ctx, key, member).Result()
+		if err != nil {
+			return false, fmt.Errorf("failed to record anonymous reaction: %w", err)
+		}
+		if added == 0 {
+			return false, nil
+		}
+		if err := ps.reactionMapper.IncrReactionCount(c, postID, reactionType); err != nil {
+			global.RedisClient.SRem(ctx, key, member)
+			return false, fmt.Errorf("failed to increase reaction count: %w", err)
+		}
+		return true, nil
+	}
+
+	removed, err := global.RedisClient.SRem(ctx, key, member).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to remove anonymous reaction: %w", err)
+	}
+	if removed == 0 {
+		return false, nil
+	}
+	if err := ps.reactionMapper.DecrReactionCount(c, postID, reactionType); err != nil {
+		global.RedisClient.SAdd(ctx, key, member)
+		return false, fmt.Errorf("failed to decrease reaction count: %w", err)
+	}
+	return true, nil
+}
+
+// postReactions 获取文章各类表态数量，按配置的表态类型顺序返回，未表态的类型数量为 0
+func (ps *PostServiceImpl) postReactions(c *app.RequestContext, postID int64) ([]*vo.ReactionCount, error) {
+	counts, err := ps.reactionMapper.ListReactionCounts(c, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	countByType := make(map[string]int64, len(counts))
+	for _, count := range counts {
+		countByType[count.Type] = count.Count
+	}
+
+	types := reactionTypes()
+	reactions := make([]*vo.ReactionCount, 0, len(types))
+	for _, reactionType := range types {
+		reactions = append(reactions, &vo.ReactionCount{Type: reactionType, Count: countByType[reactionType]})
+	}
+	return reactions, nil
+}
+
+// reactionTypes 获取配置的表态类型，未配置时仅支持默认类型
+func reactionTypes() []string {
+	cfgs, err := configs.GetConfig()
+	if err != nil || len(cfgs.ReactionConfig.Types) == 0 {
+		return []string{consts.PostReactionDefaultType}
+	}
+	return cfgs.ReactionConfig.Types
+}
+
+// anonymousReactorID 生成匿名访客的表态去重标识，客户端提供浏览器指纹时与 IP 组合，否则使用 IP 与 User-Agent
+func anonymousReactorID(c *app.RequestContext, fingerprint string) string {
+	if fingerprint == "" {
+		return visitorID(c)
+	}
+	sum := sha256.Sum256([]byte(c.ClientIP() + "|" + fingerprint))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,322 @@
+// Package impl 相关文章推荐服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/tfidf"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// relatedCandidate 相关文章候选
+type relatedCandidate struct {
+	postID int64
+	score  float64
+}
+
+// ListRelatedPosts 获取与指定文章最相似的已发布文章
+// 相似度由正文 TF-IDF 余弦相似度、标签 Jaccard 相似度与是否同分类加权求和，结果按缓存版本缓存
+func (ps *PostServiceImpl) ListRelatedPosts(c *app.RequestContext, req *dto.ListRelatedPostsRequest) (*vo.ListRelatedPostsResponse, error) {
+	postID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = consts.PostRelatedDefaultLimit
+	}
+
+	target, err := ps.postMapper.GetPostByID(c, postID)
+	if err != nil || target.Status != consts.PostStatusPublished {
+		logger.BizLogger(c).Errorf("published post with ID %d not found: %v", postID, err)
+		return nil, service.ErrPostNotFound
+	}
+
+	cacheKey := fmt.Sprintf("%s:%s:%d:%d", consts.PostRelatedKeyPrefix, relatedCacheVersion(c), postID, limit)
+	if global.RedisClient != nil {
+		if cached, err := global.RedisClient.Get(context.Background(), cacheKey).Bytes(); err == nil {
+			response := new(vo.ListRelatedPostsResponse)
+			if err := json.Unmarshal(cached, response); err == nil {
+				return response, nil
+			}
+		} else if !errors.Is(err, redis.Nil) {
+			logger.BizLogger(c).Warnf("failed to get related posts cache: %v", err)
+		}
+	}
+
+	candidates, err := ps.scoreRelatedPosts(c, target)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to score related posts for post %d: %v", postID, err)
+		return nil, fmt.Errorf("failed to score related posts: %w", err)
+	}
+	if int64(len(candidates)) > limit {
+		candidates = candidates[:limit]
+	}
+
+	postIDs := make([]int64, 0, len(candidates))
+	for _, candidate := range candidates {
+		postIDs = append(postIDs, candidate.postID)
+	}
+	posts, err := ps.postMapper.GetPostsByIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get related posts: %v", err)
+		return nil, fmt.Errorf("failed to get related posts: %w", err)
+	}
+	postByID := make(map[int64]*post.Post, len(posts))
+	for _, p := range posts {
+		postByID[p.ID] = p
+	}
+
+	ordered := make([]*post.Post, 0, len(candidates))
+	scores := make([]float64, 0, len(candidates))
+	for _, candidate := range candidates {
+		if p, ok := postByID[candidate.postID]; ok {
+			ordered = append(ordered, p)
+			scores = append(scores, candidate.score)
+		}
+	}
+
+	postItems, err := ps.buildPostItems(c, ordered)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to build post items: %v", err)
+		return nil, fmt.Errorf("failed to build post items: %w", err)
+	}
+
+	list := make([]*vo.RelatedPostItem, 0, len(postItems))
+	for i, item := range postItems {
+		list = append(list, &vo.RelatedPostItem{PostItem: item, Score: scores[i]})
+	}
+
+	response := &vo.ListRelatedPostsResponse{
+		PostID: req.ID,
+		List:   list,
+	}
+
+	if global.RedisClient != nil {
+		if content, err := json.Marshal(response); err == nil {
+			if err := global.RedisClient.Set(context.Background(), cacheKey, content, consts.PostRelatedCacheTTL).Err(); err != nil {
+				logger.BizLogger(c).Warnf("failed to cache related posts: %v", err)
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// scoreRelatedPosts 召回正文关键词相同、同分类或含相同标签的候选文章并计算相似度，按得分倒序返回
+func (ps *PostServiceImpl) scoreRelatedPosts(c *app.RequestContext, target *post.Post) ([]*relatedCandidate, error) {
+	targetTerms, err := ps.termMapper.ListPostTerms(c, target.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get post terms: %w", err)
+	}
+	weights := make(map[string]float64, len(targetTerms))
+	terms := make([]string, 0, len(targetTerms))
+	for _, t := range targetTerms {
+		weights[t.Term] = t.Weight
+		terms = append(terms, t.Term)
+	}
+
+	// 关键词权重均已归一化，共有关键词权重乘积之和即为余弦相似度
+	matched, err := ps.termMapper.ListMatchingTerms(c, terms, target.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to match post terms: %w", err)
+	}
+	textScores := make(map[int64]float64)
+	for _, m := range matched {
+		textScores[m.PostID] += weights[m.Term] * m.Weight
+	}
+
+	candidateIDs := topTextCandidates(textScores, consts.PostRelatedCandidateLimit)
+
+	if target.CategoryID != nil {
+		categoryPostIDs, err := ps.postMapper.ListPublishedPostIDsByCategory(c, *target.CategoryID, target.ID, consts.PostRelatedCandidateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list posts in category: %w", err)
+		}
+		candidateIDs = append(candidateIDs, categoryPostIDs...)
+	}
+
+	targetTags, err := ps.tagMapper.ListTagsByPostIDs(c, []int64{target.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get post tags: %w", err)
+	}
+	targetTagIDs := tagModelIDs(targetTags[target.ID])
+	tagPostIDs, err := ps.postMapper.ListPublishedPostIDsByTags(c, targetTagIDs, target.ID, consts.PostRelatedCandidateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list posts with tags: %w", err)
+	}
+	candidateIDs = append(candidateIDs, tagPostIDs...)
+
+	candidateIDs = uniqueIDs(candidateIDs)
+	if len(candidateIDs) == 0 {
+		return []*relatedCandidate{}, nil
+	}
+
+	candidatePosts, err := ps.postMapper.GetPostsByIDs(c, candidateIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get candidate posts: %w", err)
+	}
+	candidateTags, err := ps.tagMapper.ListTagsByPostIDs(c, candidateIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get candidate tags: %w", err)
+	}
+
+	candidates := make([]*relatedCandidate, 0, len(candidatePosts))
+	for _, p := range candidatePosts {
+		if p.Status != consts.PostStatusPublished {
+			continue
+		}
+
+		score := consts.PostRelatedTextWeight * min(textScores[p.ID], 1)
+		score += consts.PostRelatedTagWeight * jaccard(targetTagIDs, tagModelIDs(candidateTags[p.ID]))
+		if target.CategoryID != nil && p.CategoryID != nil && *target.CategoryID == *p.CategoryID {
+			score += consts.PostRelatedCategoryWeight
+		}
+		if score > 0 {
+			candidates = append(candidates, &relatedCandidate{postID: p.ID, score: score})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].postID > candidates[j].postID
+	})
+
+	return candidates, nil
+}
+
+// indexPostTerms 重建文章关键词索引，在文章标题或内容变更时于同一事务内调用
+func (ps *PostServiceImpl) indexPostTerms(c *app.RequestContext, p *post.Post) error {
+	return IndexPostTerms(c, ps.termMapper, p)
+}
+
+// IndexPostTerms 按 TF-IDF 重建文章关键词索引，文章写入与启动时回填共用
+// 参数：
+//
+//	c: Hertz 请求上下文，存在事务时在事务内执行
+//	termMapper: 文章关键词数据访问
+//	p: 文章，需包含 ID、标题与 Markdown 内容
+//
+// 返回值：
+//
+//	error: 错误信息
+func IndexPostTerms(c *app.RequestContext, termMapper mapper.PostTermMapper, p *post.Post) error {
+	frequencies := tfidf.TermFrequencies(p.Title, p.Markdown, consts.PostRelatedTitleWeight)
+
+	documentFrequencies, err := termMapper.CountTermDocuments(c, tfidf.Terms(frequencies), p.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count term documents: %w", err)
+	}
+	total, err := termMapper.CountIndexedPosts(c, p.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count indexed posts: %w", err)
+	}
+
+	weights := tfidf.Weigh(frequencies, documentFrequencies, total, consts.PostRelatedTermLimit)
+	terms := make([]*post.PostTerm, 0, len(weights))
+	for term, weight := range weights {
+		terms = append(terms, &post.PostTerm{PostID: p.ID, Term: term, Weight: weight})
+	}
+
+	if err := termMapper.ReplacePostTerms(c, p.ID, terms); err != nil {
+		return fmt.Errorf("failed to save post terms: %w", err)
+	}
+	p.TermsIndexed = true
+	return nil
+}
+
+// relatedCacheVersion 获取相关文章缓存版本号
+func relatedCacheVersion(c *app.RequestContext) string {
+	if global.RedisClient == nil {
+		return "0"
+	}
+	version, err := global.RedisClient.Get(context.Background(), consts.PostRelatedCacheVersionKey).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			logger.BizLogger(c).Warnf("failed to get related posts cache version: %v", err)
+		}
+		return "0"
+	}
+	return version
+}
+
+// invalidateRelatedCache 使相关文章缓存失效，在文章变更后调用
+func invalidateRelatedCache(c *app.RequestContext) {
+	if global.RedisClient == nil {
+		return
+	}
+	if err := global.RedisClient.Incr(context.Background(), consts.PostRelatedCacheVersionKey).Err(); err != nil {
+		logger.BizLogger(c).Warnf("failed to invalidate related posts cache: %v", err)
+	}
+}
+
+// topTextCandidates 按正文相似度取前 limit 篇文章
+func topTextCandidates(textScores map[int64]float64, limit int) []int64 {
+	postIDs := make([]int64, 0, len(textScores))
+	for postID := range textScores {
+		postIDs = append(postIDs, postID)
+	}
+	sort.Slice(postIDs, func(i, j int) bool {
+		if textScores[postIDs[i]] != textScores[postIDs[j]] {
+			return textScores[postIDs[i]] > textScores[postIDs[j]]
+		}
+		return postIDs[i] > postIDs[j]
+	})
+	if len(postIDs) > limit {
+		postIDs = postIDs[:limit]
+	}
+	return postIDs
+}
+
+// uniqueIDs 去除重复 ID，保持首次出现的顺序
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// jaccard 计算两个 ID 集合的 Jaccard 相似度
+func jaccard(a, b []int64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[int64]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+	var intersection int
+	for _, id := range b {
+		if _, ok := set[id]; ok {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
@@ -0,0 +1,54 @@
+package impl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int64
+		b    []int64
+		want float64
+	}{
+		{name: "identical", a: []int64{1, 2, 3}, b: []int64{3, 2, 1}, want: 1},
+		{name: "disjoint", a: []int64{1, 2}, b: []int64{3, 4}, want: 0},
+		{name: "partial overlap", a: []int64{1, 2, 3}, b: []int64{2, 3, 4}, want: 0.5},
+		{name: "subset", a: []int64{1}, b: []int64{1, 2, 3, 4}, want: 0.25},
+		{name: "empty", a: nil, b: []int64{1}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, jaccard(tt.a, tt.b), 1e-9)
+			assert.InDelta(t, tt.want, jaccard(tt.b, tt.a), 1e-9)
+		})
+	}
+}
+
+func TestUniqueIDs(t *testing.T) {
+	assert.Equal(t, []int64{3, 1, 2}, uniqueIDs([]int64{3, 1, 3, 2, 1}))
+	assert.Empty(t, uniqueIDs(nil))
+}
+
+func TestTopTextCandidates(t *testing.T) {
+	scores := map[int64]float64{1: 0.2, 2: 0.9, 3: 0.5, 4: 0.5, 5: 0.1}
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []int64
+	}{
+		{name: "ordered by score then newer id", limit: 10, want: []int64{2, 4, 3, 1, 5}},
+		{name: "limited", limit: 3, want: []int64{2, 4, 3}},
+		{name: "zero limit", limit: 0, want: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, topTextCandidates(scores, tt.limit))
+		})
+	}
+}
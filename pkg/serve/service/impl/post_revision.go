@@ -0,0 +1,278 @@
+// Package impl 文章修订历史服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/pmezard/go-difflib/difflib"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/model/user"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// revisionDiffContext 修订差异中变更行前后保留的上下文行数
+const revisionDiffContext = 3
+
+// ListRevisions 获取文章修订列表，仅作者或拥有文章越权管理权限的用户可查看
+func (ps *PostServiceImpl) ListRevisions(c *app.RequestContext, req *dto.ListPostRevisionsRequest) (*vo.ListPostRevisionsResponse, error) {
+	existingPost, err := ps.getOwnedPost(c, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, total, err := ps.revisionMapper.ListPostRevisions(c, existingPost.ID, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list revisions for post %d: %v", existingPost.ID, err)
+		return nil, fmt.Errorf("failed to list post revisions: %w", err)
+	}
+
+	editors, err := ps.revisionEditors(c, revisions...)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*vo.PostRevisionItem, 0, len(revisions))
+	for _, r := range revisions {
+		list = append(list, revisionItem(r, editors[r.EditorID]))
+	}
+
+	return &vo.ListPostRevisionsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     list,
+	}, nil
+}
+
+// DiffRevisions 生成两个修订之间的统一格式差异
+func (ps *PostServiceImpl) DiffRevisions(c *app.RequestContext, req *dto.DiffPostRevisionsRequest) (*vo.DiffPostRevisionsResponse, error) {
+	existingPost, err := ps.getOwnedPost(c, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := ps.getPostRevision(c, existingPost.ID, req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := ps.getPostRevision(c, existingPost.ID, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	fromName := fmt.Sprintf("revision-%d", from.ID)
+	toName := fmt.Sprintf("revision-%d", to.ID)
+
+	diff, err := unifiedDiff(from.Markdown, to.Markdown, fromName, toName)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to diff revisions %d and %d: %v", from.ID, to.ID, err)
+		return nil, fmt.Errorf("failed to diff revisions: %w", err)
+	}
+
+	var titleDiff string
+	if from.Title != to.Title {
+		if titleDiff, err = unifiedDiff(from.Title, to.Title, fromName, toName); err != nil {
+			logger.BizLogger(c).Errorf("failed to diff revision titles %d and %d: %v", from.ID, to.ID, err)
+			return nil, fmt.Errorf("failed to diff revisions: %w", err)
+		}
+	}
+
+	editors, err := ps.revisionEditors(c, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vo.DiffPostRevisionsResponse{
+		From:      revisionItem(from, editors[from.EditorID]),
+		To:        revisionItem(to, editors[to.EditorID]),
+		TitleDiff: titleDiff,
+		Diff:      diff,
+	}, nil
+}
+
+// RestoreRevision 将文章标题与内容恢复为指定修订，恢复操作本身会生成一条新修订
+func (ps *PostServiceImpl) RestoreRevision(c *app.RequestContext, req *dto.RestorePostRevisionRequest) (*vo.RestorePostRevisionResponse, error) {
+	existingPost, err := ps.getOwnedPost(c, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := ps.getPostRevision(c, existingPost.ID, req.RevisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := ps.renderContent(c, revision.Markdown, existingPost.AuthorID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to render markdown for revision %d: %v", revision.ID, err)
+		return nil, fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	previous := *existingPost
+	existingPost.Title = revision.Title
+	existingPost.Markdown = revision.Markdown
+	applyRendered(existingPost, rendered)
+
+	userID, _ := c.Get(consts.JWTSubjectClaim)
+	result, err := db.RunDBTransaction(c, func() (any, error) {
+		if err := ps.postMapper.UpdatePost(c, existingPost); err != nil {
+			return nil, err
+		}
+		if err := ps.indexPostTerms(c, existingPost); err != nil {
+			return nil, err
+		}
+		return ps.recordRevision(c, &previous, existingPost, userID.(int64))
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to restore revision %d for post %d: %v", revision.ID, existingPost.ID, err)
+		return nil, fmt.Errorf("failed to restore post revision: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("post %d restored to revision %d", existingPost.ID, revision.ID)
+	invalidateSEOCache(c)
+	invalidateRelatedCache(c)
+
+	return &vo.RestorePostRevisionResponse{
+		ID:         strconv.FormatInt(existingPost.ID, 10),
+		RevisionID: strconv.FormatInt(result.(*post.PostRevision).ID, 10),
+		Title:      existingPost.Title,
+		Markdown:   existingPost.Markdown,
+		Message:    "Post revision restored successfully",
+	}, nil
+}
+
+// recordRevision 记录文章当前内容的修订，历史文章首次编辑时会先补存编辑前的内容作为基线修订
+// 标题与正文均与最新修订相同时（如仅修改分类、标签）不新增修订，直接返回最新修订
+func (ps *PostServiceImpl) recordRevision(c *app.RequestContext, previous, current *post.Post, editorID int64) (*post.PostRevision, error) {
+	latest, err := ps.revisionMapper.GetLatestPostRevision(c, current.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if latest == nil && previous != nil {
+		latest = newPostRevision(previous, previous.AuthorID)
+		if err := ps.revisionMapper.CreatePostRevision(c, latest); err != nil {
+			return nil, err
+		}
+	}
+	if latest != nil && latest.Title == current.Title && latest.Markdown == current.Markdown {
+		return latest, nil
+	}
+
+	revision := newPostRevision(current, editorID)
+	if err := ps.revisionMapper.CreatePostRevision(c, revision); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// getOwnedPost 获取文章并校验当前用户是否有权管理
+func (ps *PostServiceImpl) getOwnedPost(c *app.RequestContext, rawPostID string) (*post.Post, error) {
+	postID, err := strconv.ParseInt(rawPostID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", rawPostID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	existingPost, err := ps.postMapper.GetPostByID(c, postID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("post with ID %d not found: %v", postID, err)
+		return nil, fmt.Errorf("%w: %w", service.ErrPostNotFound, err)
+	}
+
+	if err := ps.checkPostOwnership(c, existingPost); err != nil {
+		return nil, err
+	}
+
+	return existingPost, nil
+}
+
+// getPostRevision 获取修订并校验其属于指定文章
+func (ps *PostServiceImpl) getPostRevision(c *app.RequestContext, postID int64, rawRevisionID string) (*post.PostRevision, error) {
+	revisionID, err := strconv.ParseInt(rawRevisionID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid revision ID format: %s", rawRevisionID)
+		return nil, fmt.Errorf("%w: revision ID: %w", service.ErrInvalidID, err)
+	}
+
+	revision, err := ps.revisionMapper.GetPostRevisionByID(c, revisionID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("revision with ID %d not found: %v", revisionID, err)
+		return nil, fmt.Errorf("revision not found: %w", err)
+	}
+
+	if revision.PostID != postID {
+		logger.BizLogger(c).Errorf("revision %d does not belong to post %d", revisionID, postID)
+		return nil, fmt.Errorf("revision %d does not belong to post %d", revisionID, postID)
+	}
+
+	return revision, nil
+}
+
+// revisionEditors 批量获取修订编辑者
+func (ps *PostServiceImpl) revisionEditors(c *app.RequestContext, revisions ...*post.PostRevision) (map[int64]*user.User, error) {
+	editorIDs := make([]int64, 0, len(revisions))
+	for _, r := range revisions {
+		editorIDs = append(editorIDs, r.EditorID)
+	}
+
+	editors, err := ps.userMapper.GetUsersByIDs(c, editorIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list revision editors: %v", err)
+		return nil, fmt.Errorf("failed to list revision editors: %w", err)
+	}
+
+	editorMap := make(map[int64]*user.User, len(editors))
+	for _, u := range editors {
+		editorMap[u.ID] = u
+	}
+	return editorMap, nil
+}
+
+// newPostRevision 根据文章当前内容创建修订快照
+func newPostRevision(p *post.Post, editorID int64) *post.PostRevision {
+	return &post.PostRevision{
+		PostID:   p.ID,
+		Title:    p.Title,
+		Markdown: p.Markdown,
+		EditorID: editorID,
+	}
+}
+
+// revisionItem 转换修订列表项
+func revisionItem(r *post.PostRevision, editor *user.User) *vo.PostRevisionItem {
+	item := &vo.PostRevisionItem{
+		ID:        strconv.FormatInt(r.ID, 10),
+		PostID:    strconv.FormatInt(r.PostID, 10),
+		Title:     r.Title,
+		EditorID:  strconv.FormatInt(r.EditorID, 10),
+		CreatedAt: time.Unix(r.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+	}
+	if editor != nil {
+		item.EditorNickname = editor.Nickname
+	}
+	return item
+}
+
+// unifiedDiff 生成统一格式差异，内容相同时返回空字符串
+func unifiedDiff(from, to, fromName, toName string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  revisionDiffContext,
+	})
+}
@@ -0,0 +1,77 @@
+// Package impl 文章系列导航服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// postSeriesNav 构建文章所属系列的导航信息，文章未加入系列时返回 nil
+// 系列目录与上下篇仅包含已发布且非仅链接可见的文章，当前文章不满足条件时仍保留在目录中的原有位置
+func (ps *PostServiceImpl) postSeriesNav(c *app.RequestContext, p *post.Post) (*vo.PostSeriesNav, error) {
+	membership, err := ps.seriesMapper.GetSeriesPostByPostID(c, p.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get series membership: %w", err)
+	}
+
+	s, err := ps.seriesMapper.GetSeriesByID(c, membership.SeriesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	seriesPosts, err := ps.seriesMapper.ListSeriesPosts(c, s.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series posts: %w", err)
+	}
+
+	nav := &vo.PostSeriesNav{
+		ID:    strconv.FormatInt(s.ID, 10),
+		Title: s.Title,
+		Slug:  s.Slug,
+		Posts: make([]*vo.PostSeriesLink, 0, len(seriesPosts)),
+	}
+	current := -1
+	for _, sp := range seriesPosts {
+		if (sp.Status != consts.PostStatusPublished || sp.Visibility == consts.PostVisibilityUnlisted) && sp.ID != p.ID {
+			continue
+		}
+		if sp.ID == p.ID {
+			current = len(nav.Posts)
+		}
+		nav.Posts = append(nav.Posts, &vo.PostSeriesLink{
+			ID:       strconv.FormatInt(sp.ID, 10),
+			Title:    sp.Title,
+			Slug:     sp.Slug,
+			Position: int64(len(nav.Posts) + 1),
+		})
+	}
+
+	nav.Total = int64(len(nav.Posts))
+	if current >= 0 {
+		nav.Position = int64(current + 1)
+		if current > 0 {
+			nav.Prev = nav.Posts[current-1]
+		}
+		if current+1 < len(nav.Posts) {
+			nav.Next = nav.Posts[current+1]
+		}
+	}
+
+	return nav, nil
+}
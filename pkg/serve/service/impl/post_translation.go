@@ -0,0 +1,154 @@
+// Package impl 文章多语言服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/locale"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/snowflake"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// applyPostTranslation 设置文章的语言与翻译组，须在写入文章前调用
+// rawLocale 为空时保持原语言（新文章使用默认语言）；translationOf 为空时保持原翻译组（新文章自成一组），
+// 为 consts.LocaleDetachTranslation 时脱离原翻译组，否则加入该文章所在的翻译组，须有权管理该文章
+func (ps *PostServiceImpl) applyPostTranslation(c *app.RequestContext, p *post.Post, rawLocale, translationOf string) error {
+	postLocale := p.Locale
+	if rawLocale != "" || postLocale == "" {
+		resolved, err := locale.Resolve(rawLocale)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid locale '%s' for post %d: %v", rawLocale, p.ID, err)
+			return err
+		}
+		postLocale = resolved
+	}
+
+	groupID := p.TranslationGroupID
+	switch translationOf {
+	case "":
+	case consts.LocaleDetachTranslation:
+		groupID = 0
+	default:
+		sourceID, err := strconv.ParseInt(translationOf, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid translation source ID format: %s", translationOf)
+			return fmt.Errorf("%w: %w", service.ErrInvalidTranslationSource, err)
+		}
+		if sourceID == p.ID {
+			return fmt.Errorf("%w: a post cannot be a translation of itself", service.ErrInvalidTranslationSource)
+		}
+		source, err := ps.postMapper.GetPostByID(c, sourceID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("translation source post %d not found: %v", sourceID, err)
+			return fmt.Errorf("%w: post %s", service.ErrTranslationSourceNotFound, translationOf)
+		}
+		if err := ps.checkPostOwnership(c, source); err != nil {
+			return err
+		}
+		groupID = source.TranslationGroupID
+	}
+
+	if groupID == 0 {
+		// 新建翻译组，组内只有当前文章，无需检查语言冲突
+		newGroupID, err := snowflake.GenerateID()
+		if err != nil {
+			return fmt.Errorf("failed to generate translation group ID: %w", err)
+		}
+		p.Locale, p.TranslationGroupID = postLocale, newGroupID
+		return nil
+	}
+
+	if groupID != p.TranslationGroupID || postLocale != p.Locale {
+		translations, err := ps.postMapper.ListPostTranslations(c, groupID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list translations of group %d: %v", groupID, err)
+			return fmt.Errorf("failed to list post translations: %w", err)
+		}
+		for _, t := range translations {
+			if t.ID != p.ID && t.Locale == postLocale {
+				logger.BizLogger(c).Warnf("translation group %d already has post %d in locale %s", groupID, t.ID, postLocale)
+				return fmt.Errorf("%w for locale %s: post %d", service.ErrTranslationExists, postLocale, t.ID)
+			}
+		}
+	}
+
+	p.Locale, p.TranslationGroupID = postLocale, groupID
+	return nil
+}
+
+// preferredTranslation 按语言回退顺序选择文章的已发布版本，当前文章已是最优先语言或没有更优先的已发布版本时返回当前文章
+func (ps *PostServiceImpl) preferredTranslation(c *app.RequestContext, p *post.Post, lang string) (*post.Post, error) {
+	chain, err := locale.FallbackChain(lang)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid lang '%s': %v", lang, err)
+		return nil, err
+	}
+	if len(chain) == 0 || p.Locale == chain[0] {
+		return p, nil
+	}
+
+	translations, err := ps.postMapper.ListPostTranslations(c, p.TranslationGroupID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list translations of post %d: %v", p.ID, err)
+		return nil, fmt.Errorf("failed to list post translations: %w", err)
+	}
+
+	// 当前文章不在回退顺序中时，任一已发布版本均优先于它
+	best, bestRank := p, slices.Index(chain, p.Locale)
+	if bestRank < 0 {
+		bestRank = len(chain)
+	}
+	for _, t := range translations {
+		if !isTranslationVisible(t) {
+			continue
+		}
+		if rank := slices.Index(chain, t.Locale); rank >= 0 && rank < bestRank {
+			best, bestRank = t, rank
+		}
+	}
+	return best, nil
+}
+
+// postTranslations 获取文章其他语言的已发布版本，按语言排序
+func (ps *PostServiceImpl) postTranslations(c *app.RequestContext, p *post.Post) ([]*vo.PostTranslation, error) {
+	list := make([]*vo.PostTranslation, 0)
+	if p.TranslationGroupID == 0 {
+		return list, nil
+	}
+
+	translations, err := ps.postMapper.ListPostTranslations(c, p.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range translations {
+		if t.ID == p.ID || !isTranslationVisible(t) {
+			continue
+		}
+		list = append(list, &vo.PostTranslation{
+			ID:     strconv.FormatInt(t.ID, 10),
+			Locale: t.Locale,
+			Title:  t.Title,
+			Slug:   t.Slug,
+		})
+	}
+	slices.SortFunc(list, func(a, b *vo.PostTranslation) int {
+		return strings.Compare(a.Locale, b.Locale)
+	})
+	return list, nil
+}
+
+// isTranslationVisible 判断文章的语言版本是否对读者可见：已发布且不是仅链接可见，与公开列表的筛选条件一致
+func isTranslationVisible(p *post.Post) bool {
+	return p.Status == consts.PostStatusPublished && p.Visibility != consts.PostVisibilityUnlisted
+}
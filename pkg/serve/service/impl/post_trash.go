@@ -0,0 +1,144 @@
+// Package impl 文章回收站服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/model/post"
+	"github.com/Done-0/jank/internal/trash"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/slugify"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// ListTrash 获取回收站中的文章，拥有文章越权管理权限的用户可查看所有作者的文章，其余用户仅可查看自己的文章
+func (ps *PostServiceImpl) ListTrash(c *app.RequestContext, req *dto.ListTrashRequest) (*vo.ListTrashedPostsResponse, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+	currentUserID := userID.(int64)
+
+	allowed, err := ps.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.PostOverrideResource, consts.PostOverrideAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check post override permission for user %d: %v", currentUserID, err)
+		return nil, fmt.Errorf("failed to check permission: %w", err)
+	}
+	var authorID *int64
+	if !allowed {
+		authorID = &currentUserID
+	}
+
+	posts, total, err := ps.postMapper.ListDeletedPosts(c, req.PageNo, req.PageSize, authorID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list trashed posts: %v", err)
+		return nil, fmt.Errorf("failed to list trashed posts: %w", err)
+	}
+
+	list := make([]*vo.TrashedPostItem, 0, len(posts))
+	for _, p := range posts {
+		slug := base.TrashedValue(p.Ext, "slug")
+		if slug == "" {
+			slug = p.Slug
+		}
+		list = append(list, &vo.TrashedPostItem{
+			ID:        strconv.FormatInt(p.ID, 10),
+			Title:     p.Title,
+			Slug:      slug,
+			Status:    p.Status,
+			AuthorID:  strconv.FormatInt(p.AuthorID, 10),
+			DeletedAt: time.Unix(trash.DeletedAt(&p.Base), 0).Format("2006-01-02 15:04:05"),
+			PurgeAt:   formatPurgeAt(&p.Base),
+		})
+	}
+
+	return &vo.ListTrashedPostsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     list,
+	}, nil
+}
+
+// Restore 从回收站恢复文章，仅作者或拥有文章越权管理权限的用户可恢复
+func (ps *PostServiceImpl) Restore(c *app.RequestContext, req *dto.RestorePostRequest) (*vo.RestorePostResponse, error) {
+	postID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid post ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+	}
+
+	p, err := ps.postMapper.GetDeletedPostByID(c, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w in trash: %s", service.ErrPostNotFound, req.ID)
+		}
+		logger.BizLogger(c).Errorf("failed to get trashed post with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get trashed post: %w", err)
+	}
+
+	if err := ps.checkPostOwnership(c, p); err != nil {
+		return nil, err
+	}
+
+	if err := ps.restoreTrashedPost(c, p); err != nil {
+		logger.BizLogger(c).Errorf("failed to restore post with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to restore post: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("post restored from trash with ID: %s", req.ID)
+	invalidateSEOCache(c)
+	invalidateRelatedCache(c)
+
+	return &vo.RestorePostResponse{
+		ID:      req.ID,
+		Slug:    p.Slug,
+		Message: "Post restored successfully",
+	}, nil
+}
+
+// restoreTrashedPost 从回收站恢复文章并写回删除时释放的 slug，原 slug 已被其他文章占用时追加序号去重
+func (ps *PostServiceImpl) restoreTrashedPost(c *app.RequestContext, p *post.Post) error {
+	slug := base.TrashedValue(p.Ext, "slug")
+	if slug != "" {
+		var err error
+		slug, err = slugify.Unique(slug, func(s string) (bool, error) {
+			return ps.postMapper.IsPostSlugTaken(c, s, p.ID)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to resolve post slug: %w", err)
+		}
+	}
+
+	if err := ps.postMapper.RestorePost(c, p, slug); err != nil {
+		return err
+	}
+
+	p.Deleted = false
+	if slug != "" {
+		p.Slug = slug
+	}
+	return nil
+}
+
+// formatPurgeAt 格式化回收站记录预计被永久删除的时间，不自动清理时返回空字符串
+func formatPurgeAt(b *base.Base) string {
+	purgeAt := trash.PurgeAt(b)
+	if purgeAt == 0 {
+		return ""
+	}
+	return time.Unix(purgeAt, 0).Format("2006-01-02 15:04:05")
+}
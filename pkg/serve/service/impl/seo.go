@@ -0,0 +1,231 @@
+// Package impl 搜索引擎优化服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Done-0/jank/configs"
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// SEOServiceImpl 搜索引擎优化服务实现
+type SEOServiceImpl struct {
+	postMapper     mapper.PostMapper
+	categoryMapper mapper.CategoryMapper
+}
+
+// NewSEOService 创建搜索引擎优化服务实例
+func NewSEOService(postMapperImpl mapper.PostMapper, categoryMapperImpl mapper.CategoryMapper) service.SEOService {
+	return &SEOServiceImpl{
+		postMapper:     postMapperImpl,
+		categoryMapper: categoryMapperImpl,
+	}
+}
+
+// GetSitemap 获取站点地图，URL 总数超过上限且未指定页码时返回站点地图索引
+func (ss *SEOServiceImpl) GetSitemap(c *app.RequestContext, req *dto.GetSitemapRequest) ([]byte, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	baseURL := siteBaseURL(c, cfgs)
+	return cachedSEOContent(c, cfgs, consts.SEOSitemapKeyPrefix, fmt.Sprintf("%s:%d", baseURL, req.Page), func() ([]byte, error) {
+		return ss.buildSitemap(c, baseURL, req.Page)
+	})
+}
+
+// GetRobots 获取 robots.txt
+func (ss *SEOServiceImpl) GetRobots(c *app.RequestContext) ([]byte, error) {
+	cfgs, err := configs.GetConfig()
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get config: %v", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	// 缓存键包含 robots 配置摘要，配置热更新后自动生成新内容
+	robots := cfgs.SEOConfig.Robots
+	hasher := fnv.New64a()
+	fmt.Fprintf(hasher, "%+v", robots)
+
+	baseURL := siteBaseURL(c, cfgs)
+	return cachedSEOContent(c, cfgs, consts.SEORobotsKeyPrefix, fmt.Sprintf("%s:%x", baseURL, hasher.Sum64()), func() ([]byte, error) {
+		var b strings.Builder
+		userAgent := robots.UserAgent
+		if userAgent == "" {
+			userAgent = "*"
+		}
+		fmt.Fprintf(&b, "User-agent: %s\n", userAgent)
+		for _, path := range robots.Allow {
+			fmt.Fprintf(&b, "Allow: %s\n", path)
+		}
+		for _, path := range robots.Disallow {
+			fmt.Fprintf(&b, "Disallow: %s\n", path)
+		}
+		if robots.CrawlDelay > 0 {
+			fmt.Fprintf(&b, "Crawl-delay: %d\n", robots.CrawlDelay)
+		}
+		if robots.Sitemap {
+			fmt.Fprintf(&b, "\nSitemap: %s%s\n", baseURL, consts.SitemapPath)
+		}
+		return []byte(b.String()), nil
+	})
+}
+
+// buildSitemap 生成站点地图内容，URL 顺序依次为首页、启用的分类、已发布文章
+func (ss *SEOServiceImpl) buildSitemap(c *app.RequestContext, baseURL string, page int64) ([]byte, error) {
+	categories, err := ss.categoryMapper.ListActiveCategories(c)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list active categories for sitemap: %v", err)
+		return nil, fmt.Errorf("failed to list active categories: %w", err)
+	}
+
+	postCount, err := ss.postMapper.CountPublishedPosts(c)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count published posts for sitemap: %v", err)
+		return nil, fmt.Errorf("failed to count published posts: %w", err)
+	}
+
+	staticCount := int64(1 + len(categories))
+	total := staticCount + postCount
+	pages := (total + consts.SitemapMaxURLs - 1) / consts.SitemapMaxURLs
+
+	// 未指定页码且超过单个站点地图上限时输出索引
+	if page == 0 && pages > 1 {
+		index := &vo.SitemapIndex{XMLNS: consts.SitemapNamespace}
+		for i := int64(1); i <= pages; i++ {
+			index.Sitemaps = append(index.Sitemaps, &vo.SitemapRef{Loc: fmt.Sprintf("%s%s?page=%d", baseURL, consts.SitemapPath, i)})
+		}
+		return marshalSitemap(index)
+	}
+
+	if page == 0 {
+		page = 1
+	}
+	if page > pages {
+		return nil, fmt.Errorf("sitemap page %d out of range", page)
+	}
+
+	start := (page - 1) * consts.SitemapMaxURLs
+	end := min(total, start+consts.SitemapMaxURLs)
+
+	urlSet := &vo.SitemapURLSet{XMLNS: consts.SitemapNamespace}
+	if start == 0 {
+		urlSet.URLs = append(urlSet.URLs, &vo.SitemapURL{Loc: baseURL + "/"})
+	}
+	for i, cat := range categories {
+		if idx := int64(1 + i); idx >= start && idx < end {
+			urlSet.URLs = append(urlSet.URLs, &vo.SitemapURL{
+				Loc:     baseURL + fmt.Sprintf(consts.CategoryPagePath, cat.ID),
+				LastMod: time.Unix(cat.GmtModified, 0).UTC().Format(time.RFC3339),
+			})
+		}
+	}
+
+	postStart := max(0, start-staticCount)
+	postEnd := end - staticCount
+	if postEnd > postStart {
+		posts, err := ss.postMapper.ListPublishedPostTimestamps(c, postStart, postEnd-postStart)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to list published posts for sitemap: %v", err)
+			return nil, fmt.Errorf("failed to list published posts: %w", err)
+		}
+		for _, p := range posts {
+			urlSet.URLs = append(urlSet.URLs, &vo.SitemapURL{
+				Loc:     baseURL + fmt.Sprintf(consts.PostPagePath, p.ID),
+				LastMod: time.Unix(p.GmtModified, 0).UTC().Format(time.RFC3339),
+			})
+		}
+	}
+
+	return marshalSitemap(urlSet)
+}
+
+// marshalSitemap 序列化站点地图 XML
+func marshalSitemap(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sitemap: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// cachedSEOContent 读取 Redis 缓存，未命中时生成并写入；缓存键为 "{keyPrefix}:{缓存版本号}:{keySuffix}"
+// Redis 不可用或读取缓存版本号失败时直接生成，避免读写已失效版本的缓存
+func cachedSEOContent(c *app.RequestContext, cfgs *configs.Config, keyPrefix, keySuffix string, build func() ([]byte, error)) ([]byte, error) {
+	if global.RedisClient == nil {
+		return build()
+	}
+
+	version, ok := seoCacheVersion(c)
+	if !ok {
+		return build()
+	}
+	cacheKey := fmt.Sprintf("%s:%s:%s", keyPrefix, version, keySuffix)
+
+	if cached, err := global.RedisClient.Get(context.Background(), cacheKey).Bytes(); err == nil {
+		return cached, nil
+	} else if !errors.Is(err, redis.Nil) {
+		logger.BizLogger(c).Warnf("failed to read seo cache %s: %v", cacheKey, err)
+	}
+
+	content, err := build()
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := cfgs.SEOConfig.CacheTTL
+	if ttl <= 0 {
+		ttl = consts.SEODefaultCacheTTL
+	}
+	if err := global.RedisClient.Set(context.Background(), cacheKey, content, time.Duration(ttl)*time.Minute).Err(); err != nil {
+		logger.BizLogger(c).Warnf("failed to write seo cache %s: %v", cacheKey, err)
+	}
+
+	return content, nil
+}
+
+// seoCacheVersion 获取当前 SEO 缓存版本号，版本号尚未设置时为 0
+// 返回值：
+//
+//	string: 缓存版本号
+//	bool: 是否获取成功，Redis 暂时不可用时为 false，调用方应跳过缓存
+func seoCacheVersion(c *app.RequestContext) (string, bool) {
+	version, err := global.RedisClient.Get(context.Background(), consts.SEOCacheVersionKey).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "0", true
+		}
+		logger.BizLogger(c).Warnf("failed to get seo cache version: %v", err)
+		return "", false
+	}
+	return version, true
+}
+
+// invalidateSEOCache 使站点地图与 robots.txt 缓存失效，在文章或分类变更后调用
+func invalidateSEOCache(c *app.RequestContext) {
+	if global.RedisClient == nil {
+		return
+	}
+	if err := global.RedisClient.Incr(context.Background(), consts.SEOCacheVersionKey).Err(); err != nil {
+		logger.BizLogger(c).Warnf("failed to invalidate seo cache: %v", err)
+	}
+}
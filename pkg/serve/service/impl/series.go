@@ -0,0 +1,475 @@
+// Package impl 文章系列服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/series"
+	"github.com/Done-0/jank/internal/model/user"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/db"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/internal/utils/slugify"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// SeriesServiceImpl 文章系列服务实现
+type SeriesServiceImpl struct {
+	seriesMapper mapper.SeriesMapper
+	postMapper   mapper.PostMapper
+	userMapper   mapper.UserMapper
+	rbacMapper   mapper.RBACMapper
+}
+
+// NewSeriesService 创建文章系列服务实例
+func NewSeriesService(seriesMapperImpl mapper.SeriesMapper, postMapperImpl mapper.PostMapper, userMapperImpl mapper.UserMapper, rbacMapperImpl mapper.RBACMapper) service.SeriesService {
+	return &SeriesServiceImpl{
+		seriesMapper: seriesMapperImpl,
+		postMapper:   postMapperImpl,
+		userMapper:   userMapperImpl,
+		rbacMapper:   rbacMapperImpl,
+	}
+}
+
+// GetSeries 获取系列及其文章，支持按 ID 或 slug 查询；未发布文章仅对系列创建者与拥有系列越权管理权限的用户可见
+func (ss *SeriesServiceImpl) GetSeries(c *app.RequestContext, req *dto.GetSeriesRequest) (*vo.GetSeriesResponse, error) {
+	s, err := ss.findSeries(c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	canManage, err := ss.canManageSeries(c, s)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := ss.seriesMapper.ListSeriesPosts(c, s.ID, !canManage)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list posts of series %d: %v", s.ID, err)
+		return nil, fmt.Errorf("failed to list series posts: %w", err)
+	}
+
+	postItems := make([]*vo.SeriesPostItem, 0, len(posts))
+	for i, p := range posts {
+		postItems = append(postItems, &vo.SeriesPostItem{
+			ID:          strconv.FormatInt(p.ID, 10),
+			Title:       p.Title,
+			Slug:        p.Slug,
+			Description: redactProtectedPost(p).Description,
+			Status:      p.Status,
+			Position:    int64(i + 1),
+			CreatedAt:   time.Unix(p.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	var author *user.User
+	if s.AuthorID != 0 {
+		author, _ = ss.userMapper.GetUserByID(c, s.AuthorID)
+	}
+	authorIDStr, authorNickname, authorAvatar := authorFields(author)
+
+	return &vo.GetSeriesResponse{
+		ID:             strconv.FormatInt(s.ID, 10),
+		Title:          s.Title,
+		Slug:           s.Slug,
+		Description:    s.Description,
+		Image:          s.Image,
+		AuthorID:       authorIDStr,
+		AuthorNickname: authorNickname,
+		AuthorAvatar:   authorAvatar,
+		Posts:          postItems,
+		CreatedAt:      time.Unix(s.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+		UpdatedAt:      time.Unix(s.GmtModified, 0).Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// ListSeries 获取系列列表
+func (ss *SeriesServiceImpl) ListSeries(c *app.RequestContext, req *dto.ListSeriesRequest) (*vo.ListSeriesResponse, error) {
+	list, total, err := ss.seriesMapper.ListSeries(c, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list series: %v", err)
+		return nil, fmt.Errorf("failed to list series: %w", err)
+	}
+
+	seriesIDs := make([]int64, 0, len(list))
+	for _, s := range list {
+		seriesIDs = append(seriesIDs, s.ID)
+	}
+
+	postCounts, err := ss.seriesMapper.CountPublishedPostsBySeriesIDs(c, seriesIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count posts by series: %v", err)
+		return nil, fmt.Errorf("failed to count posts by series: %w", err)
+	}
+
+	seriesItems := make([]*vo.SeriesItem, 0, len(list))
+	for _, s := range list {
+		seriesItems = append(seriesItems, &vo.SeriesItem{
+			ID:          strconv.FormatInt(s.ID, 10),
+			Title:       s.Title,
+			Slug:        s.Slug,
+			Description: s.Description,
+			Image:       s.Image,
+			PostCount:   postCounts[s.ID],
+			CreatedAt:   time.Unix(s.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt:   time.Unix(s.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &vo.ListSeriesResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     seriesItems,
+	}, nil
+}
+
+// Create 创建系列
+func (ss *SeriesServiceImpl) Create(c *app.RequestContext, req *dto.CreateSeriesRequest) (*vo.CreateSeriesResponse, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+
+	seriesSlug, err := ss.resolveSeriesSlug(c, req.Slug, req.Title, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs, err := ss.resolveSeriesPostIDs(c, req.PostIDs, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &series.Series{
+		Title:       req.Title,
+		Slug:        seriesSlug,
+		Description: req.Description,
+		Image:       req.Image,
+		AuthorID:    userID.(int64),
+	}
+
+	_, err = db.RunDBTransaction(c, func() (any, error) {
+		if err := ss.seriesMapper.CreateSeries(c, s); err != nil {
+			return nil, err
+		}
+		return nil, ss.seriesMapper.SetSeriesPosts(c, s.ID, postIDs)
+	})
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to create series '%s': %v", req.Title, err)
+		return nil, fmt.Errorf("failed to create series: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("series created successfully with ID: %d", s.ID)
+
+	return &vo.CreateSeriesResponse{
+		ID:          strconv.FormatInt(s.ID, 10),
+		Title:       s.Title,
+		Slug:        s.Slug,
+		Description: s.Description,
+		Image:       s.Image,
+		PostIDs:     formatIDs(postIDs),
+		Message:     "Series created successfully",
+	}, nil
+}
+
+// Update 更新系列
+func (ss *SeriesServiceImpl) Update(c *app.RequestContext, req *dto.UpdateSeriesRequest) (*vo.UpdateSeriesResponse, error) {
+	s, err := ss.getManagedSeries(c, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != "" {
+		s.Title = req.Title
+	}
+	if req.Slug != "" && req.Slug != s.Slug {
+		seriesSlug, err := ss.resolveSeriesSlug(c, req.Slug, s.Title, s.ID)
+		if err != nil {
+			return nil, err
+		}
+		s.Slug = seriesSlug
+	}
+	s.Description = req.Description
+	s.Image = req.Image
+
+	if err := ss.seriesMapper.UpdateSeries(c, s); err != nil {
+		logger.BizLogger(c).Errorf("failed to update series with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update series: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("series updated successfully with ID: %d", s.ID)
+
+	return &vo.UpdateSeriesResponse{
+		ID:          strconv.FormatInt(s.ID, 10),
+		Title:       s.Title,
+		Slug:        s.Slug,
+		Description: s.Description,
+		Image:       s.Image,
+		Message:     "Series updated successfully",
+	}, nil
+}
+
+// Delete 删除系列，系列内文章保留，仅解除关联
+func (ss *SeriesServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteSeriesRequest) (*vo.DeleteSeriesResponse, error) {
+	s, err := ss.getManagedSeries(c, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ss.seriesMapper.DeleteSeries(c, s.ID); err != nil {
+		logger.BizLogger(c).Errorf("failed to delete series with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to delete series: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("series deleted successfully with ID: %s", req.ID)
+
+	return &vo.DeleteSeriesResponse{
+		Message: "Series deleted successfully",
+	}, nil
+}
+
+// Reorder 设置系列文章及顺序，未列出的文章移出系列；已删除的文章不在请求中出现，保留在系列末尾以便恢复
+func (ss *SeriesServiceImpl) Reorder(c *app.RequestContext, req *dto.ReorderSeriesRequest) (*vo.ReorderSeriesResponse, error) {
+	s, err := ss.getManagedSeries(c, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := ss.seriesMapper.ListSeriesPostIDs(c, s.ID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list post IDs of series %d: %v", s.ID, err)
+		return nil, fmt.Errorf("failed to list series posts: %w", err)
+	}
+	members := make(map[int64]struct{}, len(memberIDs))
+	for _, id := range memberIDs {
+		members[id] = struct{}{}
+	}
+
+	postIDs, err := ss.resolveSeriesPostIDs(c, req.PostIDs, s.ID, members)
+	if err != nil {
+		return nil, err
+	}
+
+	activePosts, err := ss.seriesMapper.ListSeriesPosts(c, s.ID, false)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list posts of series %d: %v", s.ID, err)
+		return nil, fmt.Errorf("failed to list series posts: %w", err)
+	}
+	active := make(map[int64]struct{}, len(activePosts))
+	for _, p := range activePosts {
+		active[p.ID] = struct{}{}
+	}
+
+	ordered := postIDs
+	for _, id := range memberIDs {
+		if _, ok := active[id]; !ok {
+			ordered = append(ordered, id)
+		}
+	}
+
+	if err := ss.seriesMapper.SetSeriesPosts(c, s.ID, ordered); err != nil {
+		logger.BizLogger(c).Errorf("failed to set posts of series %d: %v", s.ID, err)
+		return nil, fmt.Errorf("failed to set series posts: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("series posts reordered successfully with ID: %d", s.ID)
+
+	return &vo.ReorderSeriesResponse{
+		ID:      strconv.FormatInt(s.ID, 10),
+		PostIDs: formatIDs(postIDs),
+		Message: "Series posts reordered successfully",
+	}, nil
+}
+
+// findSeries 按 ID 或 slug 查询系列
+func (ss *SeriesServiceImpl) findSeries(c *app.RequestContext, req *dto.GetSeriesRequest) (*series.Series, error) {
+	if req.ID != "" {
+		seriesID, err := strconv.ParseInt(req.ID, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid series ID format: %s", req.ID)
+			return nil, fmt.Errorf("%w: series ID: %w", service.ErrInvalidID, err)
+		}
+
+		s, err := ss.seriesMapper.GetSeriesByID(c, seriesID)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get series with ID %s: %v", req.ID, err)
+			return nil, fmt.Errorf("series not found: %w", err)
+		}
+		return s, nil
+	}
+
+	s, err := ss.seriesMapper.GetSeriesBySlug(c, req.Slug)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get series with slug %s: %v", req.Slug, err)
+		return nil, fmt.Errorf("series not found: %w", err)
+	}
+	return s, nil
+}
+
+// getManagedSeries 获取当前用户可管理的系列，非创建者需拥有系列越权管理权限
+func (ss *SeriesServiceImpl) getManagedSeries(c *app.RequestContext, rawID string) (*series.Series, error) {
+	seriesID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid series ID format: %s", rawID)
+		return nil, fmt.Errorf("%w: series ID: %w", service.ErrInvalidID, err)
+	}
+
+	s, err := ss.seriesMapper.GetSeriesByID(c, seriesID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("series with ID %s not found: %v", rawID, err)
+		return nil, fmt.Errorf("series not found: %w", err)
+	}
+
+	if _, exists := c.Get(consts.JWTSubjectClaim); !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return nil, service.ErrAuthenticationRequired
+	}
+
+	allowed, err := ss.canManageSeries(c, s)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user attempted to modify series %d owned by user %d", s.ID, s.AuthorID)
+		return nil, fmt.Errorf("%w: only the creator can modify this series", service.ErrPermissionDenied)
+	}
+
+	return s, nil
+}
+
+// canManageSeries 判断当前用户是否可管理系列，未登录时返回 false
+func (ss *SeriesServiceImpl) canManageSeries(c *app.RequestContext, s *series.Series) (bool, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		return false, nil
+	}
+
+	currentUserID := userID.(int64)
+	if s.AuthorID == currentUserID {
+		return true, nil
+	}
+
+	allowed, err := ss.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.SeriesOverrideResource, consts.SeriesOverrideAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check series override permission for user %d: %v", currentUserID, err)
+		return false, fmt.Errorf("failed to check permission: %w", err)
+	}
+	return allowed, nil
+}
+
+// resolveSeriesPostIDs 解析并校验系列文章 ID：不得重复，文章须存在；新加入的文章须由当前用户撰写（或拥有文章越权管理权限）且未加入其他系列
+func (ss *SeriesServiceImpl) resolveSeriesPostIDs(c *app.RequestContext, rawPostIDs []string, seriesID int64, members map[int64]struct{}) ([]int64, error) {
+	postIDs := make([]int64, 0, len(rawPostIDs))
+	seen := make(map[int64]struct{}, len(rawPostIDs))
+	for _, raw := range rawPostIDs {
+		postID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			logger.BizLogger(c).Errorf("invalid post ID format: %s", raw)
+			return nil, fmt.Errorf("%w: post ID: %w", service.ErrInvalidID, err)
+		}
+		if _, ok := seen[postID]; ok {
+			logger.BizLogger(c).Errorf("duplicate post ID in series: %d", postID)
+			return nil, fmt.Errorf("duplicate post ID: %d", postID)
+		}
+		seen[postID] = struct{}{}
+		postIDs = append(postIDs, postID)
+	}
+	if len(postIDs) == 0 {
+		return postIDs, nil
+	}
+
+	posts, err := ss.postMapper.GetPostsByIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get series posts: %v", err)
+		return nil, fmt.Errorf("failed to get posts: %w", err)
+	}
+	if len(posts) != len(postIDs) {
+		logger.BizLogger(c).Errorf("some series posts not found, expected %d, found %d", len(postIDs), len(posts))
+		return nil, service.ErrPostNotFound
+	}
+
+	memberships, err := ss.seriesMapper.ListSeriesPostsByPostIDs(c, postIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get series memberships: %v", err)
+		return nil, fmt.Errorf("failed to get series memberships: %w", err)
+	}
+	for _, sp := range memberships {
+		if sp.SeriesID != seriesID {
+			logger.BizLogger(c).Errorf("post %d already belongs to series %d", sp.PostID, sp.SeriesID)
+			return nil, fmt.Errorf("post %d already belongs to another series", sp.PostID)
+		}
+	}
+
+	currentUserID := c.MustGet(consts.JWTSubjectClaim).(int64)
+	var override *bool
+	for _, p := range posts {
+		if _, ok := members[p.ID]; ok || p.AuthorID == currentUserID {
+			continue
+		}
+		if override == nil {
+			allowed, err := ss.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.PostOverrideResource, consts.PostOverrideAction)
+			if err != nil {
+				logger.BizLogger(c).Errorf("failed to check post override permission for user %d: %v", currentUserID, err)
+				return nil, fmt.Errorf("failed to check permission: %w", err)
+			}
+			override = &allowed
+		}
+		if !*override {
+			logger.BizLogger(c).Warnf("user ID %d attempted to add post %d owned by user %d to a series", currentUserID, p.ID, p.AuthorID)
+			return nil, fmt.Errorf("%w: only the author can add this post to a series", service.ErrPermissionDenied)
+		}
+	}
+
+	return postIDs, nil
+}
+
+// resolveSeriesSlug 确定系列 slug：显式指定时校验唯一性，否则根据标题生成并自动追加序号去重
+func (ss *SeriesServiceImpl) resolveSeriesSlug(c *app.RequestContext, explicit, title string, excludeID int64) (string, error) {
+	taken := func(s string) (bool, error) {
+		return ss.seriesMapper.IsSeriesSlugTaken(c, s, excludeID)
+	}
+
+	if explicit != "" {
+		exists, err := taken(explicit)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to check series slug '%s': %v", explicit, err)
+			return "", fmt.Errorf("failed to check series slug: %w", err)
+		}
+		if exists {
+			logger.BizLogger(c).Errorf("series slug '%s' already exists", explicit)
+			return "", fmt.Errorf("%w: %s", service.ErrSlugConflict, explicit)
+		}
+		return explicit, nil
+	}
+
+	base := slugify.Make(title)
+	if base == "" {
+		base = consts.SlugEntitySeries
+	}
+	seriesSlug, err := slugify.Unique(base, taken)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to generate slug for series '%s': %v", title, err)
+		return "", fmt.Errorf("failed to generate series slug: %w", err)
+	}
+	return seriesSlug, nil
+}
+
+// formatIDs 将 ID 列表格式化为字符串列表
+func formatIDs(ids []int64) []string {
+	formatted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		formatted = append(formatted, strconv.FormatInt(id, 10))
+	}
+	return formatted
+}
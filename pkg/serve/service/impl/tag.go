@@ -0,0 +1,172 @@
+// Package impl 标签服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/internal/model/tag"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/mapper"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// TagServiceImpl 标签服务实现
+type TagServiceImpl struct {
+	tagMapper mapper.TagMapper
+}
+
+// NewTagService 创建标签服务实例
+func NewTagService(tagMapperImpl mapper.TagMapper) service.TagService {
+	return &TagServiceImpl{
+		tagMapper: tagMapperImpl,
+	}
+}
+
+// ListTags 获取标签列表
+func (ts *TagServiceImpl) ListTags(c *app.RequestContext, req *dto.ListTagsRequest) (*vo.ListTagsResponse, error) {
+	tags, total, err := ts.tagMapper.ListTags(c, req.PageNo, req.PageSize, req.Keyword)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list tags: %v", err)
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tagIDs := make([]int64, 0, len(tags))
+	for _, t := range tags {
+		tagIDs = append(tagIDs, t.ID)
+	}
+
+	postCounts, err := ts.tagMapper.CountPublishedPostsByTagIDs(c, tagIDs)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to count posts by tags: %v", err)
+		return nil, fmt.Errorf("failed to count posts by tags: %w", err)
+	}
+
+	tagItems := make([]*vo.TagItem, 0, len(tags))
+	for _, t := range tags {
+		tagItems = append(tagItems, &vo.TagItem{
+			ID:          strconv.FormatInt(t.ID, 10),
+			Name:        t.Name,
+			Description: t.Description,
+			PostCount:   postCounts[t.ID],
+			CreatedAt:   time.Unix(t.GmtCreated, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt:   time.Unix(t.GmtModified, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &vo.ListTagsResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     tagItems,
+	}, nil
+}
+
+// Create 创建标签
+func (ts *TagServiceImpl) Create(c *app.RequestContext, req *dto.CreateTagRequest) (*vo.CreateTagResponse, error) {
+	name := normalizeTagName(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("tag name is required")
+	}
+	if _, err := ts.tagMapper.GetTagByName(c, name); err == nil {
+		logger.BizLogger(c).Errorf("tag name '%s' is already in use", name)
+		return nil, fmt.Errorf("tag name '%s' is %w", name, service.ErrAlreadyInUse)
+	}
+
+	t := &tag.Tag{
+		Name:        name,
+		Description: req.Description,
+	}
+
+	if err := ts.tagMapper.CreateTag(c, t); err != nil {
+		logger.BizLogger(c).Errorf("failed to create tag '%s': %v", name, err)
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("tag created successfully with ID: %d", t.ID)
+
+	return &vo.CreateTagResponse{
+		ID:          strconv.FormatInt(t.ID, 10),
+		Name:        t.Name,
+		Description: t.Description,
+		Message:     "Tag created successfully",
+	}, nil
+}
+
+// Update 更新标签
+func (ts *TagServiceImpl) Update(c *app.RequestContext, req *dto.UpdateTagRequest) (*vo.UpdateTagResponse, error) {
+	tagID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid tag ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: tag ID: %w", service.ErrInvalidID, err)
+	}
+
+	existingTag, err := ts.tagMapper.GetTagByID(c, tagID)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to get tag with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	if name := normalizeTagName(req.Name); name != "" && name != existingTag.Name {
+		if sameName, err := ts.tagMapper.GetTagByName(c, name); err == nil && sameName.ID != existingTag.ID {
+			logger.BizLogger(c).Errorf("tag name '%s' is already in use", name)
+			return nil, fmt.Errorf("tag name '%s' is %w", name, service.ErrAlreadyInUse)
+		}
+		existingTag.Name = name
+	}
+	if req.Description != nil {
+		existingTag.Description = *req.Description
+	}
+
+	if err := ts.tagMapper.UpdateTag(c, existingTag); err != nil {
+		logger.BizLogger(c).Errorf("failed to update tag with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("tag updated successfully with ID: %d", existingTag.ID)
+
+	return &vo.UpdateTagResponse{
+		ID:          strconv.FormatInt(existingTag.ID, 10),
+		Name:        existingTag.Name,
+		Description: existingTag.Description,
+		Message:     "Tag updated successfully",
+	}, nil
+}
+
+// Delete 删除标签
+func (ts *TagServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteTagRequest) (*vo.DeleteTagResponse, error) {
+	tagID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid tag ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: tag ID: %w", service.ErrInvalidID, err)
+	}
+
+	if _, err := ts.tagMapper.GetTagByID(c, tagID); err != nil {
+		logger.BizLogger(c).Errorf("tag with ID %s not found: %v", req.ID, err)
+		return nil, fmt.Errorf("tag not found: %w", err)
+	}
+
+	if err := ts.tagMapper.DeleteTag(c, tagID); err != nil {
+		logger.BizLogger(c).Errorf("failed to delete tag with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("tag deleted successfully with ID: %s", req.ID)
+
+	return &vo.DeleteTagResponse{
+		Message: "Tag deleted successfully",
+	}, nil
+}
+
+// normalizeTagName 规范化标签名称：去除首尾空白并将连续空白合并为单个空格，避免仅空白不同的重复标签
+func normalizeTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
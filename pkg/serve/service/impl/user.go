@@ -18,6 +18,7 @@ import (
 	"github.com/Done-0/jank/internal/global"
 	"github.com/Done-0/jank/internal/model/user"
 	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/cursor"
 	"github.com/Done-0/jank/internal/utils/logger"
 	"github.com/Done-0/jank/internal/utils/verification"
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
@@ -87,7 +88,7 @@ func (us *UserServiceImpl) Register(c *app.RequestContext, req *dto.RegisterRequ
 
 	userIDStr := strconv.FormatInt(u.ID, 10)
 	if _, err := us.rbacMapper.AssignRole(c, userIDStr, cfgs.AppConfig.User.DefaultRole); err != nil {
-		us.userMapper.DeleteUser(c, userIDStr)
+		us.userMapper.PurgeUser(c, u.ID)
 		return nil, fmt.Errorf("user registration failed due to RBAC system error: %w", err)
 	}
 
@@ -289,7 +290,7 @@ func (us *UserServiceImpl) GetProfile(c *app.RequestContext) (*vo.GetProfileResp
 	userID, exists := c.Get(consts.JWTSubjectClaim)
 	if !exists {
 		logger.BizLogger(c).Errorf("unable to get current user ID from context")
-		return nil, fmt.Errorf("authentication required")
+		return nil, service.ErrAuthenticationRequired
 	}
 
 	u, err := us.userMapper.GetUserByID(c, userID.(int64))
@@ -336,7 +337,7 @@ func (us *UserServiceImpl) Update(c *app.RequestContext, req *dto.UpdateRequest)
 		existingUser, err := us.userMapper.GetUserByNickname(c, req.Nickname)
 		if err == nil && existingUser.ID != u.ID {
 			logger.BizLogger(c).Errorf("nickname '%s' is already in use", req.Nickname)
-			return nil, fmt.Errorf("nickname '%s' is already in use", req.Nickname)
+			return nil, fmt.Errorf("nickname '%s' is %w", req.Nickname, service.ErrAlreadyInUse)
 		}
 	}
 
@@ -413,12 +414,34 @@ func (us *UserServiceImpl) ResetPassword(c *app.RequestContext, req *dto.ResetPa
 	}, nil
 }
 
-// ListUsers 获取用户列表逻辑
+// ListUsers 获取用户列表逻辑，未指定页码时使用游标分页
 func (us *UserServiceImpl) ListUsers(c *app.RequestContext, req *dto.ListUsersRequest) (*vo.ListUsersResponse, error) {
-	users, total, err := us.userMapper.ListUsers(c, req.PageNo, req.PageSize, req.Keyword, req.Role)
-	if err != nil {
-		logger.BizLogger(c).Errorf("failed to get user list: %v", err)
-		return nil, fmt.Errorf("failed to get user list: %w", err)
+	var users []*user.User
+	var total int64
+	var nextCursor, prevCursor string
+	if req.PageNo == 0 {
+		cur, err := cursor.Decode(req.Cursor)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to decode cursor: %v", err)
+			return nil, err
+		}
+		page, count, err := us.userMapper.ListUsersWithCursor(c, cur, req.PageSize, req.WithTotal, req.Keyword, req.Role)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get user list: %v", err)
+			return nil, fmt.Errorf("failed to get user list: %w", err)
+		}
+		users, total = page.List, count
+		if !req.WithTotal {
+			total = cursor.TotalSkipped
+		}
+		nextCursor, prevCursor = cursor.Encode(page.Next), cursor.Encode(page.Prev)
+	} else {
+		var err error
+		users, total, err = us.userMapper.ListUsers(c, req.PageNo, req.PageSize, req.Keyword, req.Role)
+		if err != nil {
+			logger.BizLogger(c).Errorf("failed to get user list: %v", err)
+			return nil, fmt.Errorf("failed to get user list: %w", err)
+		}
 	}
 
 	list := make([]*vo.UserItem, 0, len(users))
@@ -442,10 +465,12 @@ func (us *UserServiceImpl) ListUsers(c *app.RequestContext, req *dto.ListUsersRe
 	}
 
 	return &vo.ListUsersResponse{
-		Total:    total,
-		PageNo:   req.PageNo,
-		PageSize: req.PageSize,
-		List:     list,
+		Total:      total,
+		PageNo:     req.PageNo,
+		PageSize:   req.PageSize,
+		List:       list,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
 	}, nil
 }
 
@@ -454,7 +479,7 @@ func (us *UserServiceImpl) UpdateUserRole(c *app.RequestContext, req *dto.Update
 	userID, exists := c.Get(consts.JWTSubjectClaim)
 	if !exists {
 		logger.BizLogger(c).Errorf("unable to get current user ID from context")
-		return nil, fmt.Errorf("authentication required")
+		return nil, service.ErrAuthenticationRequired
 	}
 
 	currentUserID, ok := userID.(int64)
@@ -485,7 +510,7 @@ func (us *UserServiceImpl) UpdateUserRole(c *app.RequestContext, req *dto.Update
 
 	if !hasPermission {
 		logger.BizLogger(c).Warnf("user ID %d attempted to update user role without permission", currentUserID)
-		return nil, fmt.Errorf("insufficient permissions: you do not have permission to update user roles")
+		return nil, fmt.Errorf("%w: you do not have permission to update user roles", service.ErrPermissionDenied)
 	}
 
 	if fmt.Sprintf("%d", currentUserID) == req.ID {
@@ -496,7 +521,7 @@ func (us *UserServiceImpl) UpdateUserRole(c *app.RequestContext, req *dto.Update
 	targetUserID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		logger.BizLogger(c).Errorf("invalid target user ID format: %v", err)
-		return nil, fmt.Errorf("invalid target user ID format: %w", err)
+		return nil, fmt.Errorf("%w: target user ID: %w", service.ErrInvalidID, err)
 	}
 
 	targetUser, err := us.userMapper.GetUserByID(c, targetUserID)
@@ -0,0 +1,178 @@
+// Package impl 用户回收站服务实现
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+
+	"github.com/Done-0/jank/internal/global"
+	"github.com/Done-0/jank/internal/model/base"
+	"github.com/Done-0/jank/internal/trash"
+	"github.com/Done-0/jank/internal/types/consts"
+	"github.com/Done-0/jank/internal/utils/logger"
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/serve/service"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// Delete 将用户移入回收站并使其登录状态失效，用户的文章、评论与角色保留至永久删除
+func (us *UserServiceImpl) Delete(c *app.RequestContext, req *dto.DeleteUserRequest) (*vo.DeleteUserResponse, error) {
+	currentUserID, err := us.checkUserManagePermission(c)
+	if err != nil {
+		return nil, err
+	}
+
+	targetUserID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid user ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: user ID: %w", service.ErrInvalidID, err)
+	}
+	if targetUserID == currentUserID {
+		logger.BizLogger(c).Warnf("user ID %d attempted to delete themselves", currentUserID)
+		return nil, service.ErrCannotDeleteSelf
+	}
+
+	if err := us.userMapper.DeleteUser(c, targetUserID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %s", service.ErrUserNotFound, req.ID)
+		}
+		logger.BizLogger(c).Errorf("failed to delete user with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	// 令牌失效失败不影响删除结果，已删除用户的令牌在过期前仍无法通过邮箱重新登录
+	if global.RedisClient != nil {
+		keys := []string{
+			fmt.Sprintf("%s:%d", consts.AuthAccessTokenKeyPrefix, targetUserID),
+			fmt.Sprintf("%s:%d", consts.AuthRefreshTokenKeyPrefix, targetUserID),
+		}
+		if err := global.RedisClient.Del(context.Background(), keys...).Err(); err != nil {
+			logger.BizLogger(c).Warnf("failed to revoke tokens of deleted user %d: %v", targetUserID, err)
+		}
+	}
+
+	logger.BizLogger(c).Infof("user %d moved to trash by user %d", targetUserID, currentUserID)
+
+	return &vo.DeleteUserResponse{
+		Message: "User deleted successfully",
+	}, nil
+}
+
+// ListTrash 获取回收站中的用户
+func (us *UserServiceImpl) ListTrash(c *app.RequestContext, req *dto.ListTrashRequest) (*vo.ListTrashedUsersResponse, error) {
+	if _, err := us.checkUserManagePermission(c); err != nil {
+		return nil, err
+	}
+
+	users, total, err := us.userMapper.ListDeletedUsers(c, req.PageNo, req.PageSize)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to list trashed users: %v", err)
+		return nil, fmt.Errorf("failed to list trashed users: %w", err)
+	}
+
+	list := make([]*vo.TrashedUserItem, 0, len(users))
+	for _, u := range users {
+		email, nickname := trashedUserIdentity(u.Ext, u.Email, u.Nickname)
+		list = append(list, &vo.TrashedUserItem{
+			ID:        strconv.FormatInt(u.ID, 10),
+			Email:     email,
+			Nickname:  nickname,
+			DeletedAt: time.Unix(trash.DeletedAt(&u.Base), 0).Format("2006-01-02 15:04:05"),
+			PurgeAt:   formatPurgeAt(&u.Base),
+		})
+	}
+
+	return &vo.ListTrashedUsersResponse{
+		Total:    total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+		List:     list,
+	}, nil
+}
+
+// Restore 从回收站恢复用户，原邮箱或昵称已被其他用户使用时拒绝恢复
+func (us *UserServiceImpl) Restore(c *app.RequestContext, req *dto.RestoreUserRequest) (*vo.RestoreUserResponse, error) {
+	if _, err := us.checkUserManagePermission(c); err != nil {
+		return nil, err
+	}
+
+	targetUserID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		logger.BizLogger(c).Errorf("invalid user ID format: %s", req.ID)
+		return nil, fmt.Errorf("%w: user ID: %w", service.ErrInvalidID, err)
+	}
+
+	u, err := us.userMapper.GetDeletedUserByID(c, targetUserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w in trash: %s", service.ErrUserNotFound, req.ID)
+		}
+		logger.BizLogger(c).Errorf("failed to get trashed user with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to get trashed user: %w", err)
+	}
+
+	email, nickname := trashedUserIdentity(u.Ext, u.Email, u.Nickname)
+	if existing, err := us.userMapper.GetUserByEmail(c, email); err == nil && existing.ID != u.ID {
+		logger.BizLogger(c).Warnf("cannot restore user %s: email %s is used by user %d", req.ID, email, existing.ID)
+		return nil, fmt.Errorf("email %w by another user: %s", service.ErrAlreadyInUse, email)
+	}
+	if existing, err := us.userMapper.GetUserByNickname(c, nickname); err == nil && existing.ID != u.ID {
+		logger.BizLogger(c).Warnf("cannot restore user %s: nickname %s is used by user %d", req.ID, nickname, existing.ID)
+		return nil, fmt.Errorf("nickname %w by another user: %s", service.ErrAlreadyInUse, nickname)
+	}
+
+	if err := us.userMapper.RestoreUser(c, u, email, nickname); err != nil {
+		logger.BizLogger(c).Errorf("failed to restore user with ID %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	logger.BizLogger(c).Infof("user restored from trash with ID: %s", req.ID)
+
+	return &vo.RestoreUserResponse{
+		ID:       req.ID,
+		Email:    email,
+		Nickname: nickname,
+		Message:  "User restored successfully",
+	}, nil
+}
+
+// checkUserManagePermission 校验当前用户是否有权管理用户，返回当前用户 ID
+func (us *UserServiceImpl) checkUserManagePermission(c *app.RequestContext) (int64, error) {
+	userID, exists := c.Get(consts.JWTSubjectClaim)
+	if !exists {
+		logger.BizLogger(c).Errorf("unable to get current user ID from context")
+		return 0, service.ErrAuthenticationRequired
+	}
+	currentUserID := userID.(int64)
+
+	allowed, err := us.rbacMapper.CheckPermission(c, strconv.FormatInt(currentUserID, 10), consts.UserManageResource, consts.UserManageAction)
+	if err != nil {
+		logger.BizLogger(c).Errorf("failed to check user manage permission for user %d: %v", currentUserID, err)
+		return 0, fmt.Errorf("failed to check permission: %w", err)
+	}
+	if !allowed {
+		logger.BizLogger(c).Warnf("user ID %d attempted to manage users without permission", currentUserID)
+		return 0, fmt.Errorf("%w: user management access required", service.ErrPermissionDenied)
+	}
+
+	return currentUserID, nil
+}
+
+// trashedUserIdentity 取回用户删除前的邮箱与昵称，未暂存时沿用当前值
+func trashedUserIdentity(ext base.JSONMap, email, nickname string) (string, string) {
+	if stashed := base.TrashedValue(ext, "email"); stashed != "" {
+		email = stashed
+	}
+	if stashed := base.TrashedValue(ext, "nickname"); stashed != "" {
+		nickname = stashed
+	}
+	return email, nickname
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// MediaService 媒体文件服务接口
+type MediaService interface {
+	Upload(c *app.RequestContext) (*vo.UploadMediaResponse, error)                              // 上传媒体文件
+	ListMedia(c *app.RequestContext, req *dto.ListMediaRequest) (*vo.ListMediaResponse, error)  // 获取媒体文件列表
+	Delete(c *app.RequestContext, req *dto.DeleteMediaRequest) (*vo.DeleteMediaResponse, error) // 删除媒体文件
+}
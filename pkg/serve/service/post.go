@@ -1,18 +1,61 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/cloudwego/hertz/pkg/app"
 
 	"github.com/Done-0/jank/pkg/serve/controller/dto"
 	"github.com/Done-0/jank/pkg/vo"
 )
 
+// ErrPostNotFound 文章不存在，或当前用户无权阅读未公开的文章
+var ErrPostNotFound = errors.New("post not found")
+
+// ErrInvalidIfMatch If-Match 请求头格式错误或使用了弱校验标签
+var ErrInvalidIfMatch = errors.New("invalid If-Match header")
+
 // PostService 文章服务接口
 type PostService interface {
-	GetPost(c *app.RequestContext, req *dto.GetPostRequest) (*vo.GetPostResponse, error)                         // 获取单篇文章
-	ListPublishedPosts(c *app.RequestContext, req *dto.ListPublishedPostsRequest) (*vo.ListPostsResponse, error) // 获取已发布文章列表
-	ListPostsByStatus(c *app.RequestContext, req *dto.ListPostsByStatusRequest) (*vo.ListPostsResponse, error)   // 根据状态获取文章列表，支持管理员查询所有文章
-	Create(c *app.RequestContext, req *dto.CreatePostRequest) (*vo.CreatePostResponse, error)                    // 创建文章
-	Update(c *app.RequestContext, req *dto.UpdatePostRequest) (*vo.UpdatePostResponse, error)                    // 更新文章
-	Delete(c *app.RequestContext, req *dto.DeletePostRequest) (*vo.DeletePostResponse, error)                    // 删除文章
+	GetPost(c *app.RequestContext, req *dto.GetPostRequest) (*vo.GetPostResponse, error)                                 // 获取单篇文章
+	ListPublishedPosts(c *app.RequestContext, req *dto.ListPublishedPostsRequest) (*vo.ListPostsResponse, error)         // 获取已发布文章列表
+	ListPostsByStatus(c *app.RequestContext, req *dto.ListPostsByStatusRequest) (*vo.ListPostsResponse, error)           // 根据状态获取文章列表，支持管理员查询所有文章
+	ListPostsByAuthor(c *app.RequestContext, req *dto.ListPostsByAuthorRequest) (*vo.ListPostsResponse, error)           // 获取指定作者的已发布文章列表
+	SearchPosts(c *app.RequestContext, req *dto.SearchPostsRequest) (*vo.SearchPostsResponse, error)                     // 全文检索已发布文章
+	ListRelatedPosts(c *app.RequestContext, req *dto.ListRelatedPostsRequest) (*vo.ListRelatedPostsResponse, error)      // 获取相关文章
+	Create(c *app.RequestContext, req *dto.CreatePostRequest) (*vo.CreatePostResponse, error)                            // 创建文章
+	Update(c *app.RequestContext, req *dto.UpdatePostRequest) (*vo.UpdatePostResponse, error)                            // 更新文章
+	Delete(c *app.RequestContext, req *dto.DeletePostRequest) (*vo.DeletePostResponse, error)                            // 删除文章
+	Bulk(c *app.RequestContext, req *dto.BulkPostsRequest) (*vo.BulkPostsResponse, error)                                // 批量修改状态、移动分类、删除、恢复或重新渲染文章
+	ListTrash(c *app.RequestContext, req *dto.ListTrashRequest) (*vo.ListTrashedPostsResponse, error)                    // 获取回收站中的文章
+	Restore(c *app.RequestContext, req *dto.RestorePostRequest) (*vo.RestorePostResponse, error)                         // 从回收站恢复文章
+	ListRevisions(c *app.RequestContext, req *dto.ListPostRevisionsRequest) (*vo.ListPostRevisionsResponse, error)       // 获取文章修订列表
+	DiffRevisions(c *app.RequestContext, req *dto.DiffPostRevisionsRequest) (*vo.DiffPostRevisionsResponse, error)       // 对比两个文章修订
+	RestoreRevision(c *app.RequestContext, req *dto.RestorePostRevisionRequest) (*vo.RestorePostRevisionResponse, error) // 将文章恢复为指定修订
+	React(c *app.RequestContext, req *dto.ReactPostRequest) (*vo.ReactPostResponse, error)                               // 添加文章表态，重复表态不会重复计数
+	Unreact(c *app.RequestContext, req *dto.ReactPostRequest) (*vo.ReactPostResponse, error)                             // 取消文章表态
+	ListFeaturedPosts(c *app.RequestContext, req *dto.ListFeaturedPostsRequest) (*vo.ListPostsResponse, error)           // 获取精选文章列表
+	Pin(c *app.RequestContext, req *dto.PinPostRequest) (*vo.PinPostResponse, error)                                     // 设置或取消文章置顶
+	Feature(c *app.RequestContext, req *dto.FeaturePostRequest) (*vo.FeaturePostResponse, error)                         // 设置或取消文章精选
+	ReorderPosts(c *app.RequestContext, req *dto.ReorderPostsRequest) (*vo.ReorderPostsResponse, error)                  // 批量调整置顶或精选文章顺序
+	ListReactedPosts(c *app.RequestContext, req *dto.ListReactedPostsRequest) (*vo.ListPostsResponse, error)             // 获取当前用户表态过的文章列表
+	Unlock(c *app.RequestContext, req *dto.UnlockPostRequest) (*vo.UnlockPostResponse, error)                            // 校验访问密码并下发密码保护文章的解锁凭证
+	CreatePreview(c *app.RequestContext, req *dto.CreatePostPreviewRequest) (*vo.CreatePostPreviewResponse, error)       // 创建文章预览链接
+	ListPreviews(c *app.RequestContext, req *dto.ListPostPreviewsRequest) (*vo.ListPostPreviewsResponse, error)          // 获取文章未过期的预览链接
+	RevokePreview(c *app.RequestContext, req *dto.RevokePostPreviewRequest) (*vo.RevokePostPreviewResponse, error)       // 撤销文章预览链接
+	Preview(c *app.RequestContext, req *dto.PreviewPostRequest) (*vo.GetPostResponse, error)                             // 通过预览令牌获取文章
+	Autosave(c *app.RequestContext, req *dto.AutosavePostRequest) (*vo.AutosavePostResponse, error)                      // 自动保存当前用户的文章草稿
+	GetAutosave(c *app.RequestContext, req *dto.GetPostAutosaveRequest) (*vo.GetPostAutosaveResponse, error)             // 获取当前用户自动保存的文章草稿
+	DiscardAutosave(c *app.RequestContext, req *dto.DiscardPostAutosaveRequest) (*vo.DiscardPostAutosaveResponse, error) // 丢弃当前用户自动保存的文章草稿
+}
+
+// PostVersionConflictError 文章编辑冲突错误，编辑所基于的版本已落后于服务端，Conflict 携带服务端当前的文章副本
+type PostVersionConflictError struct {
+	Conflict *vo.PostVersionConflict
+}
+
+// Error 返回冲突描述
+func (e *PostVersionConflictError) Error() string {
+	return fmt.Sprintf("post version conflict: expected version %d, current version %d", e.Conflict.ExpectedVersion, e.Conflict.CurrentVersion)
 }
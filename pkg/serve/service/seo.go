@@ -0,0 +1,13 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+)
+
+// SEOService 搜索引擎优化服务接口
+type SEOService interface {
+	GetSitemap(c *app.RequestContext, req *dto.GetSitemapRequest) ([]byte, error) // 获取站点地图（或站点地图索引）
+	GetRobots(c *app.RequestContext) ([]byte, error)                              // 获取 robots.txt
+}
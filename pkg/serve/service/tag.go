@@ -0,0 +1,16 @@
+package service
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/Done-0/jank/pkg/serve/controller/dto"
+	"github.com/Done-0/jank/pkg/vo"
+)
+
+// TagService 标签服务接口
+type TagService interface {
+	ListTags(c *app.RequestContext, req *dto.ListTagsRequest) (*vo.ListTagsResponse, error) // 获取标签列表
+	Create(c *app.RequestContext, req *dto.CreateTagRequest) (*vo.CreateTagResponse, error) // 创建标签
+	Update(c *app.RequestContext, req *dto.UpdateTagRequest) (*vo.UpdateTagResponse, error) // 更新标签
+	Delete(c *app.RequestContext, req *dto.DeleteTagRequest) (*vo.DeleteTagResponse, error) // 删除标签
+}
@@ -18,4 +18,7 @@ type UserService interface {
 	ResetPassword(c *app.RequestContext, req *dto.ResetPasswordRequest) (*vo.ResetPasswordResponse, error)    // 重置密码
 	ListUsers(c *app.RequestContext, req *dto.ListUsersRequest) (*vo.ListUsersResponse, error)                // 获取用户列表
 	UpdateUserRole(c *app.RequestContext, req *dto.UpdateUserRoleRequest) (*vo.UpdateUserRoleResponse, error) // 管理员更新用户角色
+	Delete(c *app.RequestContext, req *dto.DeleteUserRequest) (*vo.DeleteUserResponse, error)                 // 管理员将用户移入回收站
+	ListTrash(c *app.RequestContext, req *dto.ListTrashRequest) (*vo.ListTrashedUsersResponse, error)         // 管理员获取回收站中的用户
+	Restore(c *app.RequestContext, req *dto.RestoreUserRequest) (*vo.RestoreUserResponse, error)              // 管理员从回收站恢复用户
 }
@@ -0,0 +1,49 @@
+// Package vo 提供浏览统计相关的值对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// DailyViews 每日浏览量
+type DailyViews struct {
+	Date  string `json:"date"`  // 日期
+	Views int64  `json:"views"` // 当日去重浏览量
+}
+
+// GetPostViewsResponse 获取文章每日浏览量响应
+type GetPostViewsResponse struct {
+	PostID    string        `json:"post_id"`    // 文章 ID
+	Title     string        `json:"title"`      // 文章标题
+	StartDate string        `json:"start_date"` // 开始日期
+	EndDate   string        `json:"end_date"`   // 结束日期
+	Total     int64         `json:"total"`      // 时间范围内浏览量合计
+	List      []*DailyViews `json:"list"`       // 每日浏览量，无浏览的日期补 0
+}
+
+// TopPostItem 浏览量排行项
+type TopPostItem struct {
+	PostID string `json:"post_id"` // 文章 ID
+	Title  string `json:"title"`   // 文章标题
+	Slug   string `json:"slug"`    // 文章 slug
+	Views  int64  `json:"views"`   // 时间范围内浏览量
+}
+
+// ListTopPostsResponse 浏览量最高的文章响应
+type ListTopPostsResponse struct {
+	StartDate string         `json:"start_date"` // 开始日期
+	EndDate   string         `json:"end_date"`   // 结束日期
+	List      []*TopPostItem `json:"list"`       // 文章列表，按浏览量降序
+}
+
+// ReferrerItem 来源统计项
+type ReferrerItem struct {
+	Referrer string `json:"referrer"` // 来源域名，直接访问为 direct
+	Views    int64  `json:"views"`    // 浏览量
+}
+
+// ListReferrersResponse 来源统计响应
+type ListReferrersResponse struct {
+	PostID    string          `json:"post_id"`    // 文章 ID，统计全站时为空
+	StartDate string          `json:"start_date"` // 开始日期
+	EndDate   string          `json:"end_date"`   // 结束日期
+	List      []*ReferrerItem `json:"list"`       // 来源列表，按浏览量降序
+}
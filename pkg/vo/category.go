@@ -5,36 +5,45 @@ package vo
 
 // CreateCategoryResponse 创建分类响应
 type CreateCategoryResponse struct {
-	ID          string `json:"id"`          // 分类 ID
-	Name        string `json:"name"`        // 分类名称
-	Description string `json:"description"` // 分类描述
-	ParentID    string `json:"parent_id"`   // 父分类 ID
-	Sort        int64  `json:"sort"`        // 排序权重
-	IsActive    bool   `json:"is_active"`   // 是否启用
-	Message     string `json:"message"`     // 创建结果消息
+	ID                 string `json:"id"`                   // 分类 ID
+	Name               string `json:"name"`                 // 分类名称
+	Slug               string `json:"slug"`                 // 分类 slug
+	Description        string `json:"description"`          // 分类描述
+	ParentID           string `json:"parent_id"`            // 父分类 ID
+	Sort               int64  `json:"sort"`                 // 排序权重
+	IsActive           bool   `json:"is_active"`            // 是否启用
+	Locale             string `json:"locale"`               // 语言
+	TranslationGroupID string `json:"translation_group_id"` // 翻译组 ID
+	Message            string `json:"message"`              // 创建结果消息
 }
 
 // GetCategoryResponse 获取分类响应
 type GetCategoryResponse struct {
-	ID          string `json:"id"`          // 分类 ID
-	Name        string `json:"name"`        // 分类名称
-	Description string `json:"description"` // 分类描述
-	ParentID    string `json:"parent_id"`   // 父分类 ID
-	Sort        int64  `json:"sort"`        // 排序权重
-	IsActive    bool   `json:"is_active"`   // 是否启用
-	CreatedAt   string `json:"created_at"`  // 创建时间
-	UpdatedAt   string `json:"updated_at"`  // 更新时间
+	ID                 string `json:"id"`                   // 分类 ID
+	Name               string `json:"name"`                 // 分类名称
+	Slug               string `json:"slug"`                 // 分类 slug
+	Description        string `json:"description"`          // 分类描述
+	ParentID           string `json:"parent_id"`            // 父分类 ID
+	Sort               int64  `json:"sort"`                 // 排序权重
+	IsActive           bool   `json:"is_active"`            // 是否启用
+	Locale             string `json:"locale"`               // 语言
+	TranslationGroupID string `json:"translation_group_id"` // 翻译组 ID
+	CreatedAt          string `json:"created_at"`           // 创建时间
+	UpdatedAt          string `json:"updated_at"`           // 更新时间
 }
 
 // UpdateCategoryResponse 更新分类响应
 type UpdateCategoryResponse struct {
-	ID          string `json:"id"`          // 分类 ID
-	Name        string `json:"name"`        // 分类名称
-	Description string `json:"description"` // 分类描述
-	ParentID    string `json:"parent_id"`   // 父分类 ID
-	Sort        int64  `json:"sort"`        // 排序权重
-	IsActive    bool   `json:"is_active"`   // 是否启用
-	Message     string `json:"message"`     // 更新结果消息
+	ID                 string `json:"id"`                   // 分类 ID
+	Name               string `json:"name"`                 // 分类名称
+	Slug               string `json:"slug"`                 // 分类 slug
+	Description        string `json:"description"`          // 分类描述
+	ParentID           string `json:"parent_id"`            // 父分类 ID
+	Sort               int64  `json:"sort"`                 // 排序权重
+	IsActive           bool   `json:"is_active"`            // 是否启用
+	Locale             string `json:"locale"`               // 语言
+	TranslationGroupID string `json:"translation_group_id"` // 翻译组 ID
+	Message            string `json:"message"`              // 更新结果消息
 }
 
 // DeleteCategoryResponse 删除分类响应
@@ -44,20 +53,58 @@ type DeleteCategoryResponse struct {
 
 // CategoryItem 分类列表项
 type CategoryItem struct {
-	ID          string `json:"id"`          // 分类 ID
-	Name        string `json:"name"`        // 分类名称
-	Description string `json:"description"` // 分类描述
-	ParentID    string `json:"parent_id"`   // 父分类 ID
-	Sort        int64  `json:"sort"`        // 排序权重
-	IsActive    bool   `json:"is_active"`   // 是否启用
-	CreatedAt   string `json:"created_at"`  // 创建时间
-	UpdatedAt   string `json:"updated_at"`  // 更新时间
+	ID                 string `json:"id"`                   // 分类 ID
+	Name               string `json:"name"`                 // 分类名称
+	Slug               string `json:"slug"`                 // 分类 slug
+	Description        string `json:"description"`          // 分类描述
+	ParentID           string `json:"parent_id"`            // 父分类 ID
+	Sort               int64  `json:"sort"`                 // 排序权重
+	IsActive           bool   `json:"is_active"`            // 是否启用
+	Locale             string `json:"locale"`               // 语言
+	TranslationGroupID string `json:"translation_group_id"` // 翻译组 ID
+	CreatedAt          string `json:"created_at"`           // 创建时间
+	UpdatedAt          string `json:"updated_at"`           // 更新时间
 }
 
 // ListCategoriesResponse 分类列表响应
 type ListCategoriesResponse struct {
-	Total    int64           `json:"total"`     // 总数量
-	PageNo   int64           `json:"page_no"`   // 当前页码
-	PageSize int64           `json:"page_size"` // 每页数量
-	List     []*CategoryItem `json:"list"`      // 分类列表
+	Total      int64           `json:"total"`                 // 总数量，游标分页未要求统计总数时为 -1
+	PageNo     int64           `json:"page_no"`               // 当前页码，游标分页时为 0
+	PageSize   int64           `json:"page_size"`             // 每页数量
+	List       []*CategoryItem `json:"list"`                  // 分类列表
+	NextCursor string          `json:"next_cursor,omitempty"` // 下一页游标，仅游标分页返回，没有更多数据时为空
+	PrevCursor string          `json:"prev_cursor,omitempty"` // 上一页游标，仅游标分页返回，已是第一页时为空
+}
+
+// CategoryTreeNode 分类树节点
+type CategoryTreeNode struct {
+	ID             string              `json:"id"`               // 分类 ID
+	Name           string              `json:"name"`             // 分类名称
+	Slug           string              `json:"slug"`             // 分类 slug
+	Description    string              `json:"description"`      // 分类描述
+	ParentID       string              `json:"parent_id"`        // 父分类 ID
+	Sort           int64               `json:"sort"`             // 排序权重
+	IsActive       bool                `json:"is_active"`        // 是否启用
+	PostCount      int64               `json:"post_count"`       // 直接归属该分类的已发布文章数量
+	TotalPostCount int64               `json:"total_post_count"` // 含子孙分类的已发布文章数量（仅统计树中展示的分类）
+	Children       []*CategoryTreeNode `json:"children"`         // 子分类，按排序权重倒序
+}
+
+// GetCategoryTreeResponse 分类树响应
+type GetCategoryTreeResponse struct {
+	List []*CategoryTreeNode `json:"list"` // 顶级分类节点，按排序权重倒序
+}
+
+// MoveCategoryResponse 移动分类子树响应
+type MoveCategoryResponse struct {
+	ID       string `json:"id"`        // 分类 ID
+	ParentID string `json:"parent_id"` // 新父分类 ID，0 表示顶级分类
+	Message  string `json:"message"`   // 移动结果消息
+}
+
+// MergeCategoryResponse 合并分类响应
+type MergeCategoryResponse struct {
+	SourceID string `json:"source_id"` // 已删除的源分类 ID
+	TargetID string `json:"target_id"` // 目标分类 ID
+	Message  string `json:"message"`   // 合并结果消息
 }
@@ -2,3 +2,45 @@
 // 创建者：Done-0
 // 创建时间：2025-08-05
 package vo
+
+// CreateCommentResponse 创建评论响应
+type CreateCommentResponse struct {
+	ID      string `json:"id"`      // 评论 ID
+	Status  string `json:"status"`  // 评论状态
+	Message string `json:"message"` // 创建结果消息
+}
+
+// ModerateCommentResponse 审核评论响应
+type ModerateCommentResponse struct {
+	ID      string `json:"id"`      // 评论 ID
+	Status  string `json:"status"`  // 审核后的评论状态
+	Message string `json:"message"` // 审核结果消息
+}
+
+// DeleteCommentResponse 删除评论响应
+type DeleteCommentResponse struct {
+	Message string `json:"message"` // 删除结果消息
+}
+
+// CommentItem 评论列表项
+type CommentItem struct {
+	ID           string `json:"id"`                     // 评论 ID
+	PostID       string `json:"post_id"`                // 文章 ID
+	ParentID     string `json:"parent_id"`              // 父评论 ID，"0" 表示顶级评论
+	UserID       string `json:"user_id"`                // 评论用户 ID，匿名评论为空
+	AuthorName   string `json:"author_name"`            // 评论者昵称
+	AuthorAvatar string `json:"author_avatar"`          // 评论者头像
+	AuthorEmail  string `json:"author_email,omitempty"` // 评论者邮箱（仅审核列表返回）
+	IP           string `json:"ip,omitempty"`           // 评论者 IP（仅审核列表返回）
+	Content      string `json:"content"`                // 评论内容
+	Status       string `json:"status"`                 // 评论状态
+	CreatedAt    string `json:"created_at"`             // 创建时间
+}
+
+// ListCommentsResponse 评论列表响应
+type ListCommentsResponse struct {
+	Total    int64          `json:"total"`     // 总数量
+	PageNo   int64          `json:"page_no"`   // 当前页码
+	PageSize int64          `json:"page_size"` // 每页数量
+	List     []*CommentItem `json:"list"`      // 评论列表
+}
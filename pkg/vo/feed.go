@@ -0,0 +1,128 @@
+// Package vo 订阅源相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+import "encoding/xml"
+
+// RSSFeed RSS 2.0 订阅源
+type RSSFeed struct {
+	XMLName   xml.Name    `xml:"rss"`
+	Version   string      `xml:"version,attr"`       // RSS 版本，固定为 2.0
+	AtomNS    string      `xml:"xmlns:atom,attr"`    // Atom 命名空间，用于 atom:link 自引用
+	ContentNS string      `xml:"xmlns:content,attr"` // Content 命名空间，用于 content:encoded 全文
+	DCNS      string      `xml:"xmlns:dc,attr"`      // Dublin Core 命名空间，用于 dc:creator 作者
+	Channel   *RSSChannel `xml:"channel"`            // 频道
+}
+
+// RSSChannel RSS 频道
+type RSSChannel struct {
+	Title         string     `xml:"title"`                   // 频道标题
+	Link          string     `xml:"link"`                    // 站点链接
+	Description   string     `xml:"description"`             // 频道描述
+	Language      string     `xml:"language,omitempty"`      // 语言
+	LastBuildDate string     `xml:"lastBuildDate,omitempty"` // 最后更新时间（RFC1123Z）
+	AtomLink      *AtomLink  `xml:"atom:link"`               // 订阅源自引用链接
+	Items         []*RSSItem `xml:"item"`                    // 条目列表
+}
+
+// RSSItem RSS 条目
+type RSSItem struct {
+	Title       string   `xml:"title"`                // 文章标题
+	Link        string   `xml:"link"`                 // 文章链接
+	GUID        *RSSGUID `xml:"guid"`                 // 全局唯一标识
+	Description string   `xml:"description"`          // 文章摘要
+	Content     *CDATA   `xml:"content:encoded"`      // 渲染后的 HTML 内容
+	Creator     string   `xml:"dc:creator,omitempty"` // 作者
+	Category    string   `xml:"category,omitempty"`   // 分类
+	PubDate     string   `xml:"pubDate"`              // 发布时间（RFC1123Z）
+}
+
+// RSSGUID RSS 条目唯一标识
+type RSSGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"` // 是否为永久链接
+	Value       string `xml:",chardata"`        // 标识值
+}
+
+// CDATA 以 CDATA 形式输出的 XML 文本
+type CDATA struct {
+	Value string `xml:",cdata"`
+}
+
+// AtomFeed Atom 订阅源
+type AtomFeed struct {
+	XMLName  xml.Name     `xml:"http://www.w3.org/2005/Atom feed"`
+	Title    string       `xml:"title"`              // 订阅源标题
+	Subtitle string       `xml:"subtitle,omitempty"` // 副标题
+	ID       string       `xml:"id"`                 // 订阅源唯一标识
+	Updated  string       `xml:"updated"`            // 最后更新时间（RFC3339）
+	Links    []*AtomLink  `xml:"link"`               // 链接
+	Entries  []*AtomEntry `xml:"entry"`              // 条目列表
+}
+
+// AtomLink Atom 链接
+type AtomLink struct {
+	Href string `xml:"href,attr"`           // 链接地址
+	Rel  string `xml:"rel,attr,omitempty"`  // 链接关系
+	Type string `xml:"type,attr,omitempty"` // 链接 MIME 类型
+}
+
+// AtomEntry Atom 条目
+type AtomEntry struct {
+	Title     string        `xml:"title"`              // 文章标题
+	ID        string        `xml:"id"`                 // 条目唯一标识
+	Links     []*AtomLink   `xml:"link"`               // 链接
+	Published string        `xml:"published"`          // 发布时间（RFC3339）
+	Updated   string        `xml:"updated"`            // 更新时间（RFC3339）
+	Author    *AtomAuthor   `xml:"author,omitempty"`   // 作者
+	Category  *AtomCategory `xml:"category,omitempty"` // 分类
+	Summary   string        `xml:"summary,omitempty"`  // 摘要
+	Content   *AtomContent  `xml:"content"`            // 渲染后的 HTML 内容
+}
+
+// AtomAuthor Atom 作者
+type AtomAuthor struct {
+	Name string `xml:"name"` // 作者名称
+}
+
+// AtomCategory Atom 分类
+type AtomCategory struct {
+	Term string `xml:"term,attr"` // 分类名称
+}
+
+// AtomContent Atom 内容
+type AtomContent struct {
+	Type  string `xml:"type,attr"` // 内容类型，固定为 html
+	Value string `xml:",chardata"` // 内容
+}
+
+// JSONFeed JSON Feed 1.1 订阅源
+type JSONFeed struct {
+	Version     string          `json:"version"`               // 规范版本地址
+	Title       string          `json:"title"`                 // 订阅源标题
+	HomePageURL string          `json:"home_page_url"`         // 站点链接
+	FeedURL     string          `json:"feed_url"`              // 订阅源地址
+	Description string          `json:"description,omitempty"` // 订阅源描述
+	Language    string          `json:"language,omitempty"`    // 语言
+	Items       []*JSONFeedItem `json:"items"`                 // 条目列表
+}
+
+// JSONFeedItem JSON Feed 条目
+type JSONFeedItem struct {
+	ID            string            `json:"id"`                // 条目唯一标识
+	URL           string            `json:"url"`               // 文章链接
+	Title         string            `json:"title"`             // 文章标题
+	ContentHTML   string            `json:"content_html"`      // 渲染后的 HTML 内容
+	Summary       string            `json:"summary,omitempty"` // 摘要
+	Image         string            `json:"image,omitempty"`   // 封面图片
+	DatePublished string            `json:"date_published"`    // 发布时间（RFC3339）
+	DateModified  string            `json:"date_modified"`     // 更新时间（RFC3339）
+	Authors       []*JSONFeedAuthor `json:"authors,omitempty"` // 作者
+	Tags          []string          `json:"tags,omitempty"`    // 分类与标签
+}
+
+// JSONFeedAuthor JSON Feed 作者
+type JSONFeedAuthor struct {
+	Name   string `json:"name"`             // 作者名称
+	Avatar string `json:"avatar,omitempty"` // 作者头像
+}
@@ -0,0 +1,36 @@
+// Package vo 提供媒体文件相关的值对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// MediaItem 媒体文件列表项
+type MediaItem struct {
+	ID         string `json:"id"`          // 媒体文件 ID
+	FileName   string `json:"file_name"`   // 原始文件名
+	MimeType   string `json:"mime_type"`   // MIME 类型
+	Size       int64  `json:"size"`        // 文件大小（字节）
+	Hash       string `json:"hash"`        // 文件内容 SHA-256
+	URL        string `json:"url"`         // 文件访问地址，可直接用于文章封面与用户头像
+	UploaderID string `json:"uploader_id"` // 上传者用户 ID
+	CreatedAt  string `json:"created_at"`  // 上传时间
+}
+
+// UploadMediaResponse 上传媒体文件响应
+type UploadMediaResponse struct {
+	*MediaItem
+	Deduplicated bool   `json:"deduplicated"` // 是否命中已有的相同内容文件（未重复存储）
+	Message      string `json:"message"`      // 上传结果消息
+}
+
+// ListMediaResponse 媒体文件列表响应
+type ListMediaResponse struct {
+	Total    int64        `json:"total"`     // 总数量
+	PageNo   int64        `json:"page_no"`   // 当前页码
+	PageSize int64        `json:"page_size"` // 每页数量
+	List     []*MediaItem `json:"list"`      // 媒体文件列表
+}
+
+// DeleteMediaResponse 删除媒体文件响应
+type DeleteMediaResponse struct {
+	Message string `json:"message"` // 删除结果消息
+}
@@ -5,43 +5,93 @@ package vo
 
 // CreatePostResponse 创建文章响应
 type CreatePostResponse struct {
-	ID           string `json:"id"`            // 文章 ID
-	Title        string `json:"title"`         // 文章标题
-	Description  string `json:"description"`   // 文章描述/摘要
-	Image        string `json:"image"`         // 文章封面图片
-	Status       string `json:"status"`        // 文章状态
-	CategoryID   string `json:"category_id"`   // 分类 ID
-	CategoryName string `json:"category_name"` // 分类名称
-	Markdown     string `json:"markdown"`      // Markdown内容
-	Message      string `json:"message"`       // 创建结果消息
+	ID                 string   `json:"id"`                   // 文章 ID
+	Title              string   `json:"title"`                // 文章标题
+	Slug               string   `json:"slug"`                 // 文章 slug
+	Description        string   `json:"description"`          // 文章描述/摘要
+	Image              string   `json:"image"`                // 文章封面图片
+	Status             string   `json:"status"`               // 文章状态
+	PublishAt          string   `json:"publish_at"`           // 定时发布时间，未设置时为空
+	Visibility         string   `json:"visibility"`           // 访问方式
+	CategoryID         string   `json:"category_id"`          // 分类 ID
+	CategoryName       string   `json:"category_name"`        // 分类名称
+	TagIDs             []string `json:"tag_ids"`              // 标签 ID 列表
+	TagNames           []string `json:"tag_names"`            // 标签名称列表
+	Markdown           string   `json:"markdown"`             // Markdown内容
+	Version            int64    `json:"version"`              // 文章版本号，更新时通过 version 或 If-Match 传回
+	Locale             string   `json:"locale"`               // 语言
+	TranslationGroupID string   `json:"translation_group_id"` // 翻译组 ID
+	Message            string   `json:"message"`              // 创建结果消息
 }
 
 // GetPostResponse 获取文章响应
 type GetPostResponse struct {
-	ID           string `json:"id"`            // 文章 ID
-	Title        string `json:"title"`         // 文章标题
-	Description  string `json:"description"`   // 文章描述/摘要
-	Image        string `json:"image"`         // 文章封面图片
-	Status       string `json:"status"`        // 文章状态
-	CategoryID   string `json:"category_id"`   // 分类 ID
-	CategoryName string `json:"category_name"` // 分类名称
-	Markdown     string `json:"markdown"`      // Markdown 内容
-	HTML         string `json:"html"`          // 渲染后的 HTML
-	CreatedAt    string `json:"created_at"`    // 创建时间
-	UpdatedAt    string `json:"updated_at"`    // 更新时间
+	ID                 string             `json:"id"`                   // 文章 ID
+	Title              string             `json:"title"`                // 文章标题
+	Slug               string             `json:"slug"`                 // 文章 slug
+	Description        string             `json:"description"`          // 文章描述/摘要
+	Image              string             `json:"image"`                // 文章封面图片
+	Status             string             `json:"status"`               // 文章状态
+	PublishAt          string             `json:"publish_at"`           // 定时发布时间，未设置时为空
+	CategoryID         string             `json:"category_id"`          // 分类 ID
+	CategoryName       string             `json:"category_name"`        // 分类名称
+	TagIDs             []string           `json:"tag_ids"`              // 标签 ID 列表
+	TagNames           []string           `json:"tag_names"`            // 标签名称列表
+	AuthorID           string             `json:"author_id"`            // 作者用户 ID
+	AuthorNickname     string             `json:"author_nickname"`      // 作者昵称
+	AuthorAvatar       string             `json:"author_avatar"`        // 作者头像
+	Pinned             bool               `json:"pinned"`               // 是否置顶（已过期的置顶视为未置顶）
+	PinnedUntil        string             `json:"pinned_until"`         // 置顶到期时间，永久置顶或未置顶时为空
+	Featured           bool               `json:"featured"`             // 是否精选
+	Visibility         string             `json:"visibility"`           // 访问方式：public、password、unlisted
+	Locked             bool               `json:"locked"`               // 是否为未解锁的密码保护文章，为 true 时不返回摘要、正文与目录
+	Markdown           string             `json:"markdown"`             // Markdown 内容
+	HTML               string             `json:"html"`                 // 渲染后的 HTML
+	TOC                []*TOCItem         `json:"toc"`                  // 文章目录
+	Series             *PostSeriesNav     `json:"series"`               // 所属系列导航，未加入系列时为空
+	Reactions          []*ReactionCount   `json:"reactions"`            // 各类表态数量，按配置的表态类型顺序
+	Version            int64              `json:"version"`              // 文章版本号，更新时通过 version 或 If-Match 传回以检测编辑冲突
+	Locale             string             `json:"locale"`               // 语言
+	TranslationGroupID string             `json:"translation_group_id"` // 翻译组 ID
+	Translations       []*PostTranslation `json:"translations"`         // 其他语言的已发布版本，按语言排序，可用于语言切换与 hreflang
+	CreatedAt          string             `json:"created_at"`           // 创建时间
+	UpdatedAt          string             `json:"updated_at"`           // 更新时间
+}
+
+// PostTranslation 文章的其他语言版本
+type PostTranslation struct {
+	ID     string `json:"id"`     // 文章 ID
+	Locale string `json:"locale"` // 语言
+	Title  string `json:"title"`  // 文章标题
+	Slug   string `json:"slug"`   // 文章 slug
+}
+
+// TOCItem 文章目录项
+type TOCItem struct {
+	Level int    `json:"level"` // 标题级别，1-6
+	ID    string `json:"id"`    // 标题锚点 ID
+	Title string `json:"title"` // 标题文本
 }
 
 // UpdatePostResponse 更新文章响应
 type UpdatePostResponse struct {
-	ID           string `json:"id"`            // 文章 ID
-	Title        string `json:"title"`         // 文章标题
-	Description  string `json:"description"`   // 文章描述/摘要
-	Image        string `json:"image"`         // 文章封面图片
-	Status       string `json:"status"`        // 文章状态
-	CategoryID   string `json:"category_id"`   // 分类 ID
-	CategoryName string `json:"category_name"` // 分类名称
-	Markdown     string `json:"markdown"`      // Markdown内容
-	Message      string `json:"message"`       // 更新结果消息
+	ID                 string   `json:"id"`                   // 文章 ID
+	Title              string   `json:"title"`                // 文章标题
+	Slug               string   `json:"slug"`                 // 文章 slug
+	Description        string   `json:"description"`          // 文章描述/摘要
+	Image              string   `json:"image"`                // 文章封面图片
+	Status             string   `json:"status"`               // 文章状态
+	PublishAt          string   `json:"publish_at"`           // 定时发布时间，未设置时为空
+	Visibility         string   `json:"visibility"`           // 访问方式
+	CategoryID         string   `json:"category_id"`          // 分类 ID
+	CategoryName       string   `json:"category_name"`        // 分类名称
+	TagIDs             []string `json:"tag_ids"`              // 标签 ID 列表
+	TagNames           []string `json:"tag_names"`            // 标签名称列表
+	Markdown           string   `json:"markdown"`             // Markdown内容
+	Version            int64    `json:"version"`              // 更新后的文章版本号
+	Locale             string   `json:"locale"`               // 语言
+	TranslationGroupID string   `json:"translation_group_id"` // 翻译组 ID
+	Message            string   `json:"message"`              // 更新结果消息
 }
 
 // DeletePostResponse 删除文章响应
@@ -51,21 +101,71 @@ type DeletePostResponse struct {
 
 // PostItem 文章列表项
 type PostItem struct {
-	ID           string `json:"id"`            // 文章 ID
-	Title        string `json:"title"`         // 文章标题
-	Description  string `json:"description"`   // 文章描述/摘要
-	Image        string `json:"image"`         // 文章封面图片
-	Status       string `json:"status"`        // 文章状态
-	CategoryID   string `json:"category_id"`   // 分类 ID
-	CategoryName string `json:"category_name"` // 分类名称
-	CreatedAt    string `json:"created_at"`    // 创建时间
-	UpdatedAt    string `json:"updated_at"`    // 更新时间
+	ID                 string   `json:"id"`                   // 文章 ID
+	Title              string   `json:"title"`                // 文章标题
+	Slug               string   `json:"slug"`                 // 文章 slug
+	Description        string   `json:"description"`          // 文章描述/摘要
+	Image              string   `json:"image"`                // 文章封面图片
+	Status             string   `json:"status"`               // 文章状态
+	PublishAt          string   `json:"publish_at"`           // 定时发布时间，未设置时为空
+	CategoryID         string   `json:"category_id"`          // 分类 ID
+	CategoryName       string   `json:"category_name"`        // 分类名称
+	TagIDs             []string `json:"tag_ids"`              // 标签 ID 列表
+	TagNames           []string `json:"tag_names"`            // 标签名称列表
+	AuthorID           string   `json:"author_id"`            // 作者用户 ID
+	AuthorNickname     string   `json:"author_nickname"`      // 作者昵称
+	AuthorAvatar       string   `json:"author_avatar"`        // 作者头像
+	Pinned             bool     `json:"pinned"`               // 是否置顶（已过期的置顶视为未置顶）
+	PinnedUntil        string   `json:"pinned_until"`         // 置顶到期时间，永久置顶或未置顶时为空
+	Featured           bool     `json:"featured"`             // 是否精选
+	Visibility         string   `json:"visibility"`           // 访问方式，密码保护文章在列表中不返回摘要
+	ViewCount          int64    `json:"view_count"`           // 累计浏览量（按日去重访客数之和，定期落库）
+	Locale             string   `json:"locale"`               // 语言
+	TranslationGroupID string   `json:"translation_group_id"` // 翻译组 ID
+	CreatedAt          string   `json:"created_at"`           // 创建时间
+	UpdatedAt          string   `json:"updated_at"`           // 更新时间
 }
 
 // ListPostsResponse 文章列表响应
 type ListPostsResponse struct {
-	Total    int64       `json:"total"`     // 总数量
-	PageNo   int64       `json:"page_no"`   // 当前页码
-	PageSize int64       `json:"page_size"` // 每页数量
-	List     []*PostItem `json:"list"`      // 文章列表
+	Total      int64       `json:"total"`                 // 总数量，游标分页未要求统计总数时为 -1
+	PageNo     int64       `json:"page_no"`               // 当前页码，游标分页时为 0
+	PageSize   int64       `json:"page_size"`             // 每页数量
+	List       []*PostItem `json:"list"`                  // 文章列表
+	NextCursor string      `json:"next_cursor,omitempty"` // 下一页游标，仅游标分页返回，没有更多数据时为空
+	PrevCursor string      `json:"prev_cursor,omitempty"` // 上一页游标，仅游标分页返回，已是第一页时为空
+}
+
+// SearchPostItem 文章检索结果项
+type SearchPostItem struct {
+	*PostItem
+	TitleHighlight string `json:"title_highlight"` // 高亮后的标题（HTML，命中词以 <mark> 包裹）
+	Snippet        string `json:"snippet"`         // 高亮后的正文摘要片段（HTML）
+}
+
+// SearchPostsResponse 文章检索响应
+type SearchPostsResponse struct {
+	Total    int64             `json:"total"`     // 总数量
+	PageNo   int64             `json:"page_no"`   // 当前页码
+	PageSize int64             `json:"page_size"` // 每页数量
+	List     []*SearchPostItem `json:"list"`      // 检索结果列表，按相关度排序
+}
+
+// RelatedPostItem 相关文章项
+type RelatedPostItem struct {
+	*PostItem
+	Score float64 `json:"score"` // 相似度得分，0-1
+}
+
+// ListRelatedPostsResponse 相关文章响应
+type ListRelatedPostsResponse struct {
+	PostID string             `json:"post_id"` // 文章 ID
+	List   []*RelatedPostItem `json:"list"`    // 相关文章列表，按相似度倒序
+}
+
+// UnlockPostResponse 解锁密码保护文章响应
+type UnlockPostResponse struct {
+	ID        string `json:"id"`         // 文章 ID
+	ExpiresAt string `json:"expires_at"` // 解锁凭证过期时间
+	Message   string `json:"message"`    // 解锁结果消息
 }
@@ -0,0 +1,66 @@
+// Package vo 文章自动保存与编辑冲突相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// PostServerCopy 服务端当前的文章副本，编辑冲突时返回供客户端合并
+type PostServerCopy struct {
+	ID          string   `json:"id"`          // 文章 ID
+	Version     int64    `json:"version"`     // 当前版本号
+	Title       string   `json:"title"`       // 文章标题
+	Slug        string   `json:"slug"`        // 文章 slug
+	Description string   `json:"description"` // 文章描述/摘要
+	Image       string   `json:"image"`       // 文章封面图片
+	Status      string   `json:"status"`      // 文章状态
+	PublishAt   string   `json:"publish_at"`  // 定时发布时间，未设置时为空
+	Visibility  string   `json:"visibility"`  // 访问方式
+	CategoryID  string   `json:"category_id"` // 分类 ID，未分类时为空
+	TagIDs      []string `json:"tag_ids"`     // 标签 ID 列表
+	Markdown    string   `json:"markdown"`    // Markdown 内容
+	UpdatedAt   string   `json:"updated_at"`  // 更新时间
+}
+
+// PostVersionConflict 文章编辑冲突详情
+type PostVersionConflict struct {
+	ExpectedVersion int64           `json:"expected_version"` // 编辑所基于的版本号
+	CurrentVersion  int64           `json:"current_version"`  // 服务端当前的版本号
+	Current         *PostServerCopy `json:"current"`          // 服务端当前的文章副本
+}
+
+// PostAutosaveDraft 自动保存的草稿内容
+type PostAutosaveDraft struct {
+	Title       string   `json:"title"`       // 文章标题
+	Description string   `json:"description"` // 文章描述/摘要
+	Image       string   `json:"image"`       // 文章封面图片
+	CategoryID  string   `json:"category_id"` // 分类 ID
+	TagIDs      []string `json:"tag_ids"`     // 标签 ID 列表
+	Markdown    string   `json:"markdown"`    // Markdown 内容
+}
+
+// AutosavePostResponse 自动保存草稿响应
+type AutosavePostResponse struct {
+	PostID         string `json:"post_id"`         // 文章 ID，新文章为空
+	BaseVersion    int64  `json:"base_version"`    // 草稿所基于的文章版本号
+	CurrentVersion int64  `json:"current_version"` // 文章当前版本号，新文章为 0
+	Stale          bool   `json:"stale"`           // 文章是否已在草稿所基于的版本之后被修改，为 true 时直接提交将发生冲突
+	SavedAt        string `json:"saved_at"`        // 保存时间
+	ExpiresAt      string `json:"expires_at"`      // 过期时间
+	Message        string `json:"message"`         // 保存结果消息
+}
+
+// GetPostAutosaveResponse 获取自动保存草稿响应
+type GetPostAutosaveResponse struct {
+	PostID         string             `json:"post_id"`         // 文章 ID，新文章为空
+	Draft          *PostAutosaveDraft `json:"draft"`           // 草稿内容
+	BaseVersion    int64              `json:"base_version"`    // 草稿所基于的文章版本号
+	CurrentVersion int64              `json:"current_version"` // 文章当前版本号，新文章或文章已删除时为 0
+	Stale          bool               `json:"stale"`           // 文章是否已在草稿所基于的版本之后被修改
+	SavedAt        string             `json:"saved_at"`        // 保存时间
+	ExpiresAt      string             `json:"expires_at"`      // 过期时间
+}
+
+// DiscardPostAutosaveResponse 丢弃自动保存草稿响应
+type DiscardPostAutosaveResponse struct {
+	PostID  string `json:"post_id"` // 文章 ID，新文章为空
+	Message string `json:"message"` // 丢弃结果消息
+}
@@ -0,0 +1,22 @@
+// Package vo 文章批量操作相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// BulkPostResult 单篇文章的批量操作结果
+type BulkPostResult struct {
+	ID      string `json:"id"`              // 文章 ID
+	Success bool   `json:"success"`         // 是否成功（试运行时表示校验通过）
+	Error   string `json:"error,omitempty"` // 失败原因
+}
+
+// BulkPostsResponse 批量操作文章响应
+type BulkPostsResponse struct {
+	Operation string            `json:"operation"` // 操作类型
+	DryRun    bool              `json:"dry_run"`   // 是否为试运行
+	Committed bool              `json:"committed"` // 修改是否已提交，试运行或整体回滚时为 false
+	Succeeded int               `json:"succeeded"` // 成功数量
+	Failed    int               `json:"failed"`    // 失败数量
+	Results   []*BulkPostResult `json:"results"`   // 每篇文章的结果，顺序与请求一致
+	Message   string            `json:"message"`   // 操作结果消息
+}
@@ -0,0 +1,28 @@
+// Package vo 文章置顶与精选相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// PinPostResponse 设置文章置顶响应
+type PinPostResponse struct {
+	ID          string `json:"id"`           // 文章 ID
+	Pinned      bool   `json:"pinned"`       // 是否置顶
+	PinnedUntil string `json:"pinned_until"` // 置顶到期时间，永久置顶时为空
+	Weight      int64  `json:"weight"`       // 置顶排序权重
+	Message     string `json:"message"`      // 设置结果消息
+}
+
+// FeaturePostResponse 设置文章精选响应
+type FeaturePostResponse struct {
+	ID       string `json:"id"`       // 文章 ID
+	Featured bool   `json:"featured"` // 是否精选
+	Weight   int64  `json:"weight"`   // 精选排序权重
+	Message  string `json:"message"`  // 设置结果消息
+}
+
+// ReorderPostsResponse 批量调整置顶或精选文章顺序响应
+type ReorderPostsResponse struct {
+	Type    string   `json:"type"`     // 排序列表类型
+	PostIDs []string `json:"post_ids"` // 调整后的文章 ID 列表，按顺序排列
+	Message string   `json:"message"`  // 调整结果消息
+}
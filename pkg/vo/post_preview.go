@@ -0,0 +1,33 @@
+// Package vo 文章预览链接相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// PostPreviewItem 文章预览链接项
+type PostPreviewItem struct {
+	TokenID   string `json:"token_id"`   // 预览令牌 ID，用于撤销
+	Token     string `json:"token"`      // 预览令牌，拼接到 /post/preview?token= 即为预览链接
+	CreatedBy string `json:"created_by"` // 创建者用户 ID
+	CreatedAt string `json:"created_at"` // 创建时间
+	ExpiresAt string `json:"expires_at"` // 过期时间
+}
+
+// CreatePostPreviewResponse 创建文章预览链接响应
+type CreatePostPreviewResponse struct {
+	PostID  string           `json:"post_id"` // 文章 ID
+	Preview *PostPreviewItem `json:"preview"` // 新建的预览链接
+	Message string           `json:"message"` // 创建结果消息
+}
+
+// ListPostPreviewsResponse 文章预览链接列表响应
+type ListPostPreviewsResponse struct {
+	PostID string             `json:"post_id"` // 文章 ID
+	List   []*PostPreviewItem `json:"list"`    // 未过期的预览链接，按过期时间升序
+}
+
+// RevokePostPreviewResponse 撤销文章预览链接响应
+type RevokePostPreviewResponse struct {
+	PostID  string `json:"post_id"`  // 文章 ID
+	TokenID string `json:"token_id"` // 预览令牌 ID
+	Message string `json:"message"`  // 撤销结果消息
+}
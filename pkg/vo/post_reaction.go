@@ -0,0 +1,18 @@
+// Package vo 提供文章互动表态相关的值对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// ReactionCount 表态数量
+type ReactionCount struct {
+	Type  string `json:"type"`  // 表态类型
+	Count int64  `json:"count"` // 表态数量
+}
+
+// ReactPostResponse 文章表态响应
+type ReactPostResponse struct {
+	PostID    string           `json:"post_id"`   // 文章 ID
+	Type      string           `json:"type"`      // 表态类型
+	Changed   bool             `json:"changed"`   // 本次请求是否改变了表态状态，重复表态或重复取消时为 false
+	Reactions []*ReactionCount `json:"reactions"` // 文章各类表态数量
+}
@@ -0,0 +1,39 @@
+// Package vo 提供文章修订历史相关的值对象定义
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// PostRevisionItem 文章修订列表项
+type PostRevisionItem struct {
+	ID             string `json:"id"`              // 修订 ID
+	PostID         string `json:"post_id"`         // 文章 ID
+	Title          string `json:"title"`           // 标题快照
+	EditorID       string `json:"editor_id"`       // 编辑者用户 ID
+	EditorNickname string `json:"editor_nickname"` // 编辑者昵称
+	CreatedAt      string `json:"created_at"`      // 修订时间
+}
+
+// ListPostRevisionsResponse 文章修订列表响应
+type ListPostRevisionsResponse struct {
+	Total    int64               `json:"total"`     // 总数量
+	PageNo   int64               `json:"page_no"`   // 当前页码
+	PageSize int64               `json:"page_size"` // 每页数量
+	List     []*PostRevisionItem `json:"list"`      // 修订列表，按时间倒序
+}
+
+// DiffPostRevisionsResponse 对比文章修订响应
+type DiffPostRevisionsResponse struct {
+	From      *PostRevisionItem `json:"from"`       // 旧修订
+	To        *PostRevisionItem `json:"to"`         // 新修订
+	TitleDiff string            `json:"title_diff"` // 标题统一格式差异，标题未变化时为空
+	Diff      string            `json:"diff"`       // Markdown 内容统一格式差异（unified diff），内容未变化时为空
+}
+
+// RestorePostRevisionResponse 恢复文章修订响应
+type RestorePostRevisionResponse struct {
+	ID         string `json:"id"`          // 文章 ID
+	RevisionID string `json:"revision_id"` // 恢复后新生成的修订 ID
+	Title      string `json:"title"`       // 恢复后的标题
+	Markdown   string `json:"markdown"`    // 恢复后的 Markdown 内容
+	Message    string `json:"message"`     // 恢复结果消息
+}
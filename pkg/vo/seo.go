@@ -0,0 +1,31 @@
+// Package vo 搜索引擎优化相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+import "encoding/xml"
+
+// SitemapURLSet 站点地图
+type SitemapURLSet struct {
+	XMLName xml.Name      `xml:"urlset"`
+	XMLNS   string        `xml:"xmlns,attr"` // 站点地图命名空间
+	URLs    []*SitemapURL `xml:"url"`        // URL 列表
+}
+
+// SitemapURL 站点地图 URL 条目
+type SitemapURL struct {
+	Loc     string `xml:"loc"`               // 页面地址
+	LastMod string `xml:"lastmod,omitempty"` // 最后修改时间（W3C Datetime）
+}
+
+// SitemapIndex 站点地图索引
+type SitemapIndex struct {
+	XMLName  xml.Name      `xml:"sitemapindex"`
+	XMLNS    string        `xml:"xmlns,attr"` // 站点地图命名空间
+	Sitemaps []*SitemapRef `xml:"sitemap"`    // 子站点地图列表
+}
+
+// SitemapRef 站点地图索引条目
+type SitemapRef struct {
+	Loc string `xml:"loc"` // 子站点地图地址
+}
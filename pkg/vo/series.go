@@ -0,0 +1,103 @@
+// Package vo 文章系列相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// CreateSeriesResponse 创建系列响应
+type CreateSeriesResponse struct {
+	ID          string   `json:"id"`          // 系列 ID
+	Title       string   `json:"title"`       // 系列标题
+	Slug        string   `json:"slug"`        // 系列 slug
+	Description string   `json:"description"` // 系列描述
+	Image       string   `json:"image"`       // 系列封面图片
+	PostIDs     []string `json:"post_ids"`    // 系列文章 ID 列表，按阅读顺序排列
+	Message     string   `json:"message"`     // 创建结果消息
+}
+
+// UpdateSeriesResponse 更新系列响应
+type UpdateSeriesResponse struct {
+	ID          string `json:"id"`          // 系列 ID
+	Title       string `json:"title"`       // 系列标题
+	Slug        string `json:"slug"`        // 系列 slug
+	Description string `json:"description"` // 系列描述
+	Image       string `json:"image"`       // 系列封面图片
+	Message     string `json:"message"`     // 更新结果消息
+}
+
+// DeleteSeriesResponse 删除系列响应
+type DeleteSeriesResponse struct {
+	Message string `json:"message"` // 删除结果消息
+}
+
+// ReorderSeriesResponse 设置系列文章及顺序响应
+type ReorderSeriesResponse struct {
+	ID      string   `json:"id"`       // 系列 ID
+	PostIDs []string `json:"post_ids"` // 系列文章 ID 列表，按阅读顺序排列
+	Message string   `json:"message"`  // 设置结果消息
+}
+
+// SeriesPostItem 系列文章项
+type SeriesPostItem struct {
+	ID          string `json:"id"`          // 文章 ID
+	Title       string `json:"title"`       // 文章标题
+	Slug        string `json:"slug"`        // 文章 slug
+	Description string `json:"description"` // 文章描述/摘要
+	Status      string `json:"status"`      // 文章状态
+	Position    int64  `json:"position"`    // 在系列中的序号，从 1 开始
+	CreatedAt   string `json:"created_at"`  // 创建时间
+}
+
+// GetSeriesResponse 获取系列响应
+type GetSeriesResponse struct {
+	ID             string            `json:"id"`              // 系列 ID
+	Title          string            `json:"title"`           // 系列标题
+	Slug           string            `json:"slug"`            // 系列 slug
+	Description    string            `json:"description"`     // 系列描述
+	Image          string            `json:"image"`           // 系列封面图片
+	AuthorID       string            `json:"author_id"`       // 创建者用户 ID
+	AuthorNickname string            `json:"author_nickname"` // 创建者昵称
+	AuthorAvatar   string            `json:"author_avatar"`   // 创建者头像
+	Posts          []*SeriesPostItem `json:"posts"`           // 系列文章，按阅读顺序排列；仅系列创建者与拥有系列越权管理权限的用户可见未发布文章
+	CreatedAt      string            `json:"created_at"`      // 创建时间
+	UpdatedAt      string            `json:"updated_at"`      // 更新时间
+}
+
+// SeriesItem 系列列表项
+type SeriesItem struct {
+	ID          string `json:"id"`          // 系列 ID
+	Title       string `json:"title"`       // 系列标题
+	Slug        string `json:"slug"`        // 系列 slug
+	Description string `json:"description"` // 系列描述
+	Image       string `json:"image"`       // 系列封面图片
+	PostCount   int64  `json:"post_count"`  // 已发布文章数量
+	CreatedAt   string `json:"created_at"`  // 创建时间
+	UpdatedAt   string `json:"updated_at"`  // 更新时间
+}
+
+// ListSeriesResponse 系列列表响应
+type ListSeriesResponse struct {
+	Total    int64         `json:"total"`     // 总数量
+	PageNo   int64         `json:"page_no"`   // 当前页码
+	PageSize int64         `json:"page_size"` // 每页数量
+	List     []*SeriesItem `json:"list"`      // 系列列表
+}
+
+// PostSeriesLink 系列导航中的文章链接
+type PostSeriesLink struct {
+	ID       string `json:"id"`       // 文章 ID
+	Title    string `json:"title"`    // 文章标题
+	Slug     string `json:"slug"`     // 文章 slug
+	Position int64  `json:"position"` // 在系列中的序号，从 1 开始
+}
+
+// PostSeriesNav 文章所属系列的导航信息
+type PostSeriesNav struct {
+	ID       string            `json:"id"`       // 系列 ID
+	Title    string            `json:"title"`    // 系列标题
+	Slug     string            `json:"slug"`     // 系列 slug
+	Position int64             `json:"position"` // 当前文章在系列中的序号，从 1 开始
+	Total    int64             `json:"total"`    // 系列文章总数
+	Prev     *PostSeriesLink   `json:"prev"`     // 上一篇，当前为第一篇时为空
+	Next     *PostSeriesLink   `json:"next"`     // 下一篇，当前为最后一篇时为空
+	Posts    []*PostSeriesLink `json:"posts"`    // 系列目录，按阅读顺序排列
+}
@@ -0,0 +1,43 @@
+// Package vo 标签相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// CreateTagResponse 创建标签响应
+type CreateTagResponse struct {
+	ID          string `json:"id"`          // 标签 ID
+	Name        string `json:"name"`        // 标签名称
+	Description string `json:"description"` // 标签描述
+	Message     string `json:"message"`     // 创建结果消息
+}
+
+// UpdateTagResponse 更新标签响应
+type UpdateTagResponse struct {
+	ID          string `json:"id"`          // 标签 ID
+	Name        string `json:"name"`        // 标签名称
+	Description string `json:"description"` // 标签描述
+	Message     string `json:"message"`     // 更新结果消息
+}
+
+// DeleteTagResponse 删除标签响应
+type DeleteTagResponse struct {
+	Message string `json:"message"` // 删除结果消息
+}
+
+// TagItem 标签列表项
+type TagItem struct {
+	ID          string `json:"id"`          // 标签 ID
+	Name        string `json:"name"`        // 标签名称
+	Description string `json:"description"` // 标签描述
+	PostCount   int64  `json:"post_count"`  // 已发布文章数量
+	CreatedAt   string `json:"created_at"`  // 创建时间
+	UpdatedAt   string `json:"updated_at"`  // 更新时间
+}
+
+// ListTagsResponse 标签列表响应
+type ListTagsResponse struct {
+	Total    int64      `json:"total"`     // 总数量
+	PageNo   int64      `json:"page_no"`   // 当前页码
+	PageSize int64      `json:"page_size"` // 每页数量
+	List     []*TagItem `json:"list"`      // 标签列表
+}
@@ -0,0 +1,86 @@
+// Package vo 回收站相关值对象
+// 创建者：Done-0
+// 创建时间：2026-10-18
+package vo
+
+// TrashedPostItem 回收站中的文章
+type TrashedPostItem struct {
+	ID        string `json:"id"`         // 文章 ID
+	Title     string `json:"title"`      // 文章标题
+	Slug      string `json:"slug"`       // 删除前的 slug，恢复时已被其他文章占用则追加序号
+	Status    string `json:"status"`     // 删除前的文章状态，恢复后保持不变
+	AuthorID  string `json:"author_id"`  // 作者用户 ID
+	DeletedAt string `json:"deleted_at"` // 删除时间
+	PurgeAt   string `json:"purge_at"`   // 预计永久删除时间，不自动清理时为空
+}
+
+// ListTrashedPostsResponse 回收站文章列表响应
+type ListTrashedPostsResponse struct {
+	Total    int64              `json:"total"`     // 总数量
+	PageNo   int64              `json:"page_no"`   // 当前页码
+	PageSize int64              `json:"page_size"` // 每页数量
+	List     []*TrashedPostItem `json:"list"`      // 文章列表，按删除时间倒序
+}
+
+// RestorePostResponse 从回收站恢复文章响应
+type RestorePostResponse struct {
+	ID      string `json:"id"`      // 文章 ID
+	Slug    string `json:"slug"`    // 恢复后的 slug
+	Message string `json:"message"` // 恢复结果消息
+}
+
+// TrashedCategoryItem 回收站中的分类
+type TrashedCategoryItem struct {
+	ID        string `json:"id"`         // 分类 ID
+	Name      string `json:"name"`       // 分类名称
+	Slug      string `json:"slug"`       // 删除前的 slug，恢复时已被其他分类占用则追加序号
+	ParentID  string `json:"parent_id"`  // 删除前的父分类 ID，恢复时父分类已不存在则恢复为顶级分类
+	DeletedAt string `json:"deleted_at"` // 删除时间
+	PurgeAt   string `json:"purge_at"`   // 预计永久删除时间，不自动清理时为空
+}
+
+// ListTrashedCategoriesResponse 回收站分类列表响应
+type ListTrashedCategoriesResponse struct {
+	Total    int64                  `json:"total"`     // 总数量
+	PageNo   int64                  `json:"page_no"`   // 当前页码
+	PageSize int64                  `json:"page_size"` // 每页数量
+	List     []*TrashedCategoryItem `json:"list"`      // 分类列表，按删除时间倒序
+}
+
+// RestoreCategoryResponse 从回收站恢复分类响应
+type RestoreCategoryResponse struct {
+	ID       string `json:"id"`        // 分类 ID
+	Slug     string `json:"slug"`      // 恢复后的 slug
+	ParentID string `json:"parent_id"` // 恢复后的父分类 ID
+	Message  string `json:"message"`   // 恢复结果消息
+}
+
+// DeleteUserResponse 删除用户响应
+type DeleteUserResponse struct {
+	Message string `json:"message"` // 删除结果消息
+}
+
+// TrashedUserItem 回收站中的用户
+type TrashedUserItem struct {
+	ID        string `json:"id"`         // 用户 ID
+	Email     string `json:"email"`      // 删除前的邮箱
+	Nickname  string `json:"nickname"`   // 删除前的昵称
+	DeletedAt string `json:"deleted_at"` // 删除时间
+	PurgeAt   string `json:"purge_at"`   // 预计永久删除时间，不自动清理时为空
+}
+
+// ListTrashedUsersResponse 回收站用户列表响应
+type ListTrashedUsersResponse struct {
+	Total    int64              `json:"total"`     // 总数量
+	PageNo   int64              `json:"page_no"`   // 当前页码
+	PageSize int64              `json:"page_size"` // 每页数量
+	List     []*TrashedUserItem `json:"list"`      // 用户列表，按删除时间倒序
+}
+
+// RestoreUserResponse 从回收站恢复用户响应
+type RestoreUserResponse struct {
+	ID       string `json:"id"`       // 用户 ID
+	Email    string `json:"email"`    // 邮箱
+	Nickname string `json:"nickname"` // 昵称
+	Message  string `json:"message"`  // 恢复结果消息
+}
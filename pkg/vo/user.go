@@ -71,8 +71,10 @@ type UserItem struct {
 
 // ListUsersResponse 用户列表响应
 type ListUsersResponse struct {
-	Total    int64       `json:"total"`     // 总数量
-	PageNo   int64       `json:"page_no"`   // 当前页码
-	PageSize int64       `json:"page_size"` // 每页数量
-	List     []*UserItem `json:"list"`      // 用户列表
+	Total      int64       `json:"total"`                 // 总数量，游标分页未要求统计总数时为 -1
+	PageNo     int64       `json:"page_no"`               // 当前页码，游标分页时为 0
+	PageSize   int64       `json:"page_size"`             // 每页数量
+	List       []*UserItem `json:"list"`                  // 用户列表
+	NextCursor string      `json:"next_cursor,omitempty"` // 下一页游标，仅游标分页返回，没有更多数据时为空
+	PrevCursor string      `json:"prev_cursor,omitempty"` // 上一页游标，仅游标分页返回，已是第一页时为空
 }
@@ -13,6 +13,15 @@ var MapperProviderSet = wire.NewSet(
 	mapperImpl.NewRBACMapper,
 	mapperImpl.NewPostMapper,
 	mapperImpl.NewCategoryMapper,
+	mapperImpl.NewCommentMapper,
+	mapperImpl.NewTagMapper,
+	mapperImpl.NewSlugHistoryMapper,
+	mapperImpl.NewPostRevisionMapper,
+	mapperImpl.NewMediaMapper,
+	mapperImpl.NewPostStatMapper,
+	mapperImpl.NewPostReactionMapper,
+	mapperImpl.NewPostTermMapper,
+	mapperImpl.NewSeriesMapper,
 )
 
 // ServiceProviderSet 服务相关的 Provider 集合
@@ -24,6 +33,13 @@ var ServiceProviderSet = wire.NewSet(
 	serviceImpl.NewVerificationService,
 	serviceImpl.NewPostService,
 	serviceImpl.NewCategoryService,
+	serviceImpl.NewCommentService,
+	serviceImpl.NewTagService,
+	serviceImpl.NewSeriesService,
+	serviceImpl.NewFeedService,
+	serviceImpl.NewSEOService,
+	serviceImpl.NewMediaService,
+	serviceImpl.NewAnalyticsService,
 )
 
 // AllProviderSet 所有 Provider 的集合
@@ -64,3 +64,59 @@ func NewCategoryController() (*controller.CategoryController, error) {
 		controller.NewCategoryController,
 	))
 }
+
+// NewCommentController 使用 Wire 初始化评论控制器
+func NewCommentController() (*controller.CommentController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewCommentController,
+	))
+}
+
+// NewTagController 使用 Wire 初始化标签控制器
+func NewTagController() (*controller.TagController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewTagController,
+	))
+}
+
+// NewSeriesController 使用 Wire 初始化文章系列控制器
+func NewSeriesController() (*controller.SeriesController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewSeriesController,
+	))
+}
+
+// NewFeedController 使用 Wire 初始化订阅源控制器
+func NewFeedController() (*controller.FeedController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewFeedController,
+	))
+}
+
+// NewSEOController 使用 Wire 初始化搜索引擎优化控制器
+func NewSEOController() (*controller.SEOController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewSEOController,
+	))
+}
+
+// NewMediaController 使用 Wire 初始化媒体文件控制器
+func NewMediaController() (*controller.MediaController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewMediaController,
+	))
+}
+
+// NewAnalyticsController 使用 Wire 初始化浏览统计控制器
+func NewAnalyticsController() (*controller.AnalyticsController, error) {
+	panic(wire.Build(
+		AllProviderSet,
+		controller.NewAnalyticsController,
+	))
+}
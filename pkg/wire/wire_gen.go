@@ -57,15 +57,95 @@ func NewVerificationController() (*controller.VerificationController, error) {
 func NewPostController() (*controller.PostController, error) {
 	postMapper := impl2.NewPostMapper()
 	categoryMapper := impl2.NewCategoryMapper()
-	postService := impl.NewPostService(postMapper, categoryMapper)
-	postController := controller.NewPostController(postService)
+	tagMapper := impl2.NewTagMapper()
+	userMapper := impl2.NewUserMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	slugHistoryMapper := impl2.NewSlugHistoryMapper()
+	postRevisionMapper := impl2.NewPostRevisionMapper()
+	postStatMapper := impl2.NewPostStatMapper()
+	postReactionMapper := impl2.NewPostReactionMapper()
+	postTermMapper := impl2.NewPostTermMapper()
+	seriesMapper := impl2.NewSeriesMapper()
+	postService := impl.NewPostService(postMapper, categoryMapper, tagMapper, userMapper, rbacMapper, slugHistoryMapper, postRevisionMapper, postStatMapper, postReactionMapper, postTermMapper, seriesMapper)
+	analyticsService := impl.NewAnalyticsService(postMapper, postStatMapper, rbacMapper)
+	postController := controller.NewPostController(postService, analyticsService)
 	return postController, nil
 }
 
 // NewCategoryController 使用 Wire 初始化分类控制器
 func NewCategoryController() (*controller.CategoryController, error) {
 	categoryMapper := impl2.NewCategoryMapper()
-	categoryService := impl.NewCategoryService(categoryMapper)
+	slugHistoryMapper := impl2.NewSlugHistoryMapper()
+	categoryService := impl.NewCategoryService(categoryMapper, slugHistoryMapper)
 	categoryController := controller.NewCategoryController(categoryService)
 	return categoryController, nil
 }
+
+// NewCommentController 使用 Wire 初始化评论控制器
+func NewCommentController() (*controller.CommentController, error) {
+	commentMapper := impl2.NewCommentMapper()
+	postMapper := impl2.NewPostMapper()
+	userMapper := impl2.NewUserMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	commentService := impl.NewCommentService(commentMapper, postMapper, userMapper, rbacMapper)
+	commentController := controller.NewCommentController(commentService)
+	return commentController, nil
+}
+
+// NewTagController 使用 Wire 初始化标签控制器
+func NewTagController() (*controller.TagController, error) {
+	tagMapper := impl2.NewTagMapper()
+	tagService := impl.NewTagService(tagMapper)
+	tagController := controller.NewTagController(tagService)
+	return tagController, nil
+}
+
+// NewSeriesController 使用 Wire 初始化文章系列控制器
+func NewSeriesController() (*controller.SeriesController, error) {
+	seriesMapper := impl2.NewSeriesMapper()
+	postMapper := impl2.NewPostMapper()
+	userMapper := impl2.NewUserMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	seriesService := impl.NewSeriesService(seriesMapper, postMapper, userMapper, rbacMapper)
+	seriesController := controller.NewSeriesController(seriesService)
+	return seriesController, nil
+}
+
+// NewFeedController 使用 Wire 初始化订阅源控制器
+func NewFeedController() (*controller.FeedController, error) {
+	postMapper := impl2.NewPostMapper()
+	categoryMapper := impl2.NewCategoryMapper()
+	tagMapper := impl2.NewTagMapper()
+	userMapper := impl2.NewUserMapper()
+	feedService := impl.NewFeedService(postMapper, categoryMapper, tagMapper, userMapper)
+	feedController := controller.NewFeedController(feedService)
+	return feedController, nil
+}
+
+// NewSEOController 使用 Wire 初始化搜索引擎优化控制器
+func NewSEOController() (*controller.SEOController, error) {
+	postMapper := impl2.NewPostMapper()
+	categoryMapper := impl2.NewCategoryMapper()
+	seoService := impl.NewSEOService(postMapper, categoryMapper)
+	seoController := controller.NewSEOController(seoService)
+	return seoController, nil
+}
+
+// NewMediaController 使用 Wire 初始化媒体文件控制器
+func NewMediaController() (*controller.MediaController, error) {
+	mediaMapper := impl2.NewMediaMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	mediaService := impl.NewMediaService(mediaMapper, rbacMapper)
+	mediaController := controller.NewMediaController(mediaService)
+	return mediaController, nil
+}
+
+// NewAnalyticsController 使用 Wire 初始化浏览统计控制器
+func NewAnalyticsController() (*controller.AnalyticsController, error) {
+	postMapper := impl2.NewPostMapper()
+	postStatMapper := impl2.NewPostStatMapper()
+	rbacMapper := impl2.NewRBACMapper()
+	analyticsService := impl.NewAnalyticsService(postMapper, postStatMapper, rbacMapper)
+	analyticsController := controller.NewAnalyticsController(analyticsService)
+	return analyticsController, nil
+}